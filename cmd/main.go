@@ -2,43 +2,69 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/config"
 	"github.com/ilbagatto/tarot-api/internal/db"
+	"github.com/ilbagatto/tarot-api/internal/imaging"
 	"github.com/ilbagatto/tarot-api/internal/logging"
-	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/repository/postgres"
 	"github.com/ilbagatto/tarot-api/internal/routes"
-	"github.com/joho/godotenv"
+	"github.com/ilbagatto/tarot-api/internal/tracing"
+	"github.com/ilbagatto/tarot-api/internal/utils"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
 func main() {
-	_ = godotenv.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	utils.SetStaticURL(cfg.Static.URL)
+	utils.SetImageVariants(cfg.Static.Sizes, cfg.Static.Formats)
 
-	logger := logging.NewLogger()
+	logger := logging.NewLogger(cfg.Log.Format)
 	defer logger.Sync()
 
-	logger.Info("Starting server...")
+	logger.Info("Starting server...", zap.String("env", cfg.Env))
 
-	port := os.Getenv("SERVER_PORT")
-	if port == "" {
-		port = "8080"
+	// Tracing must be set up before the database driver starts emitting spans
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Exporter, cfg.Tracing.ServiceName)
+	if err != nil {
+		logger.Fatal("Could not set up tracing", zap.Error(err))
 	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("Could not flush traces", zap.Error(err))
+		}
+	}()
 
 	// Initialize DB
-	database, err := db.InitDB()
+	database, err := db.InitDB(cfg.Database)
 	if err != nil {
 		logger.Fatal("Could not connect to database", zap.Error(err))
 	}
 	defer database.Close()
 
+	// `tarot-api migrate up` applies pending schema migrations and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrations(database, db.DialectOf(cfg.Database.DSN), logger, os.Args[2:])
+		return
+	}
+
 	// Initialize application
-	application := app.NewApp(database)
+	application := app.NewApp(cfg, database, postgres.New(database), cache.New(cfg.Cache.TTL, cfg.Cache.MaxEntries), logger)
 	// Add global middleware for charset=utf-8 in JSON responses
 	application.Echo.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -47,13 +73,23 @@ func main() {
 		}
 	})
 
-	application.Echo.Use(middleware.CORSMiddleware())
+	if dir := cfg.ImageServer.CacheDir; dir != "" {
+		application.Images, err = imaging.NewDiskCache(dir, int64(cfg.ImageServer.CacheSizeMB)<<20)
+		if err != nil {
+			logger.Fatal("Could not open image cache", zap.String("dir", dir), zap.Error(err))
+		}
+	}
 
 	routes.InitRoutes(application)
 
+	// Purge the trash in the background until shutdown
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go application.PurgeTrash(purgeCtx)
+
 	// Start the server in a goroutine
 	go func() {
-		if err := application.Echo.Start(":" + port); err != nil {
+		if err := application.Echo.Start(":" + strconv.Itoa(cfg.Server.Port)); err != nil {
 			logger.Fatal("Shutting down the server", zap.Error(err))
 		}
 	}()
@@ -65,6 +101,13 @@ func main() {
 	sig := <-quit
 	logger.Info("Shutting down server...", zap.String("signal", sig.String()))
 
+	// Fail readiness first, so that load balancers stop routing new traffic
+	application.SetShuttingDown()
+	if delay := cfg.Server.ShutdownDelay; delay > 0 {
+		logger.Info("Waiting before shutdown", zap.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -74,3 +117,17 @@ func main() {
 
 	logger.Info("Server exited properly")
 }
+
+// runMigrations handles the `migrate` subcommand
+func runMigrations(database *sql.DB, dialect db.Dialect, logger *zap.Logger, args []string) {
+	if len(args) == 0 || args[0] != "up" {
+		logger.Fatal("Usage: tarot-api migrate up")
+	}
+
+	applied, err := db.Migrate(context.Background(), database, dialect)
+	if err != nil {
+		logger.Fatal("Migration failed", zap.Error(err), zap.Int("applied", applied))
+	}
+	version, _, _ := db.SchemaVersion(context.Background(), database)
+	logger.Info("Migrations applied", zap.Int("applied", applied), zap.Int64("version", version))
+}
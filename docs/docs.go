@@ -16,6 +16,110 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/audit": {
+            "get": {
+                "description": "Returns the recorded creates, updates, deletes, restores and purges, newest first, with the fields each one changed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "Get the audit log",
+                "parameters": [
+                    {
+                        "enum": [
+                            "deck",
+                            "source",
+                            "spread",
+                            "suit",
+                            "rank",
+                            "card_major",
+                            "card_minor",
+                            "meaning_major",
+                            "meaning_minor"
+                        ],
+                        "type": "string",
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "entityId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Actor, e.g. key:3f2b8c1e9a7d or ip:203.0.113.7",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest time, inclusive (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest time, exclusive (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries (default 100, at most 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AuditEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cache/stats": {
+            "get": {
+                "description": "Returns hit/miss/eviction counters of the application cache",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "service"
+                ],
+                "summary": "Cache statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/cache.Stats"
+                        }
+                    }
+                }
+            }
+        },
         "/cards/major": {
             "get": {
                 "description": "Returns all major arcana cards from the specified deck",
@@ -93,9 +197,84 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "201": {
-                        "description": "Returns the ID of the created card",
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CardMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cards/major/batch": {
+            "post": {
+                "description": "Each item creates a card, or updates the card with its id, conditionally if it has a version.\nIn atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cards"
+                ],
+                "summary": "Create and update Major Arcana cards in a batch",
+                "parameters": [
+                    {
+                        "description": "Items to write",
+                        "name": "items",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CardMajorBatchItem"
+                            }
+                        }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "partial"
+                        ],
+                        "type": "string",
+                        "description": "atomic or partial",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "All items written",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in partial mode",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/handlers.BatchResponse"
                         }
                     },
                     "400": {
@@ -104,6 +283,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "422": {
+                        "description": "Some items failed in atomic mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -137,6 +328,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/models.CardMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -181,11 +378,26 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CardMajorInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CardMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -199,6 +411,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -208,7 +432,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a card by ID",
+                "description": "Moves a card to the trash, see /trash. A card used in combinations is only deleted with force=true",
                 "tags": [
                     "cards"
                 ],
@@ -220,9 +444,33 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
                     "204": {
                         "description": "No Content"
                     },
@@ -232,6 +480,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -239,13 +511,12 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cards/minor": {
-            "get": {
-                "description": "Returns all minor arcana cards from the specified deck",
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/merge-patch+json"
                 ],
                 "produces": [
                     "application/json"
@@ -253,23 +524,41 @@ const docTemplate = `{
                 "tags": [
                     "cards"
                 ],
-                "summary": "List Minor Arcana cards",
+                "summary": "Partially update a Major Arcana card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Deck ID (required)",
-                        "name": "deckId",
-                        "in": "query",
+                        "description": "CardMajor ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "card",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CardMajorInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CardMinor"
+                            "$ref": "#/definitions/models.CardMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
                             }
                         }
                     },
@@ -285,46 +574,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "Adds a new card of interpretations",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "cards"
-                ],
-                "summary": "Create a new card",
-                "parameters": [
-                    {
-                        "description": "CardMinor data",
-                        "name": "card",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
-                            "$ref": "#/definitions/models.CardMinorInput"
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created card",
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "428": {
+                        "description": "Precondition Required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -338,22 +607,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/cards/minor/{id}": {
+        "/cards/minor": {
             "get": {
-                "description": "Retrieves a card by its ID",
-                "produces": [
+                "description": "Returns all minor arcana cards from the specified deck",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "cards"
                 ],
-                "summary": "Get card by ID",
+                "summary": "List Minor Arcana cards",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "CardMinor ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Deck ID (required)",
+                        "name": "deckId",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -361,7 +633,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CardMinor"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CardMinor"
+                            }
                         }
                     },
                     "400": {
@@ -375,11 +650,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing card",
+            "post": {
+                "description": "Adds a new card of interpretations",
                 "consumes": [
                     "application/json"
                 ],
@@ -389,17 +670,10 @@ const docTemplate = `{
                 "tags": [
                     "cards"
                 ],
-                "summary": "Update a card",
+                "summary": "Create a new card",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "CardMinor ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated card",
+                        "description": "CardMinor data",
                         "name": "card",
                         "in": "body",
                         "required": true,
@@ -409,48 +683,22 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/models.CardMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Deletes a card by ID",
-                "tags": [
-                    "cards"
-                ],
-                "summary": "Delete a card",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "CardMinor ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -466,39 +714,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/decks": {
-            "get": {
-                "description": "Retrieves a list of available Tarot decks. Optionally filters decks that contain cards.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "decks"
-                ],
-                "summary": "Get all decks",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.DeckListItem"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            },
+        "/cards/minor/batch": {
             "post": {
-                "description": "Adds a new Tarot deck and links to existing sources (by ID)",
+                "description": "Each item creates a card, or updates the card with its id, conditionally if it has a version.\nIn atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.",
                 "consumes": [
                     "application/json"
                 ],
@@ -506,25 +724,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "decks"
+                    "cards"
                 ],
-                "summary": "Create a new deck",
+                "summary": "Create and update Minor Arcana cards in a batch",
                 "parameters": [
                     {
-                        "description": "Deck input",
-                        "name": "deck",
+                        "description": "Items to write",
+                        "name": "items",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeckInput"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CardMinorBatchItem"
+                            }
                         }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "partial"
+                        ],
+                        "type": "string",
+                        "description": "atomic or partial",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "All items written",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in partial mode",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/handlers.BatchResponse"
                         }
                     },
                     "400": {
@@ -533,8 +770,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "422": {
+                        "description": "Some items failed in atomic mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -548,20 +791,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/decks/{id}": {
+        "/cards/minor/{id}": {
             "get": {
-                "description": "Retrieves a deck with its associated interpretation sources",
+                "description": "Retrieves a card by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "decks"
+                    "cards"
                 ],
-                "summary": "Get deck by ID",
+                "summary": "Get card by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Deck ID",
+                        "description": "CardMinor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -571,31 +814,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Deck"
+                            "$ref": "#/definitions/models.CardMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Updates the specified Tarot deck and its sources",
+                "description": "Updates an existing card",
                 "consumes": [
                     "application/json"
                 ],
@@ -603,48 +846,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "decks"
+                    "cards"
                 ],
-                "summary": "Update a deck",
+                "summary": "Update a card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Deck ID",
+                        "description": "CardMinor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Deck input",
-                        "name": "deck",
+                        "description": "Updated card",
+                        "name": "card",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DeckInput"
+                            "$ref": "#/definitions/models.CardMinorInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Deck"
+                            "$ref": "#/definitions/models.CardMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "428": {
+                        "description": "Precondition Required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -658,24 +913,45 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a deck and its associations",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Moves a card to the trash, see /trash. A card used in combinations is only deleted with force=true",
                 "tags": [
-                    "decks"
+                    "cards"
                 ],
-                "summary": "Delete deck by ID",
+                "summary": "Delete a card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Deck ID",
+                        "description": "CardMinor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
                     "204": {
                         "description": "No Content"
                     },
@@ -685,6 +961,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -692,52 +992,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/meanings/major": {
-            "get": {
-                "description": "Returns a list of meanings for major arcana cards with optional filters",
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/merge-patch+json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "cards"
                 ],
-                "summary": "Get Major Arcana meanings",
+                "summary": "Partially update a Minor Arcana card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Card number (optional)",
-                        "name": "number",
-                        "in": "query"
+                        "description": "CardMinor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "card",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CardMinorInput"
+                        }
                     },
                     {
-                        "enum": [
-                            "straight",
-                            "reverted"
-                        ],
                         "type": "string",
-                        "description": "Card position",
-                        "name": "position",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Source ID (optional)",
-                        "name": "source",
-                        "in": "query"
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MeaningMajor"
+                            "$ref": "#/definitions/models.CardMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
                             }
                         }
                     },
@@ -747,6 +1049,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -754,9 +1086,41 @@ const docTemplate = `{
                         }
                     }
                 }
+            }
+        },
+        "/decks": {
+            "get": {
+                "description": "Retrieves a list of available Tarot decks. Optionally filters decks that contain cards.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decks"
+                ],
+                "summary": "Get all decks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DeckListItem"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
             },
             "post": {
-                "description": "Adds a new MajorMeaning of interpretations",
+                "description": "Adds a new Tarot deck and links to existing sources (by ID)",
                 "consumes": [
                     "application/json"
                 ],
@@ -764,25 +1128,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Create a new MajorMeaning",
+                "summary": "Create a new deck",
                 "parameters": [
                     {
-                        "description": "MajorMeaning data",
-                        "name": "MajorMeaning",
+                        "description": "Deck input",
+                        "name": "deck",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMajorInput"
+                            "$ref": "#/definitions/models.DeckInput"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Returns the ID of the created MajorMeaning",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/models.Deck"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
                         }
                     },
                     "400": {
@@ -791,6 +1165,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -800,20 +1180,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/meanings/major/{id}": {
+        "/decks/{id}": {
             "get": {
-                "description": "Retrieves a MajorMeaning by its ID",
+                "description": "Retrieves a deck with its associated interpretation sources",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Get MajorMeaning by ID",
+                "summary": "Get deck by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "MajorMeaning ID",
+                        "description": "Deck ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -823,25 +1203,37 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMajor"
+                            "$ref": "#/definitions/models.Deck"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             },
             "put": {
-                "description": "Updates an existing MajorMeaning",
+                "description": "Updates the specified Tarot deck and its sources",
                 "consumes": [
                     "application/json"
                 ],
@@ -849,32 +1241,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Update a MajorMeaning",
+                "summary": "Update a deck",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "MajorMeaning ID",
+                        "description": "Deck ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated MajorMeaning",
-                        "name": "MajorMeaning",
+                        "description": "Deck input",
+                        "name": "deck",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMajorInput"
+                            "$ref": "#/definitions/models.DeckInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMajor"
+                            "$ref": "#/definitions/models.Deck"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -889,35 +1293,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Deletes a MajorMeaning by ID",
-                "tags": [
-                    "meanings"
-                ],
-                "summary": "Delete a MajorMeaning",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "MajorMeaning ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -929,61 +1318,83 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/meanings/minor": {
-            "get": {
-                "description": "Returns a list of meanings for minor arcana cards with optional filters",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Moves a deck with its cards to the trash, see /trash. A deck with cards or linked sources is only deleted with force=true",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Get Minor Arcana meanings",
+                "summary": "Delete deck by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Card number (optional)",
-                        "name": "number",
-                        "in": "query"
+                        "description": "Deck ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "straight",
-                            "reverted"
-                        ],
                         "type": "string",
-                        "description": "Card position",
-                        "name": "position",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Source ID (optional)",
-                        "name": "source",
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Dry run",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MeaningMinor"
-                            }
+                            "$ref": "#/definitions/handlers.DeleteReport"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -992,34 +1403,54 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Adds a new MinorMeaning of interpretations",
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/merge-patch+json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Create a new MinorMeaning",
+                "summary": "Partially update a deck",
                 "parameters": [
                     {
-                        "description": "MinorMeaning data",
-                        "name": "MinorMeaning",
+                        "type": "integer",
+                        "description": "Deck ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "deck",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMinorInput"
+                            "$ref": "#/definitions/models.DeckInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created MinorMeaning",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/models.Deck"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1028,29 +1459,59 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                }
-            }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
         },
-        "/meanings/minor/{id}": {
+        "/decks/{id}/images/check": {
             "get": {
-                "description": "Retrieves a MinorMeaning by its ID",
+                "description": "Resolves the image path of every card of a deck, its own or from the image templates of the deck, and checks that the file exists in the static image directory. Cards without any image path are reported as missing too",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Get MinorMeaning by ID",
+                "summary": "Check the card images of a deck",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "MinorMeaning ID",
+                        "description": "Deck ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1060,7 +1521,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMinor"
+                            "$ref": "#/definitions/handlers.ImageCheckReport"
                         }
                     },
                     "400": {
@@ -1074,11 +1535,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing MinorMeaning",
+            }
+        },
+        "/decks/{id}/scaffold": {
+            "post": {
+                "description": "Creates the 22 Major Arcana of an empty deck, numbered and named in a naming tradition (rider-waite by default, or marseille), and a Minor Arcana card for every suit and rank. Image paths are set from the optional patterns, which are image templates like those of decks: {arcana}, {number} (of a Major Arcana card, or the rank ID), {suit} and {rank} (slugs)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1086,32 +1555,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "meanings"
+                    "decks"
                 ],
-                "summary": "Update a MinorMeaning",
+                "summary": "Create the standard cards of a deck",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "MinorMeaning ID",
+                        "description": "Deck ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated MinorMeaning",
-                        "name": "MinorMeaning",
+                        "description": "Naming tradition and image patterns",
+                        "name": "scaffold",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMinorInput"
+                            "$ref": "#/definitions/models.ScaffoldInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.MeaningMinor"
+                            "$ref": "#/definitions/models.ScaffoldResult"
                         }
                     },
                     "400": {
@@ -1126,35 +1594,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Deletes a MinorMeaning by ID",
-                "tags": [
-                    "meanings"
-                ],
-                "summary": "Delete a MinorMeaning",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "MinorMeaning ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "The deck already has cards",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1168,62 +1609,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/ranks": {
+        "/healthz": {
             "get": {
-                "description": "Retrieves a list of all available interpretation ranks",
+                "description": "Returns 200 as long as the process is able to serve requests",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ranks"
+                    "service"
                 ],
-                "summary": "Get all ranks",
+                "summary": "Liveness probe",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Rank"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "$ref": "#/definitions/handlers.HealthStatus"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Adds a new rank of interpretations",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/img/{deck}/back": {
+            "get": {
+                "description": "Renders the card back of a deck like the image of a card, see GET /img/{deck}/{card}",
                 "produces": [
-                    "application/json"
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif",
+                    "image/webp",
+                    "image/avif"
                 ],
                 "tags": [
-                    "ranks"
+                    "images"
                 ],
-                "summary": "Create a new rank",
+                "summary": "Get the card back of a deck, scaled",
                 "parameters": [
                     {
-                        "description": "Rank data",
-                        "name": "rank",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RankInput"
-                        }
+                        "type": "integer",
+                        "description": "Deck ID",
+                        "name": "deck",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Width in pixels",
+                        "name": "w",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Height in pixels",
+                        "name": "h",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "jpeg, png, gif, webp or avif; the format of the original by default (png if it is none of them)",
+                        "name": "fmt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "contain (default), cover or fill, with both w and h",
+                        "name": "fit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created rank",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1232,39 +1689,86 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
+                    },
+                    "503": {
+                        "description": "The request ended while waiting for a render",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/ranks/{id}": {
+        "/img/{deck}/{card}": {
             "get": {
-                "description": "Retrieves a rank by its ID",
+                "description": "Renders the image of a card, its own or from the image templates of the deck, scaled to fit w and h and converted to fmt. Renditions are cached on disk; without parameters the original is served as it is. Images are never scaled up; w and h are rounded up to the configured size step, unless they are the width of a configured size",
                 "produces": [
-                    "application/json"
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif",
+                    "image/webp",
+                    "image/avif"
                 ],
                 "tags": [
-                    "ranks"
+                    "images"
                 ],
-                "summary": "Get rank by ID",
+                "summary": "Get the image of a card, scaled",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Rank ID",
-                        "name": "id",
+                        "description": "Deck ID",
+                        "name": "deck",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Card ID",
+                        "name": "card",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Width in pixels",
+                        "name": "w",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Height in pixels",
+                        "name": "h",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "jpeg, png, gif, webp or avif; the format of the original by default (png if it is none of them)",
+                        "name": "fmt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "contain (default), cover or fill, with both w and h",
+                        "name": "fit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Rank"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1278,11 +1782,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "The request ended while waiting for a render",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing rank",
+            }
+        },
+        "/meanings/major": {
+            "get": {
+                "description": "Returns a list of meanings for major arcana cards with optional filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -1290,32 +1808,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ranks"
+                    "meanings"
                 ],
-                "summary": "Update a rank",
+                "summary": "Get Major Arcana meanings",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Rank ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Card number (optional)",
+                        "name": "number",
+                        "in": "query"
                     },
                     {
-                        "description": "Updated rank",
-                        "name": "rank",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RankInput"
-                        }
+                        "enum": [
+                            "straight",
+                            "reverted"
+                        ],
+                        "type": "string",
+                        "description": "Card position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Source ID (optional)",
+                        "name": "source",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Rank"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMajor"
+                            }
                         }
                     },
                     "400": {
@@ -1324,12 +1851,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1338,24 +1859,45 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a rank by ID",
-                "tags": [
-                    "ranks"
-                ],
-                "summary": "Delete a rank",
+            "post": {
+                "description": "Adds a new MajorMeaning of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meanings"
+                ],
+                "summary": "Create a new MajorMeaning",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Rank ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "MajorMeaning data",
+                        "name": "MajorMeaning",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMajorInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1372,36 +1914,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/sources": {
-            "get": {
-                "description": "Retrieves a list of all available interpretation sources",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "sources"
-                ],
-                "summary": "Get all sources",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.SourceListItem"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    }
-                }
-            },
+        "/meanings/major/batch": {
             "post": {
-                "description": "Adds a new source of interpretations",
+                "description": "Each item creates a meaning, or updates the meaning with its id, conditionally if it has a version.\nIn atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1409,25 +1924,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sources"
+                    "meanings"
                 ],
-                "summary": "Create a new source",
+                "summary": "Create and update Major Arcana meanings in a batch",
                 "parameters": [
                     {
-                        "description": "Source data with list of deck IDs",
-                        "name": "source",
+                        "description": "Items to write",
+                        "name": "items",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SourceInput"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMajorBatchItem"
+                            }
                         }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "partial"
+                        ],
+                        "type": "string",
+                        "description": "atomic or partial",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created source",
+                    "200": {
+                        "description": "All items written",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in partial mode",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/handlers.BatchResponse"
                         }
                     },
                     "400": {
@@ -1436,6 +1970,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "422": {
+                        "description": "Some items failed in atomic mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1445,20 +1991,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/sources/{id}": {
+        "/meanings/major/{id}": {
             "get": {
-                "description": "Retrieves a source by its ID",
+                "description": "Retrieves a MajorMeaning by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sources"
+                    "meanings"
                 ],
-                "summary": "Get source by ID",
+                "summary": "Get MajorMeaning by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Source ID",
+                        "description": "MajorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1468,7 +2014,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Source"
+                            "$ref": "#/definitions/models.MeaningMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1486,7 +2038,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing source",
+                "description": "Updates an existing MajorMeaning",
                 "consumes": [
                     "application/json"
                 ],
@@ -1494,32 +2046,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sources"
+                    "meanings"
                 ],
-                "summary": "Update a source",
+                "summary": "Update a MajorMeaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Source ID",
+                        "description": "MajorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated source with list of deck IDs",
-                        "name": "source",
+                        "description": "Updated MajorMeaning",
+                        "name": "MajorMeaning",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SourceInput"
+                            "$ref": "#/definitions/models.MeaningMajorInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Source"
+                            "$ref": "#/definitions/models.MeaningMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1534,6 +2098,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1543,21 +2119,39 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a source by ID",
+                "description": "Deletes a MajorMeaning by ID",
                 "tags": [
-                    "sources"
+                    "meanings"
                 ],
-                "summary": "Delete a source",
+                "summary": "Delete a MajorMeaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Source ID",
+                        "description": "MajorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check that the delete would succeed",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
                     "204": {
                         "description": "No Content"
                     },
@@ -1567,33 +2161,22 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/spreads": {
-            "get": {
-                "description": "Retrieves a list of all available interpretation spreads",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "spreads"
-                ],
-                "summary": "Get all spreads",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Spread"
-                            }
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "500": {
@@ -1604,34 +2187,54 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Adds a new spread of interpretations",
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/merge-patch+json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "spreads"
+                    "meanings"
                 ],
-                "summary": "Create a new spread",
+                "summary": "Partially update a Major Arcana meaning",
                 "parameters": [
                     {
-                        "description": "Spread data",
-                        "name": "spread",
+                        "type": "integer",
+                        "description": "MeaningMajor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "meaning",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SpreadInput"
+                            "$ref": "#/definitions/models.MeaningMajorInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created spread",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/models.MeaningMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1640,6 +2243,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1649,20 +2282,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/spreads/{id}": {
+        "/meanings/major/{id}/revisions": {
             "get": {
-                "description": "Retrieves a spread by its ID",
+                "description": "Returns every version of the meaning, newest first, with the fields changed from the version before",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "spreads"
+                    "meanings"
                 ],
-                "summary": "Get spread by ID",
+                "summary": "Get revisions of a Major Arcana meaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spread ID",
+                        "description": "MeaningMajor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1672,7 +2305,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Spread"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMajorRevision"
+                            }
                         }
                     },
                     "400": {
@@ -1686,44 +2322,59 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing spread",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/meanings/major/{id}/revisions/{revision}/restore": {
+            "post": {
+                "description": "Updates the meaning to the content of the given revision; the result is kept as a new revision",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "spreads"
+                    "meanings"
                 ],
-                "summary": "Update a spread",
+                "summary": "Restore a revision of a Major Arcana meaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spread ID",
+                        "description": "MeaningMajor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated spread",
-                        "name": "spread",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SpreadInput"
-                        }
+                        "type": "integer",
+                        "description": "Revision to restore",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Spread"
+                            "$ref": "#/definitions/models.MeaningMajor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1738,6 +2389,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1745,25 +2414,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a spread by ID",
+            }
+        },
+        "/meanings/minor": {
+            "get": {
+                "description": "Returns a list of meanings for minor arcana cards with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "spreads"
+                    "meanings"
                 ],
-                "summary": "Delete a spread",
+                "summary": "Get Minor Arcana meanings",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Spread ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Card number (optional)",
+                        "name": "number",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "straight",
+                            "reverted"
+                        ],
+                        "type": "string",
+                        "description": "Card position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Source ID (optional)",
+                        "name": "source",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMinor"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1778,28 +2476,53 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/suits": {
-            "get": {
-                "description": "Retrieves a list of all available interpretation suits",
+            },
+            "post": {
+                "description": "Adds a new MinorMeaning of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "suits"
+                    "meanings"
+                ],
+                "summary": "Create a new MinorMeaning",
+                "parameters": [
+                    {
+                        "description": "MinorMeaning data",
+                        "name": "MinorMeaning",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMinorInput"
+                        }
+                    }
                 ],
-                "summary": "Get all suits",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Suit"
+                            "$ref": "#/definitions/models.MeaningMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1807,9 +2530,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/meanings/minor/batch": {
             "post": {
-                "description": "Adds a new suit of interpretations",
+                "description": "Each item creates a meaning, or updates the meaning with its id, conditionally if it has a version.\nIn atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1817,25 +2542,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suits"
+                    "meanings"
                 ],
-                "summary": "Create a new suit",
+                "summary": "Create and update Minor Arcana meanings in a batch",
                 "parameters": [
                     {
-                        "description": "Suit data",
-                        "name": "suit",
+                        "description": "Items to write",
+                        "name": "items",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SuitInput"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMinorBatchItem"
+                            }
                         }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "partial"
+                        ],
+                        "type": "string",
+                        "description": "atomic or partial",
+                        "name": "mode",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Returns the ID of the created suit",
+                    "200": {
+                        "description": "All items written",
                         "schema": {
-                            "$ref": "#/definitions/models.IDOnly"
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in partial mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
                         }
                     },
                     "400": {
@@ -1844,6 +2588,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "422": {
+                        "description": "Some items failed in atomic mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BatchResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1853,20 +2609,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/suits/{id}": {
+        "/meanings/minor/{id}": {
             "get": {
-                "description": "Retrieves a suit by its ID",
+                "description": "Retrieves a MinorMeaning by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "suits"
+                    "meanings"
                 ],
-                "summary": "Get suit by ID",
+                "summary": "Get MinorMeaning by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Suit ID",
+                        "description": "MinorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1876,7 +2632,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Suit"
+                            "$ref": "#/definitions/models.MeaningMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1894,7 +2656,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing suit",
+                "description": "Updates an existing MinorMeaning",
                 "consumes": [
                     "application/json"
                 ],
@@ -1902,32 +2664,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suits"
+                    "meanings"
                 ],
-                "summary": "Update a suit",
+                "summary": "Update a MinorMeaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Suit ID",
+                        "description": "MinorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated suit",
-                        "name": "suit",
+                        "description": "Updated MinorMeaning",
+                        "name": "MinorMeaning",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SuitInput"
+                            "$ref": "#/definitions/models.MeaningMinorInput"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Suit"
+                            "$ref": "#/definitions/models.MeaningMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -1942,6 +2716,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1951,21 +2737,39 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a suit by ID",
+                "description": "Deletes a MinorMeaning by ID",
                 "tags": [
-                    "suits"
+                    "meanings"
                 ],
-                "summary": "Delete a suit",
+                "summary": "Delete a MinorMeaning",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Suit ID",
+                        "description": "MinorMeaning ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check that the delete would succeed",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
                     "204": {
                         "description": "No Content"
                     },
@@ -1975,6 +2779,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1982,40 +2804,2209 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "handlers.APIResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.CardMajor": {
-            "type": "object",
-            "properties": {
-                "deck": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "image": {
-                    "description": "Full URL",
-                    "type": "string"
-                },
-                "meanings": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.MeaningRef"
-                    }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meanings"
+                ],
+                "summary": "Partially update a Minor Arcana meaning",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "MeaningMinor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "meaning",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMinorInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/meanings/minor/{id}/revisions": {
+            "get": {
+                "description": "Returns every version of the meaning, newest first, with the fields changed from the version before",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meanings"
+                ],
+                "summary": "Get revisions of a Minor Arcana meaning",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "MeaningMinor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MeaningMinorRevision"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/meanings/minor/{id}/revisions/{revision}/restore": {
+            "post": {
+                "description": "Updates the meaning to the content of the given revision; the result is kept as a new revision",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meanings"
+                ],
+                "summary": "Restore a revision of a Minor Arcana meaning",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "MeaningMinor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to restore",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MeaningMinor"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ranks": {
+            "get": {
+                "description": "Retrieves a list of all available interpretation ranks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Get all ranks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Rank"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new rank of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Create a new rank",
+                "parameters": [
+                    {
+                        "description": "Rank data",
+                        "name": "rank",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RankInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rank"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ranks/{id}": {
+            "get": {
+                "description": "Retrieves a rank by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Get rank by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rank ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rank"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing rank",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Update a rank",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rank ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated rank",
+                        "name": "rank",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RankInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rank"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a rank with its Minor Arcana cards to the trash, see /trash. A rank with cards or meanings is only deleted with force=true",
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Delete a rank",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rank ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranks"
+                ],
+                "summary": "Partially update a rank",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rank ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "rank",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RankInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rank"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the database connection, the schema migration version and the static image directory.\nFails while the server is shutting down.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "service"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthStatus"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthStatus"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources": {
+            "get": {
+                "description": "Retrieves a list of all available interpretation sources",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Get all sources",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SourceListItem"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new source of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Create a new source",
+                "parameters": [
+                    {
+                        "description": "Source data with list of deck IDs",
+                        "name": "source",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SourceInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Source"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{id}": {
+            "get": {
+                "description": "Retrieves a source by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Get source by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Source"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing source",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Update a source",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated source with list of deck IDs",
+                        "name": "source",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SourceInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Source"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a source to the trash, see /trash. A source with meanings or linked decks is only deleted with force=true",
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Delete a source",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sources"
+                ],
+                "summary": "Partially update a source",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "source",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SourceInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Source"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spreads": {
+            "get": {
+                "description": "Retrieves a list of all available interpretation spreads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Get all spreads",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Spread"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new spread of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Create a new spread",
+                "parameters": [
+                    {
+                        "description": "Spread data",
+                        "name": "spread",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpreadInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spread"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spreads/{id}": {
+            "get": {
+                "description": "Retrieves a spread by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Get spread by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spread"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing spread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Update a spread",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated spread",
+                        "name": "spread",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpreadInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spread"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a spread by ID",
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Delete a spread",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check that the delete would succeed",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "spreads"
+                ],
+                "summary": "Partially update a spread",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Spread ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "spread",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpreadInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spread"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/suits": {
+            "get": {
+                "description": "Retrieves a list of all available interpretation suits",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Get all suits",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Suit"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new suit of interpretations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Create a new suit",
+                "parameters": [
+                    {
+                        "description": "Suit data",
+                        "name": "suit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SuitInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Suit"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/suits/{id}": {
+            "get": {
+                "description": "Retrieves a suit by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Get suit by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Suit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Suit"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing suit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Update a suit",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Suit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated suit",
+                        "name": "suit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SuitInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Suit"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a suit with its Minor Arcana cards to the trash, see /trash. A suit with cards or meanings is only deleted with force=true",
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Delete a suit",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Suit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report what the delete would remove",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the dependents",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteReport"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suits"
+                ],
+                "summary": "Partially update a suit",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Suit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "suit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SuitInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed; required when REQUIRE_IF_MATCH is set",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Suit"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash": {
+            "get": {
+                "description": "Returns the deleted decks, sources, cards, suits and ranks, most recently deleted first. They are purged after the configured retention.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get the trash",
+                "parameters": [
+                    {
+                        "enum": [
+                            "deck",
+                            "source",
+                            "card_major",
+                            "card_minor",
+                            "suit",
+                            "rank"
+                        ],
+                        "type": "string",
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TrashItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/{entity}/{id}": {
+            "delete": {
+                "description": "Deletes a deck (with its cards), source, suit or rank (with its cards and meanings) or card in the trash for good",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge from the trash",
+                "parameters": [
+                    {
+                        "enum": [
+                            "deck",
+                            "source",
+                            "card_major",
+                            "card_minor",
+                            "suit",
+                            "rank"
+                        ],
+                        "type": "string",
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/{entity}/{id}/restore": {
+            "post": {
+                "description": "Takes a deleted deck, suit or rank (with its cards), source or card out of the trash and returns it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore from the trash",
+                "parameters": [
+                    {
+                        "enum": [
+                            "deck",
+                            "source",
+                            "card_major",
+                            "card_minor",
+                            "suit",
+                            "rank"
+                        ],
+                        "type": "string",
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The restored deck, source, card, suit or rank",
+                        "schema": {
+                            "type": "object"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the resource, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The name has been taken meanwhile, or the deck, suit or rank of the card is in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "cache.Stats": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "integer"
+                },
+                "evictions": {
+                    "type": "integer"
+                },
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.APIResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "3f2b8c1e9a7d4e6f8b0c2d4e6f8a0b1c"
+                }
+            }
+        },
+        "handlers.BatchItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "status": {
+                    "description": "Status is the one the item would get as a request of its own; 424 marks an\nitem rolled back because another one failed",
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "handlers.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "requestId": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.BatchItemResult"
+                    }
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "handlers.DeleteReport": {
+            "type": "object",
+            "properties": {
+                "dependents": {
+                    "$ref": "#/definitions/models.Dependents"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.HealthCheck": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "expected": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "latency": {
+                    "type": "string",
+                    "example": "1.2ms"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.HealthStatus": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.HealthCheck"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "handlers.ImageCheckReport": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer",
+                    "example": 78
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.MissingImage"
+                    }
+                }
+            }
+        },
+        "handlers.MissingImage": {
+            "type": "object",
+            "properties": {
+                "arcana": {
+                    "type": "string",
+                    "example": "minor"
+                },
+                "card": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "error": {
+                    "type": "string",
+                    "example": "file does not exist"
+                },
+                "path": {
+                    "description": "empty if the card has no image",
+                    "type": "string",
+                    "example": "rws/cups/01.jpg"
+                },
+                "templated": {
+                    "description": "resolved from an image template",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "update"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "key:3f2b8c1e9a7d"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "Changes maps every field that differs to its values before and after,\ne.g. {\"meaning\": {\"before\": \"Teacher\", \"after\": \"Hierophant\"}}",
+                    "type": "object"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "meaning_major"
+                },
+                "entityId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CardMajor": {
+            "type": "object",
+            "properties": {
+                "deck": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "description": "Full URL",
+                    "type": "string"
+                },
+                "images": {
+                    "description": "Images lists all variants of the image, for srcset",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.ImageVariant"
+                    }
+                },
+                "meanings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MeaningRef"
+                    }
                 },
                 "name": {
                     "type": "string",
@@ -2035,6 +5026,36 @@ const docTemplate = `{
                 }
             }
         },
+        "models.CardMajorBatchItem": {
+            "type": "object",
+            "properties": {
+                "deck": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "The Fool"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "orgname": {
+                    "type": "string",
+                    "example": "Le Mat"
+                },
+                "version": {
+                    "description": "version the card is expected at; 0 updates it unconditionally",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
         "models.CardMajorInput": {
             "type": "object",
             "properties": {
@@ -2070,15 +5091,47 @@ const docTemplate = `{
                     "description": "Full URL",
                     "type": "string"
                 },
+                "images": {
+                    "description": "Images lists all variants of the image, for srcset",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.ImageVariant"
+                    }
+                },
                 "meanings": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/models.MeaningRef"
                     }
                 },
-                "name": {
-                    "type": "string",
-                    "example": "The Fool"
+                "name": {
+                    "type": "string",
+                    "example": "The Fool"
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "suit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "thumbnail": {
+                    "description": "Full URL",
+                    "type": "string"
+                }
+            }
+        },
+        "models.CardMinorBatchItem": {
+            "type": "object",
+            "properties": {
+                "deck": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 0
                 },
                 "rank": {
                     "type": "integer",
@@ -2088,9 +5141,10 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
-                "thumbnail": {
-                    "description": "Full URL",
-                    "type": "string"
+                "version": {
+                    "description": "version the card is expected at; 0 updates it unconditionally",
+                    "type": "integer",
+                    "example": 0
                 }
             }
         },
@@ -2114,6 +5168,16 @@ const docTemplate = `{
         "models.Deck": {
             "type": "object",
             "properties": {
+                "backImage": {
+                    "description": "Image of the card back: full URL and variants",
+                    "type": "string"
+                },
+                "backImages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.ImageVariant"
+                    }
+                },
                 "description": {
                     "type": "string"
                 },
@@ -2127,6 +5191,24 @@ const docTemplate = `{
                     "description": "Full URL",
                     "type": "string"
                 },
+                "imageHeight": {
+                    "type": "integer",
+                    "example": 1925
+                },
+                "imageWidth": {
+                    "description": "Size of the original card images in pixels, 0 if unknown",
+                    "type": "integer",
+                    "example": 1100
+                },
+                "majorImageTemplate": {
+                    "description": "Image paths of the cards that have none of their own, see ImageTemplate",
+                    "type": "string",
+                    "example": "rws/major/{number}.jpg"
+                },
+                "minorImageTemplate": {
+                    "type": "string",
+                    "example": "rws/{suit}/{number}.jpg"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2145,6 +5227,11 @@ const docTemplate = `{
         "models.DeckInput": {
             "type": "object",
             "properties": {
+                "backImage": {
+                    "description": "Relative URL",
+                    "type": "string",
+                    "example": "rws/back.jpg"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -2152,6 +5239,24 @@ const docTemplate = `{
                     "description": "Relative URL",
                     "type": "string"
                 },
+                "imageHeight": {
+                    "type": "integer",
+                    "example": 1925
+                },
+                "imageWidth": {
+                    "description": "Size of the original card images in pixels, 0 if unknown",
+                    "type": "integer",
+                    "example": 1100
+                },
+                "majorImageTemplate": {
+                    "description": "Image paths of the cards that have none of their own, see ImageTemplate",
+                    "type": "string",
+                    "example": "rws/major/{number}.jpg"
+                },
+                "minorImageTemplate": {
+                    "type": "string",
+                    "example": "rws/{suit}/{number}.jpg"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2206,6 +5311,53 @@ const docTemplate = `{
                 }
             }
         },
+        "models.Dependents": {
+            "type": "object",
+            "properties": {
+                "combinations": {
+                    "description": "Combinations counts the card combination meanings, which have no IDs",
+                    "type": "integer"
+                },
+                "decks": {
+                    "description": "decks linked to a source",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "majorCards": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "majorMeanings": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minorCards": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minorMeanings": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "sources": {
+                    "description": "sources linked to a deck",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "models.IDOnly": {
             "type": "object",
             "properties": {
@@ -2241,6 +5393,40 @@ const docTemplate = `{
                 }
             }
         },
+        "models.MeaningMajorBatchItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "meaning": {
+                    "type": "string",
+                    "example": "Spiritual wisdom and intuition"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "position": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MeaningPosition"
+                        }
+                    ],
+                    "example": "straight"
+                },
+                "source": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "version": {
+                    "description": "version the meaning is expected at; 0 updates it unconditionally",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
         "models.MeaningMajorInput": {
             "type": "object",
             "properties": {
@@ -2266,6 +5452,42 @@ const docTemplate = `{
                 }
             }
         },
+        "models.MeaningMajorRevision": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "Changes holds the fields that differ from the previous revision",
+                    "type": "object"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "meaning": {
+                    "type": "string",
+                    "example": "Spiritual wisdom and intuition"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "position": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MeaningPosition"
+                        }
+                    ],
+                    "example": "straight"
+                },
+                "revision": {
+                    "description": "the version of the meaning it copies",
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
         "models.MeaningMinor": {
             "type": "object",
             "properties": {
@@ -2289,6 +5511,44 @@ const docTemplate = `{
                 }
             }
         },
+        "models.MeaningMinorBatchItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "meaning": {
+                    "type": "string",
+                    "example": "Active communication and drive"
+                },
+                "position": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MeaningPosition"
+                        }
+                    ],
+                    "example": "straight"
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "source": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "suit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "version": {
+                    "description": "version the meaning is expected at; 0 updates it unconditionally",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
         "models.MeaningMinorInput": {
             "type": "object",
             "properties": {
@@ -2318,6 +5578,46 @@ const docTemplate = `{
                 }
             }
         },
+        "models.MeaningMinorRevision": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "Changes holds the fields that differ from the previous revision",
+                    "type": "object"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "meaning": {
+                    "type": "string",
+                    "example": "Active communication and drive"
+                },
+                "position": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MeaningPosition"
+                        }
+                    ],
+                    "example": "straight"
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "revision": {
+                    "description": "the version of the meaning it copies",
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "suit": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
         "models.MeaningPosition": {
             "type": "string",
             "enum": [
@@ -2351,6 +5651,11 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "slug": {
+                    "description": "{rank} of image templates",
+                    "type": "string",
+                    "example": "ace"
                 }
             }
         },
@@ -2359,6 +5664,45 @@ const docTemplate = `{
             "properties": {
                 "name": {
                     "type": "string"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "ace"
+                }
+            }
+        },
+        "models.ScaffoldInput": {
+            "type": "object",
+            "properties": {
+                "majorImages": {
+                    "type": "string",
+                    "example": "thoth/major/{number}.jpg"
+                },
+                "minorImages": {
+                    "type": "string",
+                    "example": "thoth/minor/{suit}/{rank}.jpg"
+                },
+                "tradition": {
+                    "description": "rider-waite (default) or marseille",
+                    "type": "string",
+                    "example": "marseille"
+                }
+            }
+        },
+        "models.ScaffoldResult": {
+            "type": "object",
+            "properties": {
+                "majorCards": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minorCards": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
@@ -2469,6 +5813,11 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "slug": {
+                    "description": "{suit} of image templates",
+                    "type": "string",
+                    "example": "wands"
                 }
             }
         },
@@ -2483,6 +5832,55 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "wands"
+                }
+            }
+        },
+        "models.TrashItem": {
+            "type": "object",
+            "properties": {
+                "deletedAt": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "deck"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tarot de Marseille"
+                }
+            }
+        },
+        "utils.ImageVariant": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string",
+                    "example": "jpeg"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 1050
+                },
+                "size": {
+                    "description": "\"original\" or the name of a configured size",
+                    "type": "string",
+                    "example": "medium"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "description": "Width and Height are in pixels, if the size of the original is known",
+                    "type": "integer",
+                    "example": 600
                 }
             }
         }
@@ -2,19 +2,58 @@ package app
 
 import (
 	"database/sql"
+	"sync/atomic"
 
+	"github.com/ilbagatto/tarot-api/internal/audit"
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/ilbagatto/tarot-api/internal/imaging"
+	"github.com/ilbagatto/tarot-api/internal/metrics"
+	"github.com/ilbagatto/tarot-api/internal/repository"
 	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
 // App holds dependencies for the application
 type App struct {
-	DB   *sql.DB
-	Echo *echo.Echo
+	Config  *config.Config
+	DB      *sql.DB
+	Repos   *repository.Repositories
+	Echo    *echo.Echo
+	Cache   cache.Cache
+	Metrics *metrics.Metrics
+	Logger  *zap.Logger
+	// Images caches the images rendered by /img; nil renders them on every request
+	Images *imaging.DiskCache
+	// Renderer bounds the images rendered by /img at the same time
+	Renderer *imaging.Renderer
+
+	shuttingDown atomic.Bool
 }
 
-func NewApp(db *sql.DB) *App {
+// NewApp wires the application dependencies.
+// db may be nil when repos are not backed by SQL (e.g. in tests).
+// Writes through App.Repos are recorded in the audit log of repos.
+func NewApp(cfg *config.Config, db *sql.DB, repos *repository.Repositories, c cache.Cache, logger *zap.Logger) *App {
 	return &App{
-		Echo: echo.New(),
-		DB:   db,
+		Config:   cfg,
+		Echo:     echo.New(),
+		DB:       db,
+		Repos:    audit.Wrap(repos),
+		Cache:    c,
+		Metrics:  metrics.New(db, c),
+		Logger:   logger,
+		Renderer: imaging.NewRenderer(cfg.ImageServer.MaxRenders, cfg.ImageServer.RenderTimeout),
 	}
 }
+
+// SetShuttingDown marks the application as draining before shutdown.
+// Readiness checks fail from then on.
+func (a *App) SetShuttingDown() {
+	a.shuttingDown.Store(true)
+}
+
+// IsShuttingDown reports whether graceful shutdown has started
+func (a *App) IsShuttingDown() bool {
+	return a.shuttingDown.Load()
+}
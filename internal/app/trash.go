@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeTrash purges the decks, sources and cards that have been in the trash
+// for longer than the configured retention, at start and then every purge
+// interval, until ctx is done. A zero retention keeps them.
+func (a *App) PurgeTrash(ctx context.Context) {
+	retention := a.Config.Trash.Retention
+	if retention == 0 {
+		return
+	}
+	ticker := time.NewTicker(a.Config.Trash.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		a.purgeTrashOnce(ctx, time.Now().Add(-retention))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeTrashOnce purges the entities trashed before the given time
+func (a *App) purgeTrashOnce(ctx context.Context, before time.Time) {
+	items, err := a.Repos.Trash.PurgeBefore(ctx, before)
+	if err != nil {
+		a.Logger.Error("Could not purge the trash", zap.Error(err), zap.Int("purged", len(items)))
+	}
+	if len(items) > 0 {
+		// Purged sources take their meanings with them
+		a.Cache.DeletePrefix(ctx, "meanings")
+		a.Logger.Info("Purged the trash", zap.Int("purged", len(items)), zap.Time("before", before))
+	}
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPurgeTrash_PurgesExpiredEntities(t *testing.T) {
+	cfg := config.Default()
+	a := NewApp(cfg, nil, memory.New(), cache.New(cfg.Cache.TTL, cfg.Cache.MaxEntries), zap.NewNop())
+	ctx := context.Background()
+
+	deckID, err := a.Repos.Decks.Create(ctx, models.DeckInput{Name: "Thoth"})
+	require.NoError(t, err)
+	sourceID, err := a.Repos.Sources.Create(ctx, models.SourceInput{Name: "Papus"})
+	require.NoError(t, err)
+	require.NoError(t, a.Repos.Decks.Delete(ctx, *deckID, 0))
+
+	a.purgeTrashOnce(ctx, time.Now().Add(-time.Hour))
+	items, err := a.Repos.Trash.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, items, 1, "entities trashed within the retention are kept")
+
+	a.purgeTrashOnce(ctx, time.Now().Add(time.Hour))
+	items, err = a.Repos.Trash.List(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	_, err = a.Repos.Sources.Get(ctx, *sourceID)
+	assert.NoError(t, err, "entities outside the trash are kept")
+
+	entries, err := a.Repos.Audit.List(ctx, models.AuditFilter{Entity: models.TrashDeck})
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	assert.Equal(t, models.AuditPurge, entries[0].Action)
+}
+
+func TestPurgeTrash_ZeroRetentionKeepsEntities(t *testing.T) {
+	cfg := config.Default()
+	cfg.Trash.Retention = 0
+	a := NewApp(cfg, nil, memory.New(), cache.New(cfg.Cache.TTL, cfg.Cache.MaxEntries), zap.NewNop())
+
+	done := make(chan struct{})
+	go func() {
+		a.PurgeTrash(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("PurgeTrash did not return with retention 0")
+	}
+}
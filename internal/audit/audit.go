@@ -0,0 +1,104 @@
+// Package audit records every create, update and delete in the audit log.
+// Wrap decorates the repositories, so that writes are recorded however they
+// are made; the actor is taken from the request context (see WithActor).
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Entity names used in the audit log
+const (
+	EntityDeck         = "deck"
+	EntitySource       = "source"
+	EntitySpread       = "spread"
+	EntitySuit         = "suit"
+	EntityRank         = "rank"
+	EntityCardMajor    = "card_major"
+	EntityCardMinor    = "card_minor"
+	EntityMeaningMajor = "meaning_major"
+	EntityMeaningMinor = "meaning_minor"
+)
+
+// Entities lists all audited entity names
+var Entities = []string{
+	EntityDeck, EntitySource, EntitySpread, EntitySuit, EntityRank,
+	EntityCardMajor, EntityCardMinor, EntityMeaningMajor, EntityMeaningMinor,
+}
+
+// SystemActor is recorded for writes made outside of a request
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// WithActor returns a context whose writes are attributed to actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns who makes the writes in ctx
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
+
+// change holds the values of one field before and after a write;
+// a value is omitted when the field did not exist on that side
+type change struct {
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// Diff compares the JSON forms of two states of an entity field by field and
+// returns the ones that differ. before is nil for a create, after for a delete.
+func Diff(before, after any) (json.RawMessage, error) {
+	old, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]change{}
+	for _, name := range slices.Sorted(maps.Keys(old)) {
+		if !bytes.Equal(old[name], updated[name]) {
+			changes[name] = change{Before: old[name], After: updated[name]}
+		}
+	}
+	for name, value := range updated {
+		if _, ok := old[name]; !ok {
+			changes[name] = change{After: value}
+		}
+	}
+	return json.Marshal(changes)
+}
+
+// fields returns the top-level fields of the JSON form of v
+func fields(v any) (map[string]json.RawMessage, error) {
+	result := map[string]json.RawMessage{}
+	if v == nil {
+		return result, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// now is the clock of recorded entries
+var now = func() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name  string   `json:"name"`
+	Tags  []string `json:"tags,omitempty"`
+	Count int      `json:"count"`
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after any
+		want          string
+	}{
+		{"create", nil, &item{Name: "Thoth", Count: 1},
+			`{"count": {"after": 1}, "name": {"after": "Thoth"}}`},
+		{"update", &item{Name: "Thoth", Count: 1}, &item{Name: "Thoth", Count: 2, Tags: []string{"a"}},
+			`{"count": {"before": 1, "after": 2}, "tags": {"after": ["a"]}}`},
+		{"unchanged", &item{Name: "Thoth"}, &item{Name: "Thoth"}, `{}`},
+		{"delete", &item{Name: "Thoth", Tags: []string{"a"}}, nil,
+			`{"count": {"before": 0}, "name": {"before": "Thoth"}, "tags": {"before": ["a"]}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestActor(t *testing.T) {
+	assert.Equal(t, SystemActor, Actor(context.Background()))
+	assert.Equal(t, "ip:192.0.2.1", Actor(WithActor(context.Background(), "ip:192.0.2.1")))
+}
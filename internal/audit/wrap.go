@@ -0,0 +1,493 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository"
+)
+
+// Wrap returns repositories that record every successful write of repos in
+// repos.Audit, in the transaction of the write (see repos.Tx): a write whose
+// entry cannot be recorded is rolled back. Rows removed by cascading deletes
+// are not recorded separately. Without an audit repository, repos is
+// returned unchanged.
+func Wrap(repos *repository.Repositories) *repository.Repositories {
+	if repos.Audit == nil {
+		return repos
+	}
+	log := auditLog{repos.Audit, repos.Tx}
+	return &repository.Repositories{
+		Decks:    decks{repos.Decks, log},
+		Sources:  sources{repos.Sources, log},
+		Spreads:  spreads{repos.Spreads, log},
+		Suits:    suits{repos.Suits, log},
+		Ranks:    ranks{repos.Ranks, log},
+		Cards:    cards{repos.Cards, log},
+		Meanings: meanings{repos.Meanings, log},
+		Trash:    trash{repos.Trash, log},
+		Audit:    repos.Audit,
+		Tx:       repos.Tx,
+	}
+}
+
+type getter[R any] func(ctx context.Context, id int64) (*R, error)
+
+// auditLog records writes in entries, within their transactions
+type auditLog struct {
+	entries repository.AuditRepository
+	tx      repository.Transactor
+}
+
+// inTx runs fn in a transaction, or as it is without a transactor
+func (l auditLog) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if l.tx == nil {
+		return fn(ctx)
+	}
+	return l.tx.InTx(ctx, fn)
+}
+
+// record appends an entry for a write to the log
+func (l auditLog) record(ctx context.Context, action, entity string, id int64, before, after any) error {
+	changes, err := Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("audit %s of %s %d: %w", action, entity, id, err)
+	}
+	err = l.entries.Record(ctx, models.AuditEntry{
+		At:       now(),
+		Actor:    Actor(ctx),
+		Action:   action,
+		Entity:   entity,
+		EntityID: id,
+		Changes:  changes,
+	})
+	if err != nil {
+		return fmt.Errorf("audit %s of %s %d: %w", action, entity, id, err)
+	}
+	return nil
+}
+
+// created runs create and records the entity it created
+func created[R any](ctx context.Context, log auditLog, entity string,
+	get getter[R], create func(ctx context.Context) (*int64, error)) (*int64, error) {
+	var id *int64
+	err := log.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = create(ctx); err != nil {
+			return err
+		}
+		after, err := get(ctx, *id)
+		if err != nil {
+			return err
+		}
+		return log.record(ctx, models.AuditCreate, entity, *id, nil, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// updated runs update of entity id and records it along with the changed fields
+func updated[R any](ctx context.Context, log auditLog, entity string,
+	get getter[R], id int64, update func(ctx context.Context) error) error {
+	return log.inTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, id)
+		if err != nil {
+			// A missing entity is reported by update itself
+			return update(ctx)
+		}
+		if err := update(ctx); err != nil {
+			return err
+		}
+		after, err := get(ctx, id)
+		if err != nil {
+			return err
+		}
+		return log.record(ctx, models.AuditUpdate, entity, id, before, after)
+	})
+}
+
+// deleted runs del of entity id and records it with the last state of the entity.
+// Deleting a missing entity records nothing.
+func deleted[R any](ctx context.Context, log auditLog, entity string,
+	get getter[R], id int64, del func(ctx context.Context) error) error {
+	return log.inTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, id)
+		if err != nil {
+			return del(ctx)
+		}
+		if err := del(ctx); err != nil {
+			return err
+		}
+		return log.record(ctx, models.AuditDelete, entity, id, before, nil)
+	})
+}
+
+// batched runs write, a batch of items with the given IDs (0 for a create),
+// and records each item it wrote: updates with the changed fields, as updated does
+func batched[R any](ctx context.Context, log auditLog, entity string, get getter[R], ids []int64,
+	write func(ctx context.Context) ([]models.BatchResult, error)) ([]models.BatchResult, error) {
+	var results []models.BatchResult
+	err := log.inTx(ctx, func(ctx context.Context) error {
+		before := make([]*R, len(ids))
+		for i, id := range ids {
+			if id != 0 {
+				// A missing entity is reported by the item itself
+				before[i], _ = get(ctx, id)
+			}
+		}
+		var err error
+		if results, err = write(ctx); err != nil {
+			return err
+		}
+		for i, result := range results {
+			if result.Err != nil || result.ID == 0 {
+				continue
+			}
+			after, err := get(ctx, result.ID)
+			if err != nil {
+				return err
+			}
+			if result.Created {
+				err = log.record(ctx, models.AuditCreate, entity, result.ID, nil, after)
+			} else {
+				err = log.record(ctx, models.AuditUpdate, entity, result.ID, before[i], after)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// itemIDs returns the IDs of the items of a batch
+func itemIDs[T any](items []T, id func(T) int64) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = id(item)
+	}
+	return ids
+}
+
+type decks struct {
+	repository.DeckRepository
+	log auditLog
+}
+
+func (r decks) Create(ctx context.Context, input models.DeckInput) (*int64, error) {
+	return created(ctx, r.log, EntityDeck, r.Get, func(ctx context.Context) (*int64, error) {
+		return r.DeckRepository.Create(ctx, input)
+	})
+}
+
+func (r decks) Update(ctx context.Context, id int64, input models.DeckInput, version int64) error {
+	return updated(ctx, r.log, EntityDeck, r.Get, id, func(ctx context.Context) error {
+		return r.DeckRepository.Update(ctx, id, input, version)
+	})
+}
+
+func (r decks) Delete(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityDeck, r.Get, id, func(ctx context.Context) error {
+		return r.DeckRepository.Delete(ctx, id, version)
+	})
+}
+
+type sources struct {
+	repository.SourceRepository
+	log auditLog
+}
+
+func (r sources) Create(ctx context.Context, input models.SourceInput) (*int64, error) {
+	return created(ctx, r.log, EntitySource, r.Get, func(ctx context.Context) (*int64, error) {
+		return r.SourceRepository.Create(ctx, input)
+	})
+}
+
+func (r sources) Update(ctx context.Context, id int64, input models.SourceInput, version int64) error {
+	return updated(ctx, r.log, EntitySource, r.Get, id, func(ctx context.Context) error {
+		return r.SourceRepository.Update(ctx, id, input, version)
+	})
+}
+
+func (r sources) Delete(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntitySource, r.Get, id, func(ctx context.Context) error {
+		return r.SourceRepository.Delete(ctx, id, version)
+	})
+}
+
+type spreads struct {
+	repository.SpreadRepository
+	log auditLog
+}
+
+func (r spreads) Create(ctx context.Context, input models.SpreadInput) (*int64, error) {
+	return created(ctx, r.log, EntitySpread, r.Get, func(ctx context.Context) (*int64, error) {
+		return r.SpreadRepository.Create(ctx, input)
+	})
+}
+
+func (r spreads) Update(ctx context.Context, id int64, input models.SpreadInput, version int64) error {
+	return updated(ctx, r.log, EntitySpread, r.Get, id, func(ctx context.Context) error {
+		return r.SpreadRepository.Update(ctx, id, input, version)
+	})
+}
+
+func (r spreads) Delete(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntitySpread, r.Get, id, func(ctx context.Context) error {
+		return r.SpreadRepository.Delete(ctx, id, version)
+	})
+}
+
+type suits struct {
+	repository.SuitRepository
+	log auditLog
+}
+
+func (r suits) Create(ctx context.Context, input models.SuitInput) (*int64, error) {
+	return created(ctx, r.log, EntitySuit, r.Get, func(ctx context.Context) (*int64, error) {
+		return r.SuitRepository.Create(ctx, input)
+	})
+}
+
+func (r suits) Update(ctx context.Context, id int64, input models.SuitInput, version int64) error {
+	return updated(ctx, r.log, EntitySuit, r.Get, id, func(ctx context.Context) error {
+		return r.SuitRepository.Update(ctx, id, input, version)
+	})
+}
+
+func (r suits) Delete(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntitySuit, r.Get, id, func(ctx context.Context) error {
+		return r.SuitRepository.Delete(ctx, id, version)
+	})
+}
+
+type ranks struct {
+	repository.RankRepository
+	log auditLog
+}
+
+func (r ranks) Create(ctx context.Context, input models.RankInput) (*int64, error) {
+	return created(ctx, r.log, EntityRank, r.Get, func(ctx context.Context) (*int64, error) {
+		return r.RankRepository.Create(ctx, input)
+	})
+}
+
+func (r ranks) Update(ctx context.Context, id int64, input models.RankInput, version int64) error {
+	return updated(ctx, r.log, EntityRank, r.Get, id, func(ctx context.Context) error {
+		return r.RankRepository.Update(ctx, id, input, version)
+	})
+}
+
+func (r ranks) Delete(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityRank, r.Get, id, func(ctx context.Context) error {
+		return r.RankRepository.Delete(ctx, id, version)
+	})
+}
+
+type cards struct {
+	repository.CardRepository
+	log auditLog
+}
+
+func (r cards) CreateMajor(ctx context.Context, input models.CardMajorInput) (*int64, error) {
+	return created(ctx, r.log, EntityCardMajor, r.GetMajor, func(ctx context.Context) (*int64, error) {
+		return r.CardRepository.CreateMajor(ctx, input)
+	})
+}
+
+func (r cards) UpdateMajor(ctx context.Context, id int64, input models.CardMajorInput, version int64) error {
+	return updated(ctx, r.log, EntityCardMajor, r.GetMajor, id, func(ctx context.Context) error {
+		return r.CardRepository.UpdateMajor(ctx, id, input, version)
+	})
+}
+
+func (r cards) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityCardMajor, r.GetMajor, id, func(ctx context.Context) error {
+		return r.CardRepository.DeleteMajor(ctx, id, version)
+	})
+}
+
+func (r cards) BatchMajor(ctx context.Context, items []models.CardMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	ids := itemIDs(items, func(item models.CardMajorBatchItem) int64 { return item.ID })
+	return batched(ctx, r.log, EntityCardMajor, r.GetMajor, ids, func(ctx context.Context) ([]models.BatchResult, error) {
+		return r.CardRepository.BatchMajor(ctx, items, atomic)
+	})
+}
+
+func (r cards) CreateMinor(ctx context.Context, input models.CardMinorInput) (*int64, error) {
+	return created(ctx, r.log, EntityCardMinor, r.GetMinor, func(ctx context.Context) (*int64, error) {
+		return r.CardRepository.CreateMinor(ctx, input)
+	})
+}
+
+func (r cards) UpdateMinor(ctx context.Context, id int64, input models.CardMinorInput, version int64) error {
+	return updated(ctx, r.log, EntityCardMinor, r.GetMinor, id, func(ctx context.Context) error {
+		return r.CardRepository.UpdateMinor(ctx, id, input, version)
+	})
+}
+
+func (r cards) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityCardMinor, r.GetMinor, id, func(ctx context.Context) error {
+		return r.CardRepository.DeleteMinor(ctx, id, version)
+	})
+}
+
+func (r cards) BatchMinor(ctx context.Context, items []models.CardMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	ids := itemIDs(items, func(item models.CardMinorBatchItem) int64 { return item.ID })
+	return batched(ctx, r.log, EntityCardMinor, r.GetMinor, ids, func(ctx context.Context) ([]models.BatchResult, error) {
+		return r.CardRepository.BatchMinor(ctx, items, atomic)
+	})
+}
+
+func (r cards) Scaffold(ctx context.Context, deckID int64, input models.ScaffoldInput) (*models.ScaffoldResult, error) {
+	var result *models.ScaffoldResult
+	err := r.log.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		if result, err = r.CardRepository.Scaffold(ctx, deckID, input); err != nil {
+			return err
+		}
+		for _, id := range result.MajorCards {
+			card, err := r.GetMajor(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err := r.log.record(ctx, models.AuditCreate, EntityCardMajor, id, nil, card); err != nil {
+				return err
+			}
+		}
+		for _, id := range result.MinorCards {
+			card, err := r.GetMinor(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err := r.log.record(ctx, models.AuditCreate, EntityCardMinor, id, nil, card); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type meanings struct {
+	repository.MeaningRepository
+	log auditLog
+}
+
+func (r meanings) CreateMajor(ctx context.Context, input models.MeaningMajorInput) (*int64, error) {
+	return created(ctx, r.log, EntityMeaningMajor, r.GetMajor, func(ctx context.Context) (*int64, error) {
+		return r.MeaningRepository.CreateMajor(ctx, input)
+	})
+}
+
+func (r meanings) UpdateMajor(ctx context.Context, id int64, input models.MeaningMajorInput, version int64) error {
+	return updated(ctx, r.log, EntityMeaningMajor, r.GetMajor, id, func(ctx context.Context) error {
+		return r.MeaningRepository.UpdateMajor(ctx, id, input, version)
+	})
+}
+
+func (r meanings) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityMeaningMajor, r.GetMajor, id, func(ctx context.Context) error {
+		return r.MeaningRepository.DeleteMajor(ctx, id, version)
+	})
+}
+
+func (r meanings) BatchMajor(ctx context.Context, items []models.MeaningMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	ids := itemIDs(items, func(item models.MeaningMajorBatchItem) int64 { return item.ID })
+	return batched(ctx, r.log, EntityMeaningMajor, r.GetMajor, ids, func(ctx context.Context) ([]models.BatchResult, error) {
+		return r.MeaningRepository.BatchMajor(ctx, items, atomic)
+	})
+}
+
+func (r meanings) CreateMinor(ctx context.Context, input models.MeaningMinorInput) (*int64, error) {
+	return created(ctx, r.log, EntityMeaningMinor, r.GetMinor, func(ctx context.Context) (*int64, error) {
+		return r.MeaningRepository.CreateMinor(ctx, input)
+	})
+}
+
+func (r meanings) UpdateMinor(ctx context.Context, id int64, input models.MeaningMinorInput, version int64) error {
+	return updated(ctx, r.log, EntityMeaningMinor, r.GetMinor, id, func(ctx context.Context) error {
+		return r.MeaningRepository.UpdateMinor(ctx, id, input, version)
+	})
+}
+
+func (r meanings) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	return deleted(ctx, r.log, EntityMeaningMinor, r.GetMinor, id, func(ctx context.Context) error {
+		return r.MeaningRepository.DeleteMinor(ctx, id, version)
+	})
+}
+
+func (r meanings) BatchMinor(ctx context.Context, items []models.MeaningMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	ids := itemIDs(items, func(item models.MeaningMinorBatchItem) int64 { return item.ID })
+	return batched(ctx, r.log, EntityMeaningMinor, r.GetMinor, ids, func(ctx context.Context) ([]models.BatchResult, error) {
+		return r.MeaningRepository.BatchMinor(ctx, items, atomic)
+	})
+}
+
+type trash struct {
+	repository.TrashRepository
+	log auditLog
+}
+
+// deletedAt is the state of a trashed entity recorded by a restore
+type deletedAt struct {
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+func (r trash) Restore(ctx context.Context, entity string, id int64) error {
+	return r.log.inTx(ctx, func(ctx context.Context) error {
+		item, err := r.Get(ctx, entity, id)
+		if err != nil {
+			return r.TrashRepository.Restore(ctx, entity, id)
+		}
+		if err := r.TrashRepository.Restore(ctx, entity, id); err != nil {
+			return err
+		}
+		return r.log.record(ctx, models.AuditRestore, entity, id, deletedAt{item.DeletedAt}, nil)
+	})
+}
+
+func (r trash) Purge(ctx context.Context, entity string, id int64) error {
+	return r.log.inTx(ctx, func(ctx context.Context) error {
+		item, err := r.Get(ctx, entity, id)
+		if err != nil {
+			return r.TrashRepository.Purge(ctx, entity, id)
+		}
+		if err := r.TrashRepository.Purge(ctx, entity, id); err != nil {
+			return err
+		}
+		return r.log.record(ctx, models.AuditPurge, entity, id, item, nil)
+	})
+}
+
+// PurgeBefore purges all of the entities or, if one fails, none of them
+func (r trash) PurgeBefore(ctx context.Context, before time.Time) ([]models.TrashItem, error) {
+	var items []models.TrashItem
+	err := r.log.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		if items, err = r.TrashRepository.PurgeBefore(ctx, before); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := r.log.record(ctx, models.AuditPurge, item.Entity, item.ID, item, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
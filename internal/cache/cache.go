@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Cache is a key-value store for serialized model lookups.
+// Values are opaque byte slices so that the in-memory implementation can be
+// replaced by a networked one (e.g. Redis) without changing callers.
+type Cache interface {
+	// Get returns the value stored under key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set stores value under key, replacing any previous value.
+	Set(ctx context.Context, key string, value []byte)
+	// DeletePrefix removes all keys starting with any of the given prefixes.
+	DeletePrefix(ctx context.Context, prefixes ...string)
+	// Stats returns usage counters since the cache was created.
+	Stats() Stats
+}
+
+// Stats holds cache usage counters
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Entries   int    `json:"entries"`
+}
+
+// Fetch returns the value cached under key, or calls load and caches its result.
+// Errors returned by load are passed through and never cached.
+func Fetch[T any](ctx context.Context, c Cache, key string, load func() (T, error)) (T, error) {
+	if data, ok := c.Get(ctx, key); ok {
+		var cached T
+		if err := json.Unmarshal(data, &cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		c.Set(ctx, key, data)
+	}
+	return value, nil
+}
+
+// New creates the application cache holding up to maxEntries values for ttl.
+// A maxEntries of 0 disables caching.
+func New(ttl time.Duration, maxEntries int) Cache {
+	if maxEntries <= 0 {
+		return NewNoop()
+	}
+	return NewMemory(ttl, maxEntries)
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// Memory is an in-process LRU cache with a per-entry time-to-live
+type Memory struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	items      map[string]*list.Element
+	order      *list.List // front = most recently used
+	stats      Stats
+	now        func() time.Time
+}
+
+// NewMemory creates an in-memory cache holding at most maxEntries values,
+// each of them valid for ttl (0 means no expiry).
+func NewMemory(ttl time.Duration, maxEntries int) *Memory {
+	return &Memory{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get returns a cached value unless it is missing or expired
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		m.stats.Misses++
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !e.expires.IsZero() && m.now().After(e.expires) {
+		m.remove(el)
+		m.stats.Misses++
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	m.stats.Hits++
+	return e.value, true
+}
+
+// Set stores a value, evicting the least recently used entry if the cache is full
+func (m *Memory) Set(_ context.Context, key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expires time.Time
+	if m.ttl > 0 {
+		expires = m.now().Add(m.ttl)
+	}
+
+	if el, ok := m.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value = value
+		e.expires = expires
+		m.order.MoveToFront(el)
+		return
+	}
+
+	m.items[key] = m.order.PushFront(&entry{key: key, value: value, expires: expires})
+	for m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+		m.stats.Evictions++
+	}
+}
+
+// DeletePrefix removes every entry whose key starts with one of the prefixes
+func (m *Memory) DeletePrefix(_ context.Context, prefixes ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, el := range m.items {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				m.remove(el)
+				break
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the usage counters
+func (m *Memory) Stats() Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.stats
+	s.Entries = m.order.Len()
+	return s
+}
+
+func (m *Memory) remove(el *list.Element) {
+	m.order.Remove(el)
+	delete(m.items, el.Value.(*entry).key)
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory_GetSet(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(time.Minute, 10)
+
+	_, ok := m.Get(ctx, "suits")
+	assert.False(t, ok)
+
+	m.Set(ctx, "suits", []byte("[]"))
+	v, ok := m.Get(ctx, "suits")
+	require.True(t, ok)
+	assert.Equal(t, "[]", string(v))
+
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Entries: 1}, m.Stats())
+}
+
+func TestMemory_Expiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewMemory(time.Minute, 10)
+	m.now = func() time.Time { return now }
+
+	m.Set(ctx, "ranks", []byte("1"))
+	now = now.Add(2 * time.Minute)
+
+	_, ok := m.Get(ctx, "ranks")
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Stats().Entries)
+}
+
+func TestMemory_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(0, 2)
+
+	m.Set(ctx, "a", []byte("1"))
+	m.Set(ctx, "b", []byte("2"))
+	m.Get(ctx, "a") // "b" becomes the least recently used
+	m.Set(ctx, "c", []byte("3"))
+
+	_, ok := m.Get(ctx, "b")
+	assert.False(t, ok)
+	_, ok = m.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), m.Stats().Evictions)
+}
+
+func TestMemory_DeletePrefix(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(0, 10)
+	m.Set(ctx, "decks", []byte("1"))
+	m.Set(ctx, "decks:3", []byte("2"))
+	m.Set(ctx, "cards:minor:deck:3", []byte("3"))
+	m.Set(ctx, "suits", []byte("4"))
+
+	m.DeletePrefix(ctx, "decks", "cards:minor")
+
+	assert.Equal(t, 1, m.Stats().Entries)
+	_, ok := m.Get(ctx, "suits")
+	assert.True(t, ok)
+}
+
+func TestFetch_LoadsOnceAndCaches(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(time.Minute, 10)
+	calls := 0
+	load := func() ([]int, error) {
+		calls++
+		return []int{1, 2, 3}, nil
+	}
+
+	first, err := Fetch(ctx, m, "numbers", load)
+	require.NoError(t, err)
+	second, err := Fetch(ctx, m, "numbers", load)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 2, 3}, first)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, calls)
+}
+
+func TestFetch_DoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(time.Minute, 10)
+	boom := errors.New("boom")
+
+	_, err := Fetch(ctx, m, "key", func() (string, error) { return "", boom })
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 0, m.Stats().Entries)
+}
+
+func TestNoop_NeverStores(t *testing.T) {
+	ctx := context.Background()
+	n := NewNoop()
+	n.Set(ctx, "key", []byte("value"))
+
+	_, ok := n.Get(ctx, "key")
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), n.Stats().Misses)
+}
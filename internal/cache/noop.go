@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Noop is a cache that never stores anything. Every lookup is a miss.
+type Noop struct {
+	misses atomic.Uint64
+}
+
+// NewNoop creates a disabled cache
+func NewNoop() *Noop {
+	return &Noop{}
+}
+
+func (n *Noop) Get(context.Context, string) ([]byte, bool) {
+	n.misses.Add(1)
+	return nil, false
+}
+
+func (n *Noop) Set(context.Context, string, []byte) {}
+
+func (n *Noop) DeletePrefix(context.Context, ...string) {}
+
+func (n *Noop) Stats() Stats {
+	return Stats{Misses: n.misses.Load()}
+}
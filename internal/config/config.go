@@ -0,0 +1,577 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds all application settings.
+// Values are resolved in order: built-in defaults, the YAML file named by
+// CONFIG_FILE (if any), then environment variables (including those from .env).
+type Config struct {
+	// Env is the deployment environment: "dev" enables CORS for localhost
+	Env         string            `yaml:"env"`
+	Server      ServerConfig      `yaml:"server"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Static      StaticConfig      `yaml:"static"`
+	ImageServer ImageServerConfig `yaml:"image_server"`
+	Log         LogConfig         `yaml:"log"`
+	Cache       CacheConfig       `yaml:"cache"`
+	HTTPCache   HTTPCacheConfig   `yaml:"http_cache"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	CORS        CORSConfig        `yaml:"cors"`
+	Concurrency ConcurrencyConfig `yaml:"concurrency"`
+	Trash       TrashConfig       `yaml:"trash"`
+}
+
+// ServerConfig configures the HTTP listener and its lifecycle
+type ServerConfig struct {
+	Port             int           `yaml:"port"`
+	ShutdownDelay    time.Duration `yaml:"shutdown_delay"`
+	ReadinessTimeout time.Duration `yaml:"readiness_timeout"`
+	// TrustedProxies lists the IPs or CIDR ranges of reverse proxies whose
+	// X-Forwarded-For header is trusted to carry the client IP
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+// DatabaseConfig configures the database connection pool.
+// Zero values mean no limit; SQLite ignores the pool settings.
+type DatabaseConfig struct {
+	// DSN selects the backend: "sqlite:<path>" (or "sqlite::memory:") for the
+	// embedded SQLite database, a postgres URL or key=value string otherwise
+	DSN             string        `yaml:"dsn"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// StatementTimeout aborts any single statement running longer on the server
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+}
+
+// StaticConfig configures card images
+type StaticConfig struct {
+	// URL is the public base URL images are served from; image URLs are omitted when empty
+	URL string `yaml:"url"`
+	// Dir is the local image directory, checked by /readyz and served under /images
+	Dir string `yaml:"dir"`
+	// Sizes are the renditions of the card images besides the original, each
+	// listed in every one of Formats and rendered by the image server
+	Sizes   []ImageSize `yaml:"sizes"`
+	Formats []string    `yaml:"formats"`
+}
+
+// ImageSize is a rendition of the images, scaled to Width
+type ImageSize struct {
+	Name  string `yaml:"name"`
+	Width int    `yaml:"width"`
+}
+
+// ImageServerConfig configures /img, which renders the card images of Static.Dir
+// scaled, cropped and converted on request
+type ImageServerConfig struct {
+	// CacheDir keeps the rendered images; empty renders them on every request
+	CacheDir string `yaml:"cache_dir"`
+	// CacheSizeMB bounds the size of CacheDir, the least recently used images
+	// are evicted beyond it
+	CacheSizeMB int `yaml:"cache_size_mb"`
+	// MaxDimension bounds the requested width and height in pixels
+	MaxDimension int `yaml:"max_dimension"`
+	// ThumbnailWidth is the width of the images served under /thumbnails
+	ThumbnailWidth int `yaml:"thumbnail_width"`
+	// SizeStep rounds up the requested width and height to a multiple of it,
+	// unless they are the width of a size of Static.Sizes or ThumbnailWidth
+	SizeStep int `yaml:"size_step"`
+	// MaxRenders bounds the images rendered at the same time
+	MaxRenders int `yaml:"max_renders"`
+	// RenderTimeout bounds a render, waiting for a free slot included. It runs
+	// on when the request that started it ends, as other requests may share it.
+	RenderTimeout time.Duration `yaml:"render_timeout"`
+}
+
+// LogConfig configures the logger
+type LogConfig struct {
+	Format string `yaml:"format"`
+}
+
+// CacheConfig configures the in-process cache; MaxEntries 0 disables it
+type CacheConfig struct {
+	TTL        time.Duration `yaml:"ttl"`
+	MaxEntries int           `yaml:"max_entries"`
+}
+
+// HTTPCacheConfig holds Cache-Control values per route group.
+// "none" (or an empty value) means no Cache-Control header.
+type HTTPCacheConfig struct {
+	Reference string `yaml:"reference"`
+	Cards     string `yaml:"cards"`
+	Meanings  string `yaml:"meanings"`
+	Images    string `yaml:"images"`
+}
+
+// CacheControl returns the header value to send, or "" when disabled
+func CacheControl(value string) string {
+	if value == "none" {
+		return ""
+	}
+	return value
+}
+
+// TracingConfig configures OpenTelemetry tracing
+type TracingConfig struct {
+	Exporter    string `yaml:"exporter"`
+	ServiceName string `yaml:"service_name"`
+}
+
+// RateLimitConfig configures per-client token buckets, kept separately for
+// reads (GET, HEAD, OPTIONS) and writes
+type RateLimitConfig struct {
+	Read  RateLimit `yaml:"read"`
+	Write RateLimit `yaml:"write"`
+	// APIKeys are the keys accepted in the X-API-Key header. Each one gets its
+	// own buckets; requests without a known key are limited per client IP.
+	APIKeys []string `yaml:"api_keys"`
+}
+
+// RateLimit is a token bucket refilled at Rate requests per second and holding
+// at most Burst requests. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// CORSConfig configures cross-origin requests. Without allowed origins
+// CORS is disabled, except for localhost origins in dev.
+type CORSConfig struct {
+	// AllowOrigins lists origins such as "https://tarot.example.com";
+	// "https://*.example.com" matches any subdomain and "*" any origin
+	AllowOrigins     []string      `yaml:"allow_origins"`
+	AllowMethods     []string      `yaml:"allow_methods"`
+	AllowHeaders     []string      `yaml:"allow_headers"`
+	ExposeHeaders    []string      `yaml:"expose_headers"`
+	AllowCredentials bool          `yaml:"allow_credentials"`
+	MaxAge           time.Duration `yaml:"max_age"`
+}
+
+// ConcurrencyConfig configures optimistic concurrency control of writes
+type ConcurrencyConfig struct {
+	// RequireIfMatch rejects PUT, PATCH and DELETE requests without If-Match
+	// with 428; otherwise such requests overwrite unconditionally
+	RequireIfMatch bool `yaml:"require_if_match"`
+}
+
+// TrashConfig configures how long deleted decks, sources and cards are kept
+type TrashConfig struct {
+	// Retention is the time after which trashed entities are purged; 0 keeps them
+	Retention time.Duration `yaml:"retention"`
+	// PurgeInterval is the time between two runs of the purge job
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+// ParseTrustedProxies converts IPs and CIDR ranges into networks
+func ParseTrustedProxies(list []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(list))
+	for _, entry := range list {
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_PROXIES: invalid IP or CIDR %q", entry)
+		}
+		nets = append(nets, network)
+	}
+	return nets, nil
+}
+
+// Supported values of enumerated settings
+var (
+	LogFormats       = []string{"color", "development", "json"}
+	TracingExporters = []string{"none", "otlp", "stdout"}
+	ImageFormats     = []string{"jpeg", "png", "gif", "webp", "avif"} // rendered by the image server
+)
+
+// Default returns the configuration used when nothing is set
+func Default() *Config {
+	return &Config{
+		Env: "prod",
+		Server: ServerConfig{
+			Port:             8080,
+			ReadinessTimeout: 2 * time.Second,
+		},
+		Database: DatabaseConfig{
+			MaxOpenConns:    20,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		},
+		Static: StaticConfig{
+			Dir: "static/images",
+			Sizes: []ImageSize{
+				{Name: "thumb", Width: 240},
+				{Name: "medium", Width: 600},
+				{Name: "full", Width: 1200},
+			},
+			Formats: []string{"jpeg"},
+		},
+		ImageServer: ImageServerConfig{
+			CacheDir:       "cache/images",
+			CacheSizeMB:    512,
+			MaxDimension:   2400,
+			ThumbnailWidth: 240,
+			SizeStep:       50,
+			MaxRenders:     4,
+			RenderTimeout:  30 * time.Second,
+		},
+		Log: LogConfig{Format: "development"},
+		Cache: CacheConfig{
+			TTL:        5 * time.Minute,
+			MaxEntries: 1000,
+		},
+		HTTPCache: HTTPCacheConfig{
+			Reference: "public, max-age=3600",
+			Cards:     "public, max-age=600",
+			Meanings:  "public, max-age=600",
+			Images:    "public, max-age=86400",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			ServiceName: "tarot-api",
+		},
+		CORS: CORSConfig{
+			AllowMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			AllowHeaders: []string{
+				"Origin", "Accept", "Content-Type", "Authorization",
+				"X-API-Key", "X-Request-ID", "If-Match", "If-None-Match",
+			},
+			ExposeHeaders: []string{
+				"ETag", "Last-Modified", "Location", "X-Request-ID", "Retry-After",
+				"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset",
+			},
+			MaxAge: 10 * time.Minute,
+		},
+		Trash: TrashConfig{
+			Retention:     30 * 24 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+	}
+}
+
+// Load reads .env, the optional CONFIG_FILE and the environment, then validates
+// the result. All invalid or missing values are reported together.
+func Load() (*Config, error) {
+	_ = godotenv.Load()
+
+	cfg := Default()
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+
+	errs := cfg.loadEnv(os.LookupEnv)
+	errs = append(errs, cfg.validate()...)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return cfg, nil
+}
+
+// IsDev reports whether the application runs in the development environment
+func (c *Config) IsDev() bool {
+	return c.Env == "dev"
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, c)
+}
+
+// loadEnv overrides settings with non-empty environment variables
+// and returns parse errors for the ones that are malformed.
+func (c *Config) loadEnv(lookup func(string) (string, bool)) []error {
+	r := envReader{lookup: lookup}
+
+	r.string("APP_ENV", &c.Env)
+	r.int("SERVER_PORT", &c.Server.Port)
+	r.duration("SHUTDOWN_DELAY", &c.Server.ShutdownDelay)
+	r.duration("READINESS_TIMEOUT", &c.Server.ReadinessTimeout)
+	r.string("POSTGRES_DSN", &c.Database.DSN)
+	r.string("DATABASE_DSN", &c.Database.DSN)
+	r.int("DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns)
+	r.int("DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns)
+	r.duration("DB_CONN_MAX_LIFETIME", &c.Database.ConnMaxLifetime)
+	r.duration("DB_CONN_MAX_IDLE_TIME", &c.Database.ConnMaxIdleTime)
+	r.duration("DB_STATEMENT_TIMEOUT", &c.Database.StatementTimeout)
+	r.string("STATIC_URL", &c.Static.URL)
+	r.string("STATIC_DIR", &c.Static.Dir)
+	r.list("STATIC_FORMATS", &c.Static.Formats)
+	r.string("IMAGE_CACHE_DIR", &c.ImageServer.CacheDir)
+	r.int("IMAGE_CACHE_SIZE_MB", &c.ImageServer.CacheSizeMB)
+	r.int("IMAGE_MAX_DIMENSION", &c.ImageServer.MaxDimension)
+	r.int("IMAGE_THUMBNAIL_WIDTH", &c.ImageServer.ThumbnailWidth)
+	r.int("IMAGE_SIZE_STEP", &c.ImageServer.SizeStep)
+	r.int("IMAGE_MAX_RENDERS", &c.ImageServer.MaxRenders)
+	r.duration("IMAGE_RENDER_TIMEOUT", &c.ImageServer.RenderTimeout)
+	r.string("LOG_FORMAT", &c.Log.Format)
+	r.duration("CACHE_TTL", &c.Cache.TTL)
+	r.int("CACHE_MAX_ENTRIES", &c.Cache.MaxEntries)
+	r.string("CACHE_CONTROL_REFERENCE", &c.HTTPCache.Reference)
+	r.string("CACHE_CONTROL_CARDS", &c.HTTPCache.Cards)
+	r.string("CACHE_CONTROL_MEANINGS", &c.HTTPCache.Meanings)
+	r.string("CACHE_CONTROL_IMAGES", &c.HTTPCache.Images)
+	r.string("OTEL_TRACES_EXPORTER", &c.Tracing.Exporter)
+	r.string("OTEL_SERVICE_NAME", &c.Tracing.ServiceName)
+	r.list("TRUSTED_PROXIES", &c.Server.TrustedProxies)
+	r.float("RATE_LIMIT_READ_RATE", &c.RateLimit.Read.Rate)
+	r.int("RATE_LIMIT_READ_BURST", &c.RateLimit.Read.Burst)
+	r.float("RATE_LIMIT_WRITE_RATE", &c.RateLimit.Write.Rate)
+	r.int("RATE_LIMIT_WRITE_BURST", &c.RateLimit.Write.Burst)
+	r.list("RATE_LIMIT_API_KEYS", &c.RateLimit.APIKeys)
+	r.list("CORS_ALLOW_ORIGINS", &c.CORS.AllowOrigins)
+	r.list("CORS_ALLOW_METHODS", &c.CORS.AllowMethods)
+	r.list("CORS_ALLOW_HEADERS", &c.CORS.AllowHeaders)
+	r.list("CORS_EXPOSE_HEADERS", &c.CORS.ExposeHeaders)
+	r.bool("CORS_ALLOW_CREDENTIALS", &c.CORS.AllowCredentials)
+	r.duration("CORS_MAX_AGE", &c.CORS.MaxAge)
+	r.bool("REQUIRE_IF_MATCH", &c.Concurrency.RequireIfMatch)
+	r.duration("TRASH_RETENTION", &c.Trash.Retention)
+	r.duration("TRASH_PURGE_INTERVAL", &c.Trash.PurgeInterval)
+
+	return r.errs
+}
+
+func (c *Config) validate() []error {
+	var errs []error
+	if c.Database.DSN == "" {
+		errs = append(errs, errors.New("DATABASE_DSN (or POSTGRES_DSN) is required"))
+	}
+	for _, limit := range []struct {
+		key   string
+		value int64
+	}{
+		{"DB_MAX_OPEN_CONNS", int64(c.Database.MaxOpenConns)},
+		{"DB_MAX_IDLE_CONNS", int64(c.Database.MaxIdleConns)},
+		{"DB_CONN_MAX_LIFETIME", int64(c.Database.ConnMaxLifetime)},
+		{"DB_CONN_MAX_IDLE_TIME", int64(c.Database.ConnMaxIdleTime)},
+		{"DB_STATEMENT_TIMEOUT", int64(c.Database.StatementTimeout)},
+	} {
+		if limit.value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", limit.key))
+		}
+	}
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("SERVER_PORT must be between 1 and 65535, got %d", c.Server.Port))
+	}
+	if c.Server.ShutdownDelay < 0 {
+		errs = append(errs, fmt.Errorf("SHUTDOWN_DELAY must not be negative, got %s", c.Server.ShutdownDelay))
+	}
+	if c.Server.ReadinessTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("READINESS_TIMEOUT must be positive, got %s", c.Server.ReadinessTimeout))
+	}
+	if !slices.Contains(LogFormats, c.Log.Format) {
+		errs = append(errs, fmt.Errorf("LOG_FORMAT must be one of %s, got %q", strings.Join(LogFormats, ", "), c.Log.Format))
+	}
+	if c.Cache.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("CACHE_TTL must be positive, got %s", c.Cache.TTL))
+	}
+	if c.Cache.MaxEntries < 0 {
+		errs = append(errs, fmt.Errorf("CACHE_MAX_ENTRIES must not be negative, got %d", c.Cache.MaxEntries))
+	}
+	errs = append(errs, c.Static.validate()...)
+	if c.ImageServer.CacheSizeMB <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_CACHE_SIZE_MB must be positive, got %d", c.ImageServer.CacheSizeMB))
+	}
+	if c.ImageServer.MaxDimension <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_MAX_DIMENSION must be positive, got %d", c.ImageServer.MaxDimension))
+	}
+	if c.ImageServer.ThumbnailWidth <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_THUMBNAIL_WIDTH must be positive, got %d", c.ImageServer.ThumbnailWidth))
+	}
+	if c.ImageServer.SizeStep <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_SIZE_STEP must be positive, got %d", c.ImageServer.SizeStep))
+	}
+	if c.ImageServer.MaxRenders <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_MAX_RENDERS must be positive, got %d", c.ImageServer.MaxRenders))
+	}
+	if c.ImageServer.RenderTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("IMAGE_RENDER_TIMEOUT must be positive, got %s", c.ImageServer.RenderTimeout))
+	}
+	for _, size := range c.Static.Sizes {
+		// The image server would refuse to render it
+		if c.ImageServer.MaxDimension > 0 && size.Width > c.ImageServer.MaxDimension {
+			errs = append(errs, fmt.Errorf("static.sizes: size %q is wider than IMAGE_MAX_DIMENSION", size.Name))
+		}
+	}
+	if c.Trash.Retention < 0 {
+		errs = append(errs, fmt.Errorf("TRASH_RETENTION must not be negative, got %s", c.Trash.Retention))
+	}
+	if c.Trash.PurgeInterval <= 0 {
+		errs = append(errs, fmt.Errorf("TRASH_PURGE_INTERVAL must be positive, got %s", c.Trash.PurgeInterval))
+	}
+	if !slices.Contains(TracingExporters, c.Tracing.Exporter) {
+		errs = append(errs, fmt.Errorf("OTEL_TRACES_EXPORTER must be one of %s, got %q", strings.Join(TracingExporters, ", "), c.Tracing.Exporter))
+	}
+	if _, err := ParseTrustedProxies(c.Server.TrustedProxies); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, c.CORS.validate()...)
+	for _, limit := range []struct {
+		prefix string
+		value  RateLimit
+	}{
+		{"RATE_LIMIT_READ", c.RateLimit.Read},
+		{"RATE_LIMIT_WRITE", c.RateLimit.Write},
+	} {
+		if limit.value.Rate < 0 {
+			errs = append(errs, fmt.Errorf("%s_RATE must not be negative, got %g", limit.prefix, limit.value.Rate))
+		}
+		if limit.value.Rate > 0 && limit.value.Burst < 1 {
+			errs = append(errs, fmt.Errorf("%s_BURST must be at least 1 when %s_RATE is set, got %d", limit.prefix, limit.prefix, limit.value.Burst))
+		}
+	}
+	return errs
+}
+
+func (c StaticConfig) validate() []error {
+	var errs []error
+	for _, format := range c.Formats {
+		if !slices.Contains(ImageFormats, format) {
+			errs = append(errs, fmt.Errorf("STATIC_FORMATS must list formats of %s, got %q", strings.Join(ImageFormats, ", "), format))
+		}
+	}
+	names := map[string]bool{"original": true}
+	for _, size := range c.Sizes {
+		if names[size.Name] || size.Name == "" {
+			errs = append(errs, fmt.Errorf("static.sizes: name %q is empty, reserved or used twice", size.Name))
+		}
+		names[size.Name] = true
+		if size.Width <= 0 {
+			errs = append(errs, fmt.Errorf("static.sizes: size %q needs a positive width", size.Name))
+		}
+	}
+	return errs
+}
+
+func (c CORSConfig) validate() []error {
+	var errs []error
+	for _, origin := range c.AllowOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				errs = append(errs, errors.New(`CORS_ALLOW_ORIGINS must list origins, not "*", when CORS_ALLOW_CREDENTIALS is set`))
+			}
+			continue
+		}
+		u, err := url.Parse(strings.Replace(origin, "://*.", "://", 1))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" {
+			errs = append(errs, fmt.Errorf("CORS_ALLOW_ORIGINS: invalid origin %q, expected scheme://host[:port]", origin))
+		}
+	}
+	if c.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("CORS_MAX_AGE must not be negative, got %s", c.MaxAge))
+	}
+	return errs
+}
+
+// envReader parses environment variables into typed fields, collecting errors
+type envReader struct {
+	lookup func(string) (string, bool)
+	errs   []error
+}
+
+func (r *envReader) get(key string) (string, bool) {
+	v, ok := r.lookup(key)
+	v = strings.TrimSpace(v)
+	return v, ok && v != ""
+}
+
+func (r *envReader) string(key string, dst *string) {
+	if v, ok := r.get(key); ok {
+		*dst = v
+	}
+}
+
+func (r *envReader) int(key string, dst *int) {
+	v, ok := r.get(key)
+	if !ok {
+		return
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid integer %q", key, v))
+		return
+	}
+	*dst = n
+}
+
+func (r *envReader) bool(key string, dst *bool) {
+	v, ok := r.get(key)
+	if !ok {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid boolean %q", key, v))
+		return
+	}
+	*dst = b
+}
+
+func (r *envReader) float(key string, dst *float64) {
+	v, ok := r.get(key)
+	if !ok {
+		return
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid number %q", key, v))
+		return
+	}
+	*dst = f
+}
+
+// list reads a comma-separated list, dropping empty items
+func (r *envReader) list(key string, dst *[]string) {
+	v, ok := r.get(key)
+	if !ok {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*dst = items
+}
+
+func (r *envReader) duration(key string, dst *time.Duration) {
+	v, ok := r.get(key)
+	if !ok {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Errorf("%s: invalid duration %q", key, v))
+		return
+	}
+	*dst = d
+}
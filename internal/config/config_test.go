@@ -0,0 +1,215 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupFrom(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+}
+
+func TestLoadEnv_OverridesDefaults(t *testing.T) {
+	cfg := Default()
+	errs := cfg.loadEnv(lookupFrom(map[string]string{
+		"APP_ENV":                 "dev",
+		"SERVER_PORT":             "9090",
+		"POSTGRES_DSN":            "postgres://localhost/tarot",
+		"STATIC_URL":              "https://cdn.example.com",
+		"CACHE_TTL":               "30s",
+		"CACHE_MAX_ENTRIES":       "0",
+		"CACHE_CONTROL_REFERENCE": "none",
+		"LOG_FORMAT":              "",
+		"REQUIRE_IF_MATCH":        "true",
+		"TRASH_RETENTION":         "168h",
+	}))
+
+	require.Empty(t, errs)
+	assert.True(t, cfg.IsDev())
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, "postgres://localhost/tarot", cfg.Database.DSN)
+	assert.Equal(t, "https://cdn.example.com", cfg.Static.URL)
+	assert.Equal(t, 30*time.Second, cfg.Cache.TTL)
+	assert.Equal(t, 0, cfg.Cache.MaxEntries)
+	assert.Equal(t, "", CacheControl(cfg.HTTPCache.Reference))
+	assert.Equal(t, "public, max-age=600", CacheControl(cfg.HTTPCache.Cards))
+	assert.True(t, cfg.Concurrency.RequireIfMatch)
+	assert.Equal(t, 7*24*time.Hour, cfg.Trash.Retention)
+	assert.Equal(t, time.Hour, cfg.Trash.PurgeInterval)
+	// empty values keep the default
+	assert.Equal(t, "development", cfg.Log.Format)
+}
+
+func TestLoadEnv_DatabaseDSNTakesPrecedence(t *testing.T) {
+	cfg := Default()
+	errs := cfg.loadEnv(lookupFrom(map[string]string{
+		"POSTGRES_DSN": "postgres://localhost/tarot",
+		"DATABASE_DSN": "sqlite::memory:",
+	}))
+
+	require.Empty(t, errs)
+	assert.Equal(t, "sqlite::memory:", cfg.Database.DSN)
+}
+
+func TestLoad_ReportsAllErrors(t *testing.T) {
+	t.Setenv("DATABASE_DSN", "")
+	t.Setenv("POSTGRES_DSN", "")
+	t.Setenv("SERVER_PORT", "http")
+	t.Setenv("CACHE_TTL", "forever")
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+	t.Setenv("TRASH_PURGE_INTERVAL", "0s")
+
+	_, err := Load()
+
+	require.Error(t, err)
+	for _, want := range []string{
+		"DATABASE_DSN (or POSTGRES_DSN) is required",
+		`SERVER_PORT: invalid integer "http"`,
+		`CACHE_TTL: invalid duration "forever"`,
+		`LOG_FORMAT must be one of color, development, json, got "xml"`,
+		`OTEL_TRACES_EXPORTER must be one of none, otlp, stdout, got "zipkin"`,
+		"TRASH_PURGE_INTERVAL must be positive, got 0s",
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+}
+
+func TestValidate_RateLimitAndProxies(t *testing.T) {
+	cfg := Default()
+	cfg.Database.DSN = "sqlite::memory:"
+	errs := cfg.loadEnv(lookupFrom(map[string]string{
+		"TRUSTED_PROXIES":       "10.0.0.0/8, 192.0.2.1,",
+		"RATE_LIMIT_READ_RATE":  "2.5",
+		"RATE_LIMIT_READ_BURST": "10",
+		"RATE_LIMIT_API_KEYS":   "alpha,beta",
+	}))
+	require.Empty(t, errs)
+	require.Empty(t, cfg.validate())
+	assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.1"}, cfg.Server.TrustedProxies)
+	assert.Equal(t, RateLimit{Rate: 2.5, Burst: 10}, cfg.RateLimit.Read)
+	assert.Equal(t, []string{"alpha", "beta"}, cfg.RateLimit.APIKeys)
+
+	nets, err := ParseTrustedProxies(cfg.Server.TrustedProxies)
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.1/32", nets[1].String())
+
+	cfg.Server.TrustedProxies = []string{"proxy.local"}
+	cfg.RateLimit.Write = RateLimit{Rate: 1}
+	assert.ElementsMatch(t, []string{
+		`TRUSTED_PROXIES: invalid IP or CIDR "proxy.local"`,
+		"RATE_LIMIT_WRITE_BURST must be at least 1 when RATE_LIMIT_WRITE_RATE is set, got 0",
+	}, errorStrings(cfg.validate()))
+}
+
+func TestValidate_CORS(t *testing.T) {
+	cfg := Default()
+	cfg.Database.DSN = "sqlite::memory:"
+	errs := cfg.loadEnv(lookupFrom(map[string]string{
+		"CORS_ALLOW_ORIGINS":     "https://tarot.example.com, https://*.example.com",
+		"CORS_ALLOW_CREDENTIALS": "true",
+		"CORS_MAX_AGE":           "1h",
+	}))
+	require.Empty(t, errs)
+	require.Empty(t, cfg.validate())
+	assert.Equal(t, []string{"https://tarot.example.com", "https://*.example.com"}, cfg.CORS.AllowOrigins)
+	assert.True(t, cfg.CORS.AllowCredentials)
+	assert.Equal(t, time.Hour, cfg.CORS.MaxAge)
+	assert.Contains(t, cfg.CORS.AllowMethods, "PUT")
+
+	cfg.CORS.AllowOrigins = []string{"*", "tarot.example.com"}
+	assert.ElementsMatch(t, []string{
+		`CORS_ALLOW_ORIGINS must list origins, not "*", when CORS_ALLOW_CREDENTIALS is set`,
+		`CORS_ALLOW_ORIGINS: invalid origin "tarot.example.com", expected scheme://host[:port]`,
+	}, errorStrings(cfg.validate()))
+
+	errs = cfg.loadEnv(lookupFrom(map[string]string{"CORS_ALLOW_CREDENTIALS": "maybe"}))
+	assert.Equal(t, []string{`CORS_ALLOW_CREDENTIALS: invalid boolean "maybe"`}, errorStrings(errs))
+}
+
+func TestValidate_ImageVariants(t *testing.T) {
+	cfg := Default()
+	cfg.Database.DSN = "sqlite::memory:"
+	require.Empty(t, cfg.validate())
+	assert.Equal(t, []string{"jpeg"}, cfg.Static.Formats)
+
+	errs := cfg.loadEnv(lookupFrom(map[string]string{
+		"STATIC_FORMATS":      "png, jpeg",
+		"IMAGE_CACHE_DIR":     "/var/cache/tarot",
+		"IMAGE_CACHE_SIZE_MB": "64",
+	}))
+	require.Empty(t, errs)
+	assert.Equal(t, []string{"png", "jpeg"}, cfg.Static.Formats)
+	assert.Equal(t, ImageServerConfig{CacheDir: "/var/cache/tarot", CacheSizeMB: 64, MaxDimension: 2400, ThumbnailWidth: 240, SizeStep: 50, MaxRenders: 4, RenderTimeout: 30 * time.Second}, cfg.ImageServer)
+
+	cfg.Static.Formats = []string{"webp", "bmp"}
+	cfg.Static.Sizes = []ImageSize{
+		{Name: "small", Width: 240},
+		{Name: "small", Width: 3000},
+		{Name: "original", Width: 0},
+	}
+	assert.ElementsMatch(t, []string{
+		`static.sizes: size "small" is wider than IMAGE_MAX_DIMENSION`,
+		`STATIC_FORMATS must list formats of jpeg, png, gif, webp, avif, got "bmp"`,
+		`static.sizes: name "small" is empty, reserved or used twice`,
+		`static.sizes: name "original" is empty, reserved or used twice`,
+		`static.sizes: size "original" needs a positive width`,
+	}, errorStrings(cfg.validate()))
+
+	cfg.ImageServer.MaxDimension = 0
+	assert.Contains(t, errorStrings(cfg.validate()), "IMAGE_MAX_DIMENSION must be positive, got 0")
+}
+
+func errorStrings(errs []error) []string {
+	out := make([]string, len(errs))
+	for i, err := range errs {
+		out[i] = err.Error()
+	}
+	return out
+}
+
+func TestLoad_FileThenEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+env: dev
+server:
+  port: 8081
+  shutdown_delay: 5s
+database:
+  dsn: postgres://file/tarot
+cache:
+  ttl: 1m
+http_cache:
+  meanings: no-cache
+`), 0o600))
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("SERVER_PORT", "8082")
+	t.Setenv("DATABASE_DSN", "")
+	t.Setenv("POSTGRES_DSN", "")
+
+	cfg, err := Load()
+
+	require.NoError(t, err)
+	assert.True(t, cfg.IsDev())
+	assert.Equal(t, 8082, cfg.Server.Port)
+	assert.Equal(t, 5*time.Second, cfg.Server.ShutdownDelay)
+	assert.Equal(t, "postgres://file/tarot", cfg.Database.DSN)
+	assert.Equal(t, time.Minute, cfg.Cache.TTL)
+	assert.Equal(t, "no-cache", cfg.HTTPCache.Meanings)
+	assert.Equal(t, 1000, cfg.Cache.MaxEntries)
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+
+	_, err := Load()
+	assert.ErrorContains(t, err, "missing.yaml")
+}
@@ -1,25 +1,72 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
-	"os"
+	"net/url"
+	"strings"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/XSAM/otelsql"
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	_ "modernc.org/sqlite"
 )
 
-// InitDB initializes the PostgreSQL database connection
-func InitDB() (*sql.DB, error) {
-	dsn := os.Getenv("POSTGRES_DSN")
-	if dsn == "" {
-		log.Fatal("POSTGRES_DSN is not set")
+// Dialect identifies the SQL backend a DSN points to
+type Dialect string
+
+const (
+	Postgres Dialect = "postgres"
+	SQLite   Dialect = "sqlite"
+)
+
+// sqlitePrefixes are the DSN schemes selecting the embedded SQLite backend
+var sqlitePrefixes = []string{"sqlite://", "sqlite:"}
+
+// DialectOf returns the backend selected by the DSN scheme: "sqlite:" for SQLite,
+// anything else (postgres URL or key=value string) for PostgreSQL.
+func DialectOf(dsn string) Dialect {
+	for _, prefix := range sqlitePrefixes {
+		if strings.HasPrefix(dsn, prefix) {
+			return SQLite
+		}
 	}
+	return Postgres
+}
 
-	db, err := sql.Open("postgres", dsn)
+// InitDB opens the database selected by the DSN scheme.
+// Every statement is traced as a child span of the request that issued it.
+// A SQLite database is migrated on open, so it is ready to use even when it lives in memory.
+func InitDB(cfg config.DatabaseConfig) (*sql.DB, error) {
+	if DialectOf(cfg.DSN) == SQLite {
+		return initSQLite(cfg)
+	}
+	return initPostgres(cfg)
+}
+
+// initPostgres initializes the PostgreSQL connection pool
+func initPostgres(cfg config.DatabaseConfig) (*sql.DB, error) {
+	dsn, err := withStatementTimeout(cfg.DSN, cfg.StatementTimeout)
 	if err != nil {
 		return nil, err
 	}
 
+	db, err := open("postgres", dsn, semconv.DBSystemPostgreSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
 	if err = db.Ping(); err != nil {
 		return nil, err
 	}
@@ -27,3 +74,76 @@ func InitDB() (*sql.DB, error) {
 	log.Println("Successfully connected to PostgreSQL")
 	return db, nil
 }
+
+// initSQLite opens a single-connection SQLite database and applies the migrations.
+// SQLite serializes writers anyway, and a single connection that is never
+// recycled keeps an in-memory database alive for the life of the process.
+func initSQLite(cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := open("sqlite", sqliteDSN(cfg.DSN), semconv.DBSystemSqlite)
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+
+	if _, err := Migrate(context.Background(), db, SQLite); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("sqlite schema: %w", err)
+	}
+
+	log.Println("Successfully opened SQLite database")
+	return db, nil
+}
+
+func open(driver, dsn string, system attribute.KeyValue) (*sql.DB, error) {
+	return otelsql.Open(driver, dsn,
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+			RecordError: func(err error) bool {
+				return !errors.Is(err, sql.ErrNoRows)
+			},
+		}),
+	)
+}
+
+// sqliteDSN turns "sqlite:path?opts" into the driver's "file:path?opts" form,
+// enforcing foreign keys (off by default in SQLite) and waiting on locks
+// instead of failing with SQLITE_BUSY.
+func sqliteDSN(dsn string) string {
+	for _, prefix := range sqlitePrefixes {
+		if rest, ok := strings.CutPrefix(dsn, prefix); ok {
+			dsn = rest
+			break
+		}
+	}
+	path, query, _ := strings.Cut(dsn, "?")
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		params = url.Values{}
+	}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	return "file:" + path + "?" + params.Encode()
+}
+
+// withStatementTimeout adds the statement_timeout run-time parameter to the DSN,
+// so that PostgreSQL itself cancels statements running longer than timeout.
+// URL-style DSNs are converted to the key=value form first.
+func withStatementTimeout(dsn string, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		return dsn, nil
+	}
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		converted, err := pq.ParseURL(dsn)
+		if err != nil {
+			return "", err
+		}
+		dsn = converted
+	}
+	return fmt.Sprintf("%s statement_timeout=%d", dsn, timeout.Milliseconds()), nil
+}
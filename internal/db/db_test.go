@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStatementTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		timeout time.Duration
+		want    string
+	}{
+		{"disabled", "postgres://u@localhost/tarot", 0, "postgres://u@localhost/tarot"},
+		{"key value", "host=localhost dbname=tarot", 5 * time.Second, "host=localhost dbname=tarot statement_timeout=5000"},
+		{"url", "postgresql://u@localhost:5442/tarot?sslmode=disable", 1500 * time.Millisecond,
+			`dbname='tarot' host='localhost' port='5442' sslmode='disable' user='u' statement_timeout=1500`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withStatementTimeout(tt.dsn, tt.timeout)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDialectOf(t *testing.T) {
+	assert.Equal(t, SQLite, DialectOf("sqlite::memory:"))
+	assert.Equal(t, SQLite, DialectOf("sqlite://data/tarot.db"))
+	assert.Equal(t, Postgres, DialectOf("postgres://u@localhost/tarot"))
+	assert.Equal(t, Postgres, DialectOf("host=localhost dbname=tarot"))
+}
+
+func TestSQLiteDSN(t *testing.T) {
+	assert.Equal(t, "file::memory:?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29", sqliteDSN("sqlite::memory:"))
+	assert.Equal(t, "file:data/tarot.db?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29&mode=ro",
+		sqliteDSN("sqlite://data/tarot.db?mode=ro"))
+}
+
+func TestInitDB_SQLite(t *testing.T) {
+	database, err := InitDB(config.DatabaseConfig{DSN: "sqlite::memory:"})
+	require.NoError(t, err)
+	defer database.Close()
+	ctx := context.Background()
+
+	version, dirty, err := SchemaVersion(ctx, database)
+	require.NoError(t, err)
+	latest, err := LatestMigrationVersion(SQLite)
+	require.NoError(t, err)
+	assert.False(t, dirty)
+	assert.Equal(t, latest, version)
+
+	var decks int
+	require.NoError(t, database.QueryRowContext(ctx, "SELECT COUNT(*) FROM deck").Scan(&decks))
+	assert.Equal(t, 4, decks, "seed data is loaded")
+
+	t.Run("enum values are checked", func(t *testing.T) {
+		_, err := database.ExecContext(ctx,
+			"INSERT INTO meaning_major (number, position, source, meaning) VALUES ($1, $2, $3, $4)", 0, "sideways", 1, "")
+		assert.ErrorContains(t, err, "CHECK constraint failed")
+	})
+
+	t.Run("names are unique", func(t *testing.T) {
+		_, err := database.ExecContext(ctx, "INSERT INTO suit (name, genitive) SELECT name, genitive FROM suit LIMIT 1")
+		assert.ErrorContains(t, err, "UNIQUE constraint failed")
+	})
+
+	t.Run("foreign keys are enforced", func(t *testing.T) {
+		_, err := database.ExecContext(ctx, "INSERT INTO card (deck, arcana) VALUES ($1, $2)", 999, "major")
+		assert.ErrorContains(t, err, "FOREIGN KEY constraint failed")
+	})
+
+	t.Run("deleting a deck cascades to its cards", func(t *testing.T) {
+		_, err := database.ExecContext(ctx, "DELETE FROM deck WHERE id = $1", 3)
+		require.NoError(t, err)
+		var cards int
+		require.NoError(t, database.QueryRowContext(ctx, "SELECT COUNT(*) FROM card WHERE deck = $1", 3).Scan(&cards))
+		assert.Zero(t, cards)
+	})
+}
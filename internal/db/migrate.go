@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ilbagatto/tarot-api/migrations"
+)
+
+// Migration is a single versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	SQL     string
+}
+
+// LoadMigrations reads the embedded up-migrations of the dialect sorted by version
+func LoadMigrations(dialect Dialect) ([]Migration, error) {
+	fsys, err := fs.Sub(migrations.FS, string(dialect))
+	if err != nil {
+		return nil, err
+	}
+	return loadMigrations(fsys)
+}
+
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Migration
+	for _, file := range files {
+		name := strings.TrimSuffix(path.Base(file), ".up.sql")
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q", file)
+		}
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, Migration{Version: version, Name: name, SQL: string(content)})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// LatestMigrationVersion returns the version the schema is expected to be at
+func LatestMigrationVersion(dialect Dialect) (int64, error) {
+	list, err := LoadMigrations(dialect)
+	if err != nil {
+		return 0, err
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+	return list[len(list)-1].Version, nil
+}
+
+// SchemaVersion returns the version recorded in schema_migrations, or 0 if no
+// migration has been applied yet. The table layout is compatible with golang-migrate.
+func SchemaVersion(ctx context.Context, db *sql.DB) (version int64, dirty bool, err error) {
+	err = db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) || isUndefinedTable(err) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Migrate applies all pending migrations, each in its own transaction,
+// and returns the number of applied migrations.
+func Migrate(ctx context.Context, db *sql.DB, dialect Dialect) (int, error) {
+	if _, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT NOT NULL PRIMARY KEY,
+			dirty BOOLEAN NOT NULL
+		)`); err != nil {
+		return 0, err
+	}
+
+	current, dirty, err := SchemaVersion(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty, fix it manually", current)
+	}
+
+	list, err := LoadMigrations(dialect)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, m := range list {
+		if m.Version <= current {
+			continue
+		}
+		if err := applyMigration(ctx, db, m); err != nil {
+			return applied, fmt.Errorf("migration %s: %w", m.Name, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func applyMigration(ctx context.Context, db *sql.DB, m Migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, m.SQL); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)", m.Version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// isUndefinedTable reports whether err means schema_migrations does not exist yet
+func isUndefinedTable(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "does not exist") || strings.Contains(msg, "no such table")
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations_SortsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000010_tenth.up.sql":    {Data: []byte("SELECT 10;")},
+		"000002_second.up.sql":   {Data: []byte("SELECT 2;")},
+		"000002_second.down.sql": {Data: []byte("SELECT -2;")},
+		"README.md":              {Data: []byte("ignored")},
+	}
+
+	list, err := loadMigrations(fsys)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, Migration{Version: 2, Name: "000002_second", SQL: "SELECT 2;"}, list[0])
+	assert.Equal(t, int64(10), list[1].Version)
+}
+
+func TestLoadMigrations_RejectsInvalidNames(t *testing.T) {
+	fsys := fstest.MapFS{"first.up.sql": {Data: []byte("SELECT 1;")}}
+
+	_, err := loadMigrations(fsys)
+	assert.Error(t, err)
+}
+
+func TestLatestMigrationVersion_EmbeddedFiles(t *testing.T) {
+	for _, dialect := range []Dialect{Postgres, SQLite} {
+		version, err := LatestMigrationVersion(dialect)
+		require.NoError(t, err, dialect)
+		assert.GreaterOrEqual(t, version, int64(1), dialect)
+	}
+}
+
+func TestLatestMigrationVersion_DialectsInStep(t *testing.T) {
+	pg, err := LatestMigrationVersion(Postgres)
+	require.NoError(t, err)
+	lite, err := LatestMigrationVersion(SQLite)
+	require.NoError(t, err)
+	assert.Equal(t, pg, lite, "every migration needs a PostgreSQL and a SQLite version")
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/audit"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// Number of audit entries returned by default and at most
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// ListAuditHandler returns entries of the audit log
+// @Summary Get the audit log
+// @Description Returns the recorded creates, updates, deletes, restores and purges, newest first, with the fields each one changed
+// @Tags audit
+// @Produce json
+// @Param entity query string false "Entity" Enums(deck, source, spread, suit, rank, card_major, card_minor, meaning_major, meaning_minor)
+// @Param entityId query int false "Entity ID"
+// @Param actor query string false "Actor, e.g. key:3f2b8c1e9a7d or ip:203.0.113.7"
+// @Param from query string false "Earliest time, inclusive (RFC 3339)"
+// @Param to query string false "Latest time, exclusive (RFC 3339)"
+// @Param limit query int false "Maximum number of entries (default 100, at most 1000)"
+// @Success 200 {array} models.AuditEntry
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /audit [get]
+func ListAuditHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		filter := models.AuditFilter{
+			Entity: c.QueryParam("entity"),
+			Actor:  c.QueryParam("actor"),
+			Limit:  defaultAuditLimit,
+		}
+		if filter.Entity != "" && !slices.Contains(audit.Entities, filter.Entity) {
+			return SendError(c, http.StatusBadRequest, fmt.Errorf("invalid entity"))
+		}
+		if id := c.QueryParam("entityId"); id != "" {
+			v, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				return SendError(c, http.StatusBadRequest, fmt.Errorf("invalid entityId"))
+			}
+			filter.EntityID = v
+		}
+		for name, target := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+			if value := c.QueryParam(name); value != "" {
+				t, err := time.Parse(time.RFC3339, value)
+				if err != nil {
+					return SendError(c, http.StatusBadRequest, fmt.Errorf("invalid %s: must be an RFC 3339 time", name))
+				}
+				*target = t
+			}
+		}
+		if limit := c.QueryParam("limit"); limit != "" {
+			v, err := strconv.Atoi(limit)
+			if err != nil || v < 1 || v > maxAuditLimit {
+				return SendError(c, http.StatusBadRequest, fmt.Errorf("invalid limit: must be between 1 and %d", maxAuditLimit))
+			}
+			filter.Limit = v
+		}
+
+		entries, err := a.Repos.Audit.List(c.Request().Context(), filter)
+		if err != nil {
+			return useHandleDBError(c, err)
+		}
+		return c.JSON(http.StatusOK, entries)
+	}
+}
@@ -0,0 +1,78 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit_RecordsWrites(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	start := time.Now().Add(-time.Second).UTC().Format(time.RFC3339)
+	createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/sources/1", models.SourceInput{Name: "Papus (1889)"}).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/sources/1", nil).Code)
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	rec := ta.Request(http.MethodGet, "/audit?entity=source&from="+start, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	entries := decodeBody[[]models.AuditEntry](t, rec.Body.Bytes())
+	require.Len(t, entries, 3)
+
+	for i, action := range []string{models.AuditDelete, models.AuditUpdate, models.AuditCreate} {
+		assert.Equal(t, action, entries[i].Action)
+		assert.Equal(t, "source", entries[i].Entity)
+		assert.Equal(t, int64(1), entries[i].EntityID)
+		assert.Equal(t, "ip:192.0.2.1", entries[i].Actor)
+	}
+	assert.JSONEq(t, `{"name": {"before": "Papus", "after": "Papus (1889)"}}`, string(entries[1].Changes))
+	assert.JSONEq(t, `{"id": {"before": 1}, "name": {"before": "Papus (1889)"}}`, string(entries[0].Changes))
+}
+
+func TestAudit_Filters(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Marseille"})
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"", 3},
+		{"?entity=deck", 2},
+		{"?entity=deck&entityId=2", 1},
+		{"?actor=ip:192.0.2.1", 3},
+		{"?actor=key:000000000000", 0},
+		{"?limit=1", 1},
+		{"?to=2000-01-01T00:00:00Z", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec := ta.Request(http.MethodGet, "/audit"+tt.query, nil)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			assert.Len(t, decodeBody[[]models.AuditEntry](t, rec.Body.Bytes()), tt.want)
+		})
+	}
+
+	for _, query := range []string{"?entity=card", "?entityId=x", "?from=yesterday", "?limit=0", "?limit=5000"} {
+		rec := ta.Request(http.MethodGet, "/audit"+query, nil)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestAudit_FailedWritesAreNotRecorded(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	require.Equal(t, http.StatusConflict, ta.RequestJSON(http.MethodPost, "/decks", models.DeckInput{Name: "Thoth"}).Code)
+	require.Equal(t, http.StatusNotFound, ta.RequestJSON(http.MethodPut, "/decks/42", models.DeckInput{Name: "Rider"}).Code)
+
+	rec := ta.Request(http.MethodGet, "/audit", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, decodeBody[[]models.AuditEntry](t, rec.Body.Bytes()), 1)
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// maxBatchItems limits the number of items of a batch request
+const maxBatchItems = 1000
+
+// Batch modes, chosen by the mode query parameter
+const (
+	batchAtomic  = "atomic"  // all items are written or none
+	batchPartial = "partial" // the items that succeed are written
+)
+
+// BatchItemResult is the outcome of one item of a batch request
+type BatchItemResult struct {
+	Index int `json:"index" example:"0"`
+	// Status is the one the item would get as a request of its own; 424 marks an
+	// item rolled back because another one failed
+	Status int    `json:"status" example:"201"`
+	ID     int64  `json:"id,omitempty" example:"12"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BatchResponse reports the outcome of a batch request item by item
+type BatchResponse struct {
+	Created   int               `json:"created" example:"1"`
+	Updated   int               `json:"updated" example:"0"`
+	Failed    int               `json:"failed" example:"0"`
+	Results   []BatchItemResult `json:"results"`
+	RequestID string            `json:"requestId,omitempty"`
+}
+
+// batchSpec tells useBatch how to write a batch of items of type I
+type batchSpec[I any] struct {
+	entity   string // written entity, see cacheDependents
+	metric   string // entity name of the created metric
+	notFound string
+	// id and version return the ID of the entity an item updates (0 if it
+	// creates one) and the version it expects
+	id      func(item I) int64
+	version func(item I) int64
+	write   func(ctx context.Context, items []I, atomic bool) ([]models.BatchResult, error)
+}
+
+// useBatch implements POST .../batch: the body is an array of items, each of
+// which creates an entity or updates the one with its ID. They are written in
+// one transaction. In the default atomic mode a failing item fails the whole
+// batch with 422; with mode=partial the other items are still written and the
+// response is 207 if some failed. Either way every item gets a result.
+func useBatch[I any](c echo.Context, a *app.App, spec batchSpec[I]) error {
+	mode := c.QueryParam("mode")
+	if mode == "" {
+		mode = batchAtomic
+	}
+	if mode != batchAtomic && mode != batchPartial {
+		return SendError(c, http.StatusBadRequest,
+			fmt.Errorf("invalid mode: must be %s or %s", batchAtomic, batchPartial))
+	}
+
+	var items []I
+	if err := useBind(c, &items); err != nil {
+		return SendError(c, http.StatusBadRequest, err)
+	}
+	if len(items) == 0 {
+		return SendError(c, http.StatusBadRequest, errors.New("batch has no items"))
+	}
+	if len(items) > maxBatchItems {
+		return SendError(c, http.StatusBadRequest,
+			fmt.Errorf("batch has %d items, at most %d are allowed", len(items), maxBatchItems))
+	}
+	if a.Config.Concurrency.RequireIfMatch {
+		for _, item := range items {
+			if spec.id(item) != 0 && spec.version(item) == 0 {
+				return SendError(c, http.StatusPreconditionRequired,
+					errors.New("every update of the batch needs a version"))
+			}
+		}
+	}
+
+	results, err := spec.write(c.Request().Context(), items, mode == batchAtomic)
+	if err != nil {
+		return useHandleDBError(c, err)
+	}
+
+	resp := BatchResponse{Results: make([]BatchItemResult, len(results))}
+	for i, result := range results {
+		item := BatchItemResult{Index: i, ID: result.ID}
+		switch {
+		case result.Err != nil:
+			status, errResp := notFoundOrDBError(result.Err, spec.notFound)
+			logServerError(c, status, result.Err)
+			item.Status, item.Error = status, errResp.Error
+			resp.Failed++
+		case result.ID == 0:
+			item.Status = http.StatusFailedDependency
+			item.Error = "Not written: another item of the batch failed"
+		case result.Created:
+			item.Status = http.StatusCreated
+			resp.Created++
+			a.Metrics.EntityCreated(spec.metric)
+		default:
+			item.Status = http.StatusOK
+			resp.Updated++
+		}
+		resp.Results[i] = item
+	}
+
+	if resp.Created+resp.Updated > 0 {
+		useInvalidate(c, a, spec.entity)
+	}
+	switch {
+	case resp.Failed == 0:
+		return c.JSON(http.StatusOK, resp)
+	case mode == batchAtomic:
+		resp.RequestID = middleware.GetRequestID(c)
+		return c.JSON(http.StatusUnprocessableEntity, resp)
+	}
+	resp.RequestID = middleware.GetRequestID(c)
+	return c.JSON(http.StatusMultiStatus, resp)
+}
@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch_CreatesAndUpdatesCards(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	foolID := createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "Fool"})
+
+	rec := ta.RequestJSON(http.MethodPost, "/cards/major/batch", []models.CardMajorBatchItem{
+		{ID: foolID, Version: 1, CardMajorInput: models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"}},
+		{CardMajorInput: models.CardMajorInput{DeckID: deckID, Number: 1, Name: "The Magus"}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	resp := decodeBody[handlers.BatchResponse](t, rec.Body.Bytes())
+	assert.Equal(t, 1, resp.Created)
+	assert.Equal(t, 1, resp.Updated)
+	assert.Equal(t, []handlers.BatchItemResult{
+		{Index: 0, Status: http.StatusOK, ID: foolID},
+		{Index: 1, Status: http.StatusCreated, ID: 2},
+	}, resp.Results)
+
+	rec = ta.Request(http.MethodGet, "/cards/major?deckId=1", nil)
+	cards := decodeBody[[]models.CardMajor](t, rec.Body.Bytes())
+	require.Len(t, cards, 2)
+	assert.Equal(t, "The Fool", cards[0].Name)
+	assert.Equal(t, "The Magus", cards[1].Name)
+
+	rec = ta.Request(http.MethodGet, "/audit?entity=card_major", nil)
+	assert.Len(t, decodeBody[[]models.AuditEntry](t, rec.Body.Bytes()), 3)
+}
+
+func TestBatch_AtomicWritesNothingOnFailure(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+
+	items := []models.MeaningMajorBatchItem{
+		{MeaningMajorInput: models.MeaningMajorInput{Number: 0, Position: models.PositionStraight, Source: sourceID, Meaning: "Folly"}},
+		{MeaningMajorInput: models.MeaningMajorInput{Number: 1, Position: models.PositionStraight, Source: 7, Meaning: "Will"}},
+		{ID: 9, MeaningMajorInput: models.MeaningMajorInput{Number: 2, Position: models.PositionStraight, Source: sourceID}},
+	}
+	rec := ta.RequestJSON(http.MethodPost, "/meanings/major/batch", items)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	resp := decodeBody[handlers.BatchResponse](t, rec.Body.Bytes())
+	assert.Equal(t, 0, resp.Created)
+	assert.Equal(t, 2, resp.Failed)
+	assert.NotEmpty(t, resp.RequestID)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, http.StatusFailedDependency, resp.Results[0].Status)
+	assert.Zero(t, resp.Results[0].ID)
+	assert.Equal(t, http.StatusConflict, resp.Results[1].Status)
+	assert.Equal(t, http.StatusNotFound, resp.Results[2].Status)
+	assert.Equal(t, "Major Meaning not found", resp.Results[2].Error)
+
+	rec = ta.Request(http.MethodGet, "/meanings/major", nil)
+	assert.Empty(t, decodeBody[[]models.MeaningMajor](t, rec.Body.Bytes()))
+
+	// In partial mode the valid item is written
+	rec = ta.RequestJSON(http.MethodPost, "/meanings/major/batch?mode=partial", items)
+	require.Equal(t, http.StatusMultiStatus, rec.Code, rec.Body.String())
+	resp = decodeBody[handlers.BatchResponse](t, rec.Body.Bytes())
+	assert.Equal(t, 1, resp.Created)
+	assert.Equal(t, 2, resp.Failed)
+	assert.Equal(t, http.StatusCreated, resp.Results[0].Status)
+
+	rec = ta.Request(http.MethodGet, "/meanings/major/"+strconv.FormatInt(resp.Results[0].ID, 10)+"/revisions", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, decodeBody[[]models.MeaningMajorRevision](t, rec.Body.Bytes()), 1)
+}
+
+func TestBatch_MinorItemsFailIndependently(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Cups", Genitive: "of Cups"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+
+	meaning := models.MeaningMinorInput{Suit: suitID, Rank: rankID, Position: models.PositionStraight, Source: sourceID, Meaning: "Love"}
+	rec := ta.RequestJSON(http.MethodPost, "/meanings/minor/batch?mode=partial", []models.MeaningMinorBatchItem{
+		{MeaningMinorInput: meaning}, {MeaningMinorInput: meaning},
+	})
+	require.Equal(t, http.StatusMultiStatus, rec.Code, rec.Body.String())
+	resp := decodeBody[handlers.BatchResponse](t, rec.Body.Bytes())
+	assert.Equal(t, http.StatusCreated, resp.Results[0].Status)
+	assert.Equal(t, http.StatusConflict, resp.Results[1].Status)
+
+	rec = ta.RequestJSON(http.MethodPost, "/cards/minor/batch", []models.CardMinorBatchItem{
+		{CardMinorInput: models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 1, decodeBody[handlers.BatchResponse](t, rec.Body.Bytes()).Created)
+}
+
+func TestBatch_InvalidRequests(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	item := models.CardMajorBatchItem{CardMajorInput: models.CardMajorInput{DeckID: deckID, Name: "The Fool"}}
+
+	rec := ta.RequestJSON(http.MethodPost, "/cards/major/batch?mode=some", []models.CardMajorBatchItem{item})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = ta.RequestJSON(http.MethodPost, "/cards/major/batch", []models.CardMajorBatchItem{})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = ta.RequestJSON(http.MethodPost, "/cards/major/batch", item)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	ta.App.Config.Concurrency.RequireIfMatch = true
+	rec = ta.RequestJSON(http.MethodPost, "/cards/major/batch", []models.CardMajorBatchItem{
+		item, {ID: 1, CardMajorInput: item.CardMajorInput},
+	})
+	assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+}
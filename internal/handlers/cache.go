@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// cacheDependents lists, for each written entity, the cache key prefixes
+// whose values may include data of that entity (directly or via cascades).
+var cacheDependents = map[string][]string{
+	"decks":          {"decks", "cards"},
+	"sources":        {"decks", "meanings"},
+	"suits":          {"suits", "cards:minor", "cards:images", "meanings:minor"},
+	"ranks":          {"ranks", "cards:minor", "cards:images", "meanings:minor"},
+	"cards:major":    {"cards:major", "cards:images"},
+	"cards:minor":    {"cards:minor", "cards:images", "decks"},
+	"meanings:major": {"meanings:major"},
+	"meanings:minor": {"meanings:minor"},
+}
+
+// useCached reads a value through the application cache, calling load on a miss
+func useCached[T any](c echo.Context, a *app.App, key string, load func() (T, error)) (T, error) {
+	return cache.Fetch(c.Request().Context(), a.Cache, key, load)
+}
+
+// cachedRow is the cached form of a versioned resource, whose row version is
+// not part of its own JSON form
+type cachedRow[R any] struct {
+	Value     *R        `json:"value"`
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// useCachedRow is useCached for a single resource embedding models.RowVersion:
+// the resource comes back with its version, as needed for its ETag
+func useCachedRow[R any, P interface {
+	*R
+	SetRow(models.RowVersion)
+}](c echo.Context, a *app.App, key string, load func() (*R, error)) (*R, error) {
+	cached, err := useCached(c, a, key, func() (cachedRow[R], error) {
+		value, err := load()
+		if err != nil {
+			return cachedRow[R]{}, err
+		}
+		row := rowVersion(value)
+		return cachedRow[R]{Value: value, Version: row.Version, UpdatedAt: row.UpdatedAt}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	P(cached.Value).SetRow(models.RowVersion{Version: cached.Version, UpdatedAt: cached.UpdatedAt})
+	return cached.Value, nil
+}
+
+// useInvalidate drops cached values affected by a successful write to entity
+func useInvalidate(c echo.Context, a *app.App, entity string) {
+	a.Cache.DeletePrefix(c.Request().Context(), cacheDependents[entity]...)
+}
+
+// filterCacheKey builds a stable cache key from a prefix and query filters
+func filterCacheKey(prefix string, filters map[string]any) string {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s=%v", key, filters[key])
+	}
+	return prefix + ":" + strings.Join(parts, "&")
+}
+
+// CacheStatsHandler returns application cache counters
+// @Summary Cache statistics
+// @Description Returns hit/miss/eviction counters of the application cache
+// @Tags service
+// @Produce json
+// @Success 200 {object} cache.Stats
+// @Router /cache/stats [get]
+func CacheStatsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, a.Cache.Stats())
+	}
+}
@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_filterCacheKey_IsStable(t *testing.T) {
+	key := filterCacheKey("meanings:major", map[string]any{"source": int64(2), "number": 5})
+	assert.Equal(t, "meanings:major:number=5&source=2", key)
+}
+
+func Test_filterCacheKey_NoFilters(t *testing.T) {
+	assert.Equal(t, "meanings:minor:", filterCacheKey("meanings:minor", map[string]any{}))
+}
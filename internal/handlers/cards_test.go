@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCards_MinorCardNameAndMeanings(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite"})
+	rec := ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name: "Rider-Waite", Sources: []models.IDOnly{{ID: sourceID}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Жезлы", Genitive: "Жезлов"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Туз"})
+	meaningID := createEntity(t, ta, "/meanings/minor", models.MeaningMinorInput{
+		Suit: suitID, Rank: rankID, Position: models.PositionStraight, Source: sourceID, Meaning: "Начало",
+	})
+
+	cardID := createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+
+	rec = ta.Request(http.MethodGet, "/cards/minor?deckId=1", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	list := decodeBody[[]models.CardMinor](t, rec.Body.Bytes())
+	require.Len(t, list, 1)
+	assert.Equal(t, "Туз Жезлов", list[0].Name)
+
+	rec = ta.Request(http.MethodGet, "/cards/minor/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	card := decodeBody[models.CardMinor](t, rec.Body.Bytes())
+	assert.Equal(t, cardID, card.ID)
+	assert.Equal(t, []models.MeaningRef{{ID: meaningID, Position: "straight", SourceID: sourceID}}, card.Meanings)
+
+	// A suit still used by cards is only deleted with force
+	rec = ta.Request(http.MethodDelete, "/suits/1", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Deleting the deck removes its cards
+	rec = ta.Request(http.MethodDelete, "/decks/1?force=true", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = ta.Request(http.MethodGet, "/cards/minor/1", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCards_MajorCardsSortedByNumber(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Marseille"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 1, Name: "Маг"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "Шут"})
+
+	rec := ta.Request(http.MethodGet, "/cards/major?deckId=1", nil)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	list := decodeBody[[]models.CardMajor](t, rec.Body.Bytes())
+	require.Len(t, list, 2)
+	assert.Equal(t, "Шут", list[0].Name)
+	assert.Equal(t, "Маг", list[1].Name)
+}
+
+func TestCards_UnknownDeckConflicts(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+
+	rec := ta.RequestJSON(http.MethodPost, "/cards/major", models.CardMajorInput{DeckID: 9, Number: 0, Name: "Шут"})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestCards_WritesReturnStoredCard(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Marseille"})
+
+	rec := ta.RequestJSON(http.MethodPost, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "Шут"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "/cards/major/1", rec.Header().Get("Location"))
+	assert.Equal(t, "Шут", decodeBody[models.CardMajor](t, rec.Body.Bytes()).Name)
+
+	rec = ta.RequestJSON(http.MethodPut, "/cards/major/1", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "Дурак"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	card := decodeBody[models.CardMajor](t, rec.Body.Bytes())
+	assert.Equal(t, int64(1), card.ID)
+	assert.Equal(t, "Дурак", card.Name)
+}
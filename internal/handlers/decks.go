@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/ilbagatto/tarot-api/internal/app"
@@ -20,7 +22,7 @@ func ListDecksHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		var decks []models.DeckListItem
 		var err error
-		decks, err = models.ListDecks(a.DB)
+		decks, err = a.Repos.Decks.List(c.Request().Context())
 
 		if err != nil {
 			return useHandleDBError(c, err)
@@ -36,6 +38,7 @@ func ListDecksHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Deck ID"
 // @Success 200 {object} models.Deck
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /decks/{id} [get]
@@ -46,11 +49,14 @@ func GetDeckByIDHandler(a *app.App) echo.HandlerFunc {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		deck, err := models.GetDeckByID(a.DB, deckID)
+		deck, err := useCachedRow(c, a, fmt.Sprintf("decks:%d", deckID), func() (*models.Deck, error) {
+			return a.Repos.Decks.Get(c.Request().Context(), deckID)
+		})
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Deck not found")
 		}
 
+		useETag(c, deck)
 		return c.JSON(http.StatusOK, deck)
 	}
 }
@@ -62,7 +68,9 @@ func GetDeckByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param deck body models.DeckInput true "Deck input"
-// @Success 201 {object} models.IDOnly
+// @Success 201 {object} models.Deck
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 409 {object} APIResponse
 // @Failure 500 {object} APIResponse
@@ -74,12 +82,14 @@ func CreateDeckHandler(a *app.App) echo.HandlerFunc {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		id, err := models.CreateDeck(a.DB, input)
+		id, err := a.Repos.Decks.Create(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "decks")
+		a.Metrics.EntityCreated("deck")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Decks.Get, "Deck not found")
 	}
 }
 
@@ -91,10 +101,14 @@ func CreateDeckHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Deck ID"
 // @Param deck body models.DeckInput true "Deck input"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.Deck
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Failure 409 {object} APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /decks/{id} [put]
 func UpdateDeckHandler(a *app.App) echo.HandlerFunc {
@@ -109,35 +123,124 @@ func UpdateDeckHandler(a *app.App) echo.HandlerFunc {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		deck, err := models.UpdateDeck(a.DB, deckID, input)
+		version, err := useIfMatch(c, a, deckID, a.Repos.Decks.Get)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Deck not found")
 		}
+		if err := a.Repos.Decks.Update(c.Request().Context(), deckID, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Deck not found")
+		}
+		useInvalidate(c, a, "decks")
 
-		return c.JSON(http.StatusOK, deck)
+		return useRespondStored(c, http.StatusOK, deckID, a.Repos.Decks.Get, "Deck not found")
+	}
+}
+
+// PatchDeckHandler partially updates a deck
+// @Summary Partially update a deck
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags decks
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param deck body models.DeckInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.Deck
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /decks/{id} [patch]
+func PatchDeckHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.Deck, models.DeckInput]{
+			entity:   "decks",
+			notFound: "Deck not found",
+			get:      a.Repos.Decks.Get,
+			input:    (*models.Deck).Input,
+			update:   a.Repos.Decks.Update,
+		})
 	}
 }
 
 // DeleteDeckHandler handles DELETE /decks/:id
 // @Summary Delete deck by ID
-// @Description Deletes a deck and its associations
+// @Description Moves a deck with its cards to the trash, see /trash. A deck with cards or linked sources is only deleted with force=true
 // @Tags decks
 // @Produce json
 // @Param id path int true "Deck ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /decks/{id} [delete]
 func DeleteDeckHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return useDelete(c, a, deleteSpec[models.Deck]{
+			entity:     "decks",
+			notFound:   "Deck not found",
+			get:        a.Repos.Decks.Get,
+			dependents: a.Repos.Decks.Dependents,
+			del:        a.Repos.Decks.Delete,
+		})
+	}
+}
+
+// ScaffoldDeckHandler handles POST /decks/:id/scaffold
+// @Summary Create the standard cards of a deck
+// @Description Creates the 22 Major Arcana of an empty deck, numbered and named in a naming tradition (rider-waite by default, or marseille), and a Minor Arcana card for every suit and rank. Image paths are set from the optional patterns, which are image templates like those of decks: {arcana}, {number} (of a Major Arcana card, or the rank ID), {suit} and {rank} (slugs)
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Param scaffold body models.ScaffoldInput false "Naming tradition and image patterns"
+// @Success 201 {object} models.ScaffoldResult
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse "The deck already has cards"
+// @Failure 500 {object} handlers.APIResponse
+// @Router /decks/{id}/scaffold [post]
+func ScaffoldDeckHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		deckID, err := useIDParam(c)
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		if err := models.DeleteDeck(a.DB, deckID); err != nil {
-			return useHandleDBError(c, err)
+		// An empty body scaffolds with the defaults
+		var input models.ScaffoldInput
+		if err := useBind(c, &input); err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		result, err := a.Repos.Cards.Scaffold(c.Request().Context(), deckID, input)
+		switch {
+		case errors.Is(err, models.ErrUnknownTradition):
+			return SendError(c, http.StatusBadRequest, err)
+		case errors.Is(err, models.ErrDeckNotEmpty):
+			return SendError(c, http.StatusConflict, err)
+		case err != nil:
+			return useHandleNotFoundOrDBError(c, err, "Deck not found")
+		}
+		useInvalidate(c, a, "decks")
+		for range result.MajorCards {
+			a.Metrics.EntityCreated("card_major")
 		}
-		return c.NoContent(http.StatusNoContent)
+		for range result.MinorCards {
+			a.Metrics.EntityCreated("card_minor")
+		}
+
+		return c.JSON(http.StatusCreated, result)
 	}
 }
@@ -0,0 +1,210 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/ilbagatto/tarot-api/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeBody[T any](t *testing.T, body []byte) T {
+	t.Helper()
+	var v T
+	require.NoError(t, json.Unmarshal(body, &v))
+	return v
+}
+
+func createEntity(t *testing.T, ta *testutils.TestApp, path string, input any) int64 {
+	t.Helper()
+	rec := ta.RequestJSON(http.MethodPost, path, input)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	return decodeBody[models.IDOnly](t, rec.Body.Bytes()).ID
+}
+
+func TestDecks_CRUD(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite", Image: "rider.png"})
+
+	rec := ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name:    "Rider-Waite-Smith",
+		Image:   "rider.png",
+		Sources: []models.IDOnly{{ID: sourceID}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, deckID, deck.ID)
+	assert.Equal(t, "Rider-Waite-Smith", deck.Name)
+	assert.Equal(t, []models.Source{{ID: sourceID, Name: "Papus"}}, deck.Sources)
+
+	rec = ta.Request(http.MethodDelete, "/decks/1?force=true", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDecks_DuplicateNameConflicts(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	rec := ta.RequestJSON(http.MethodPost, "/decks", models.DeckInput{Name: "Thoth"})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Duplicate entry")
+}
+
+func TestDecks_UnknownSourceConflicts(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	rec := ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name:    "Thoth",
+		Sources: []models.IDOnly{{ID: 42}},
+	})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Invalid reference")
+
+	rec = ta.RequestJSON(http.MethodPost, "/decks", models.DeckInput{
+		Name:    "Marseille",
+		Sources: []models.IDOnly{{ID: 42}},
+	})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Invalid reference")
+}
+
+func TestDecks_UpdateMissingReturns404(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+
+	rec := ta.RequestJSON(http.MethodPut, "/decks/7", models.DeckInput{Name: "Marseille"})
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDecks_CreateReturnsStoredDeck(t *testing.T) {
+	utils.SetStaticURL("https://static.example.com")
+	t.Cleanup(func() { utils.SetStaticURL("") })
+	ta := testutils.SetupMemoryApp()
+
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Crowley"})
+
+	rec := ta.RequestJSON(http.MethodPost, "/decks", models.DeckInput{
+		Name: "Thoth", Image: "thoth/cover.png", Sources: []models.IDOnly{{ID: sourceID}},
+	})
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "/decks/1", rec.Header().Get("Location"))
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, int64(1), deck.ID)
+	assert.Equal(t, "Thoth", deck.Name)
+	assert.Equal(t, "https://static.example.com/images/thoth/cover.png", deck.Image)
+	assert.Equal(t, []models.Source{{ID: sourceID, Name: "Crowley"}}, deck.Sources)
+}
+
+func TestDecks_UpdateReturnsStoredDeck(t *testing.T) {
+	utils.SetStaticURL("https://static.example.com")
+	t.Cleanup(func() { utils.SetStaticURL("") })
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Жезлы", Genitive: "Жезлов"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Туз"})
+	createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+
+	rec := ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name:    "Thoth",
+		Image:   "thoth/cover.png",
+		Sources: []models.IDOnly{{ID: sourceID}},
+	})
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, "https://static.example.com/images/thoth/cover.png", deck.Image)
+	assert.NotEmpty(t, deck.Thumbnail)
+	assert.True(t, deck.HasMinorCards)
+	assert.Equal(t, []models.Source{{ID: sourceID, Name: "Papus"}}, deck.Sources)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, deck, decodeBody[models.Deck](t, rec.Body.Bytes()))
+}
+
+func TestDecks_ScaffoldCreatesStandardCards(t *testing.T) {
+	utils.SetStaticURL("https://static.example.com")
+	t.Cleanup(func() { utils.SetStaticURL("") })
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Marseille"})
+	for _, name := range []string{"Жезлы", "Мечи"} {
+		createEntity(t, ta, "/suits", models.SuitInput{Name: name, Genitive: name})
+	}
+	for _, name := range []string{"Туз", "Двойка", "Тройка"} {
+		createEntity(t, ta, "/ranks", models.RankInput{Name: name})
+	}
+
+	rec := ta.RequestJSON(http.MethodPost, "/decks/1/scaffold", models.ScaffoldInput{
+		Tradition:   "marseille",
+		MajorImages: "marseille/major/{number}.png",
+		MinorImages: "marseille/minor/{suit}/{rank}.png",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	result := decodeBody[models.ScaffoldResult](t, rec.Body.Bytes())
+	assert.Len(t, result.MajorCards, 22)
+	assert.Len(t, result.MinorCards, 6)
+
+	rec = ta.Request(http.MethodGet, "/cards/major?deckId=1", nil)
+	majors := decodeBody[[]models.CardMajor](t, rec.Body.Bytes())
+	require.Len(t, majors, 22)
+	assert.Equal(t, "Шут", majors[0].Name)
+	assert.Equal(t, "Le Mat", majors[0].OrgName)
+	assert.Equal(t, 21, majors[21].Number)
+	assert.Equal(t, "https://static.example.com/images/marseille/major/21.png", *majors[21].Image)
+
+	rec = ta.Request(http.MethodGet, "/cards/minor?deckId=1", nil)
+	minors := decodeBody[[]models.CardMinor](t, rec.Body.Bytes())
+	require.Len(t, minors, 6)
+	assert.Equal(t, "https://static.example.com/images/marseille/minor/02/03.png", *minors[5].Image)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	assert.True(t, decodeBody[models.Deck](t, rec.Body.Bytes()).HasMinorCards)
+
+	// A deck is only scaffolded once
+	rec = ta.Request(http.MethodPost, "/decks/1/scaffold", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestDecks_ScaffoldDefaultsAndErrors(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite"})
+
+	rec := ta.Request(http.MethodPost, "/decks/7/scaffold", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = ta.RequestJSON(http.MethodPost, "/decks/1/scaffold", models.ScaffoldInput{Tradition: "thoth"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "marseille, rider-waite")
+
+	// Without a body the Major Arcana get the Rider-Waite names and no images
+	rec = ta.Request(http.MethodPost, "/decks/1/scaffold", nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Empty(t, decodeBody[models.ScaffoldResult](t, rec.Body.Bytes()).MinorCards)
+
+	rec = ta.Request(http.MethodGet, "/cards/major?deckId=1", nil)
+	majors := decodeBody[[]models.CardMajor](t, rec.Body.Bytes())
+	require.Len(t, majors, 22)
+	assert.Equal(t, "The Hierophant", majors[5].OrgName)
+	assert.Nil(t, majors[5].Image)
+
+	rec = ta.Request(http.MethodGet, "/audit?entity=card_major", nil)
+	assert.Len(t, decodeBody[[]models.AuditEntry](t, rec.Body.Bytes()), 22)
+}
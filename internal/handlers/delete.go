@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteReport lists what a delete removes along with the resource.
+// It is the response to a dry run, and to a delete refused for lack of force.
+type DeleteReport struct {
+	Error      string            `json:"error,omitempty"`
+	RequestID  string            `json:"requestId,omitempty"`
+	Dependents models.Dependents `json:"dependents"`
+}
+
+// deleteSpec tells useDelete how to delete one kind of resource
+type deleteSpec[R any] struct {
+	entity   string // written entity, see cacheDependents
+	notFound string
+	get      func(ctx context.Context, id int64) (*R, error)
+	// dependents is nil for resources nothing depends on
+	dependents func(ctx context.Context, id int64) (*models.Dependents, error)
+	del        func(ctx context.Context, id int64, version int64) error
+}
+
+// useDelete implements DELETE. A missing resource is reported as 404.
+// With dry_run=true nothing is deleted: the response lists the dependents that
+// the delete would remove. A resource with dependents is only deleted with
+// force=true, otherwise the request fails with 409 and the same list.
+func useDelete[R any](c echo.Context, a *app.App, spec deleteSpec[R]) error {
+	id, err := useIDParam(c)
+	if err != nil {
+		return SendError(c, http.StatusBadRequest, err)
+	}
+	dryRun, err := useBoolParam(c, "dry_run")
+	if err != nil {
+		return SendError(c, http.StatusBadRequest, err)
+	}
+	force, err := useBoolParam(c, "force")
+	if err != nil {
+		return SendError(c, http.StatusBadRequest, err)
+	}
+
+	ctx := c.Request().Context()
+	current, err := spec.get(ctx, id)
+	if err != nil {
+		return useHandleNotFoundOrDBError(c, err, spec.notFound)
+	}
+	// The dependents are checked in the transaction of the delete, so that
+	// none is added in between
+	var report DeleteReport
+	deleted := false
+	err = a.Repos.Tx.InTx(ctx, func(ctx context.Context) error {
+		if spec.dependents != nil {
+			deps, err := spec.dependents(ctx, id)
+			if err != nil {
+				return err
+			}
+			report.Dependents = *deps
+		}
+		if dryRun || (!force && !report.Dependents.IsEmpty()) {
+			return nil
+		}
+		version, err := ifMatchVersion(c, a, current)
+		if err != nil {
+			return err
+		}
+		deleted = true
+		return spec.del(ctx, id, version)
+	})
+	switch {
+	case err != nil:
+		return useHandleNotFoundOrDBError(c, err, spec.notFound)
+	case dryRun:
+		return c.JSON(http.StatusOK, report)
+	case !deleted:
+		report.Error = "Other data depends on this resource: delete with force=true to remove it as well"
+		report.RequestID = middleware.GetRequestID(c)
+		return c.JSON(http.StatusConflict, report)
+	}
+	useInvalidate(c, a, spec.entity)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// useBoolParam reads an optional boolean query parameter, false when absent
+func useBoolParam(c echo.Context, name string) (bool, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: must be true or false", name)
+	}
+	return b, nil
+}
@@ -0,0 +1,125 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelete_MissingIsNotFound(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	for _, path := range []string{
+		"/decks/7", "/sources/7", "/suits/7", "/ranks/7", "/spreads/7",
+		"/cards/major/7", "/cards/minor/7", "/meanings/major/7", "/meanings/minor/7",
+	} {
+		rec := ta.Request(http.MethodDelete, path, nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+}
+
+func TestDelete_DependentsRequireForce(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name: "Thoth", Sources: []models.IDOnly{{ID: sourceID}},
+	}).Code)
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Cups", Genitive: "of Cups"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+	cardID := createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+	meaningID := createEntity(t, ta, "/meanings/minor", models.MeaningMinorInput{
+		Suit: suitID, Rank: rankID, Position: models.PositionStraight, Source: sourceID, Meaning: "Love",
+	})
+
+	rec := ta.Request(http.MethodDelete, "/decks/1?dry_run=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	report := decodeBody[handlers.DeleteReport](t, rec.Body.Bytes())
+	assert.Equal(t, models.Dependents{MinorCards: []int64{cardID}, Sources: []int64{sourceID}}, report.Dependents)
+
+	rec = ta.Request(http.MethodDelete, "/sources/1?dry_run=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	report = decodeBody[handlers.DeleteReport](t, rec.Body.Bytes())
+	assert.Equal(t, models.Dependents{MinorMeanings: []int64{meaningID}, Decks: []int64{deckID}}, report.Dependents)
+
+	rec = ta.Request(http.MethodDelete, "/suits/1", nil)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	report = decodeBody[handlers.DeleteReport](t, rec.Body.Bytes())
+	assert.NotEmpty(t, report.Error)
+	assert.Equal(t, models.Dependents{MinorCards: []int64{cardID}, MinorMeanings: []int64{meaningID}}, report.Dependents)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/suits/1", nil).Code)
+
+	rec = ta.Request(http.MethodDelete, "/suits/1?force=true", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/suits/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/cards/minor/1", nil).Code)
+	// The meaning is hidden along with its suit
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/meanings/minor/1", nil).Code)
+
+	// The card in the trash no longer counts
+	rec = ta.Request(http.MethodDelete, "/decks/1?dry_run=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, models.Dependents{Sources: []int64{sourceID}}, decodeBody[handlers.DeleteReport](t, rec.Body.Bytes()).Dependents)
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/trash/suit/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/meanings/minor/1", nil).Code)
+
+	// Nothing depends on the rank any more
+	rec = ta.Request(http.MethodDelete, "/ranks/1?dry_run=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, decodeBody[handlers.DeleteReport](t, rec.Body.Bytes()).Dependents.IsEmpty())
+	assert.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/ranks/1", nil).Code)
+}
+
+func TestDelete_InvalidFlag(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	rec := ta.Request(http.MethodDelete, "/decks/1?force=yes", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/decks/1", nil).Code)
+}
+
+type inTxKey struct{}
+
+// markingTx marks the context of the functions it runs in a transaction
+type markingTx struct{ repository.Transactor }
+
+func (tx markingTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return tx.Transactor.InTx(ctx, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, inTxKey{}, true))
+	})
+}
+
+// txDecks records which calls were made in a transaction
+type txDecks struct {
+	repository.DeckRepository
+	calls map[string]bool
+}
+
+func (r txDecks) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.calls["dependents"] = ctx.Value(inTxKey{}) == true
+	return r.DeckRepository.Dependents(ctx, id)
+}
+
+func (r txDecks) Delete(ctx context.Context, id int64, version int64) error {
+	r.calls["delete"] = ctx.Value(inTxKey{}) == true
+	return r.DeckRepository.Delete(ctx, id, version)
+}
+
+func TestDelete_ChecksDependentsInTransactionOfDelete(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	calls := map[string]bool{}
+	ta.App.Repos.Tx = markingTx{ta.App.Repos.Tx}
+	ta.App.Repos.Decks = txDecks{ta.App.Repos.Decks, calls}
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/decks/1", nil).Code)
+	assert.Equal(t, map[string]bool{"dependents": true, "delete": true}, calls)
+}
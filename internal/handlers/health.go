@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/db"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+// HealthCheck is the outcome of a single readiness check
+type HealthCheck struct {
+	Status   string `json:"status" example:"ok"`
+	Latency  string `json:"latency,omitempty" example:"1.2ms"`
+	Version  *int64 `json:"version,omitempty" example:"1"`
+	Expected *int64 `json:"expected,omitempty" example:"1"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HealthStatus is the response of the health endpoints
+type HealthStatus struct {
+	Status string                 `json:"status" example:"ok"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
+
+// HealthzHandler reports that the process is alive
+// @Summary Liveness probe
+// @Description Returns 200 as long as the process is able to serve requests
+// @Tags service
+// @Produce json
+// @Success 200 {object} HealthStatus
+// @Router /healthz [get]
+func HealthzHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, HealthStatus{Status: statusOK})
+	}
+}
+
+// ReadyzHandler reports whether the service can handle traffic
+// @Summary Readiness probe
+// @Description Checks the database connection, the schema migration version and the static image directory.
+// @Description Fails while the server is shutting down.
+// @Tags service
+// @Produce json
+// @Success 200 {object} HealthStatus
+// @Failure 503 {object} HealthStatus
+// @Router /readyz [get]
+func ReadyzHandler(a *app.App) echo.HandlerFunc {
+	timeout := a.Config.Server.ReadinessTimeout
+	staticDir := a.Config.Static.Dir
+
+	return func(c echo.Context) error {
+		ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+		defer cancel()
+
+		checks := map[string]HealthCheck{
+			"database":   checkDatabase(ctx, a),
+			"migrations": checkMigrations(ctx, a),
+			"static":     checkStaticDir(staticDir),
+		}
+		if a.IsShuttingDown() {
+			checks["shutdown"] = HealthCheck{Status: statusFail, Error: "server is shutting down"}
+		}
+
+		result := HealthStatus{Status: statusOK, Checks: checks}
+		for _, check := range checks {
+			if check.Status != statusOK {
+				result.Status = statusFail
+				return c.JSON(http.StatusServiceUnavailable, result)
+			}
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+func checkDatabase(ctx context.Context, a *app.App) HealthCheck {
+	start := time.Now()
+	if err := a.DB.PingContext(ctx); err != nil {
+		return HealthCheck{Status: statusFail, Error: err.Error()}
+	}
+	return HealthCheck{Status: statusOK, Latency: time.Since(start).String()}
+}
+
+func checkMigrations(ctx context.Context, a *app.App) HealthCheck {
+	expected, err := db.LatestMigrationVersion(db.DialectOf(a.Config.Database.DSN))
+	if err != nil {
+		return HealthCheck{Status: statusFail, Error: err.Error()}
+	}
+	version, dirty, err := db.SchemaVersion(ctx, a.DB)
+	if err != nil {
+		return HealthCheck{Status: statusFail, Expected: &expected, Error: err.Error()}
+	}
+
+	check := HealthCheck{Status: statusOK, Version: &version, Expected: &expected}
+	switch {
+	case dirty:
+		check.Status = statusFail
+		check.Error = "schema is dirty"
+	case version != expected:
+		check.Status = statusFail
+		check.Error = fmt.Sprintf("schema version %d, expected %d", version, expected)
+	}
+	return check
+}
+
+func checkStaticDir(dir string) HealthCheck {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return HealthCheck{Status: statusFail, Error: err.Error()}
+	}
+	if !info.IsDir() {
+		return HealthCheck{Status: statusFail, Error: dir + " is not a directory"}
+	}
+	return HealthCheck{Status: statusOK}
+}
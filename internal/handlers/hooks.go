@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -8,7 +9,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ilbagatto/tarot-api/internal/logging"
+	"github.com/ilbagatto/tarot-api/internal/models"
 	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
 // useIDParam extracts and validates an integer ID from path or query parameters.
@@ -48,19 +52,66 @@ func useBind[T any](c echo.Context, target *T) error {
 	return nil
 }
 
-// useHandleDBError translates model-level errors into HTTP responses
+// useHandleDBError translates model-level errors into HTTP responses.
+// Server-side failures are logged with the request context.
 func useHandleDBError(c echo.Context, err error) error {
 	if err == nil {
 		return nil
 	}
 	status, resp := HTTPErrorFromDBError(err)
-	return c.JSON(status, resp)
+	logServerError(c, status, err)
+	return sendErrorResponse(c, status, resp)
 }
 
-// useHandleNotFoundOrDBError handles sql.ErrNoRows and general DB errors
+// useHandleNotFoundOrDBError handles sql.ErrNoRows, failed preconditions
+// of conditional writes and general DB errors
 func useHandleNotFoundOrDBError(c echo.Context, err error, notFoundMsg string) error {
-	if errors.Is(err, sql.ErrNoRows) {
-		return c.JSON(http.StatusNotFound, APIResponse{Error: notFoundMsg})
+	status, resp := notFoundOrDBError(err, notFoundMsg)
+	logServerError(c, status, err)
+	return sendErrorResponse(c, status, resp)
+}
+
+// notFoundOrDBError returns the status and response of useHandleNotFoundOrDBError
+func notFoundOrDBError(err error, notFoundMsg string) (int, APIResponse) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound, APIResponse{Error: notFoundMsg}
+	case errors.Is(err, models.ErrVersionMismatch):
+		return http.StatusPreconditionFailed,
+			APIResponse{Error: "Resource was modified meanwhile: fetch it again and retry with its ETag"}
+	case errors.Is(err, errPreconditionRequired):
+		return http.StatusPreconditionRequired, NewErrorResponse(err)
+	}
+	return HTTPErrorFromDBError(err)
+}
+
+// logServerError logs err with the request context if status is a server-side failure
+func logServerError(c echo.Context, status int, err error) {
+	if status < http.StatusInternalServerError {
+		return
+	}
+	logging.FromContext(c).Error("database error",
+		zap.Error(err),
+		zap.String("method", c.Request().Method),
+		zap.String("route", c.Path()),
+		zap.Strings("params", c.ParamValues()),
+		zap.String("query", c.QueryString()),
+	)
+}
+
+// useRespondStored re-reads the resource id after a write and responds with it,
+// so that clients get what is stored (full image URLs, names of linked entities)
+// rather than an echo of their input, along with its new ETag.
+// A 201 response also gets a Location header.
+func useRespondStored[R any](c echo.Context, status int, id int64,
+	get func(ctx context.Context, id int64) (*R, error), notFoundMsg string) error {
+	resource, err := get(c.Request().Context(), id)
+	if err != nil {
+		return useHandleNotFoundOrDBError(c, err, notFoundMsg)
+	}
+	useETag(c, resource)
+	if status == http.StatusCreated {
+		c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", c.Path(), id))
 	}
-	return useHandleDBError(c, err)
+	return c.JSON(status, resource)
 }
@@ -1,10 +1,15 @@
 package handlers
 
 import (
+	"errors"
 	"net/http/httptest"
 
+	"github.com/ilbagatto/tarot-api/internal/logging"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"testing"
 )
@@ -67,3 +72,56 @@ func Test_useIDParam_InvalidParam(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "must be an integer")
 }
+
+func Test_useHandleDBError_LogsServerErrors(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	e := echo.New()
+	req := httptest.NewRequest("GET", "/decks", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	logging.SetLogger(c, zap.New(core))
+
+	err := useHandleDBError(c, errors.New("connection refused"))
+	assert.NoError(t, err)
+	assert.Equal(t, 500, rec.Code)
+	assert.Equal(t, 1, logs.Len())
+	assert.Equal(t, "connection refused", logs.All()[0].ContextMap()["error"])
+}
+
+func Test_useHandleDBError_DoesNotLogClientErrors(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	e := echo.New()
+	req := httptest.NewRequest("POST", "/decks", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	logging.SetLogger(c, zap.New(core))
+
+	_ = useHandleDBError(c, errors.New(`duplicate key value violates unique constraint "deck_name_unique_idx"`))
+	assert.Equal(t, 409, rec.Code)
+	assert.Equal(t, 0, logs.Len())
+}
+
+func Test_SendError_IncludesRequestID(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest("GET", "/decks/abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Response().Header().Set(echo.HeaderXRequestID, "req-123")
+
+	_ = SendError(c, 400, errors.New("invalid id"))
+	assert.JSONEq(t, `{"error":"invalid id","requestId":"req-123"}`, rec.Body.String())
+}
+
+func Test_HTTPErrorHandler_RendersAPIResponse(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	req := httptest.NewRequest("GET", "/unknown", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-456")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Response().Header().Set(echo.HeaderXRequestID, "req-456")
+
+	HTTPErrorHandler(echo.ErrNotFound, c)
+	assert.Equal(t, 404, rec.Code)
+	assert.JSONEq(t, `{"error":"Not Found","requestId":"req-456"}`, rec.Body.String())
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/ilbagatto/tarot-api/internal/imaging"
+	"github.com/ilbagatto/tarot-api/internal/logging"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// ImageCheckReport lists the cards of a deck whose image file is missing
+type ImageCheckReport struct {
+	Checked int            `json:"checked" example:"78"`
+	Missing []MissingImage `json:"missing"`
+}
+
+// MissingImage is a card whose image file is missing, and why
+type MissingImage struct {
+	models.CardImagePath
+	Error string `json:"error" example:"file does not exist"`
+}
+
+// CheckDeckImagesHandler handles GET /decks/:id/images/check
+// @Summary Check the card images of a deck
+// @Description Resolves the image path of every card of a deck, its own or from the image templates of the deck, and checks that the file exists in the static image directory. Cards without any image path are reported as missing too
+// @Tags decks
+// @Produce json
+// @Param id path int true "Deck ID"
+// @Success 200 {object} handlers.ImageCheckReport
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /decks/{id}/images/check [get]
+func CheckDeckImagesHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		deckID, err := useIDParam(c)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		images, err := a.Repos.Cards.ListImages(c.Request().Context(), deckID)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Deck not found")
+		}
+
+		static := os.DirFS(a.Config.Static.Dir)
+		report := ImageCheckReport{Checked: len(images), Missing: []MissingImage{}}
+		for _, img := range images {
+			if err := checkImageFile(static, img.Path); err != nil {
+				report.Missing = append(report.Missing, MissingImage{CardImagePath: img, Error: err.Error()})
+			}
+		}
+		return c.JSON(http.StatusOK, report)
+	}
+}
+
+// checkImageFile checks that path names a regular file of the static storage
+func checkImageFile(static fs.FS, path string) error {
+	if path == "" {
+		return errors.New("card has no image path")
+	}
+	name := strings.TrimPrefix(path, "/")
+	if !fs.ValidPath(name) {
+		return errors.New("invalid image path")
+	}
+	info, err := fs.Stat(static, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fs.ErrNotExist
+	}
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.New("not a file")
+	}
+	return nil
+}
+
+// ServeCardImageHandler handles GET /img/:deck/:card
+// @Summary Get the image of a card, scaled
+// @Description Renders the image of a card, its own or from the image templates of the deck, scaled to fit w and h and converted to fmt. Renditions are cached on disk; without parameters the original is served as it is. Images are never scaled up; w and h are rounded up to the configured size step, unless they are the width of a configured size
+// @Tags images
+// @Produce jpeg,png,gif,image/webp,image/avif
+// @Param deck path int true "Deck ID"
+// @Param card path int true "Card ID"
+// @Param w query int false "Width in pixels"
+// @Param h query int false "Height in pixels"
+// @Param fmt query string false "jpeg, png, gif, webp or avif; the format of the original by default (png if it is none of them)"
+// @Param fit query string false "contain (default), cover or fill, with both w and h"
+// @Success 200 {file} binary
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Failure 503 {object} handlers.APIResponse "The request ended while waiting for a render"
+// @Router /img/{deck}/{card} [get]
+func ServeCardImageHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		deckID, err := useIDParam(c, "deck")
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		cardID, err := useIDParam(c, "card")
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		opts, err := useImageOptions(c, a.Config)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		images, err := useCached(c, a, fmt.Sprintf("cards:images:deck:%d", deckID), func() ([]models.CardImagePath, error) {
+			return a.Repos.Cards.ListImages(c.Request().Context(), deckID)
+		})
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Deck not found")
+		}
+		i := slices.IndexFunc(images, func(img models.CardImagePath) bool { return img.CardID == cardID })
+		if i < 0 {
+			return SendError(c, http.StatusNotFound, errors.New("card not found"))
+		}
+		return useServeImage(c, a, images[i].Path, opts, fmt.Sprintf("image of card %d", cardID))
+	}
+}
+
+// ServeBackImageHandler handles GET /img/:deck/back
+// @Summary Get the card back of a deck, scaled
+// @Description Renders the card back of a deck like the image of a card, see GET /img/{deck}/{card}
+// @Tags images
+// @Produce jpeg,png,gif,image/webp,image/avif
+// @Param deck path int true "Deck ID"
+// @Param w query int false "Width in pixels"
+// @Param h query int false "Height in pixels"
+// @Param fmt query string false "jpeg, png, gif, webp or avif; the format of the original by default (png if it is none of them)"
+// @Param fit query string false "contain (default), cover or fill, with both w and h"
+// @Success 200 {file} binary
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Failure 503 {object} handlers.APIResponse "The request ended while waiting for a render"
+// @Router /img/{deck}/back [get]
+func ServeBackImageHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		deckID, err := useIDParam(c, "deck")
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		opts, err := useImageOptions(c, a.Config)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		// Not through the cache, which keeps the deck as it is represented,
+		// without the path of its card back
+		deck, err := a.Repos.Decks.Get(c.Request().Context(), deckID)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Deck not found")
+		}
+		return useServeImage(c, a, deck.BackImagePath, opts, fmt.Sprintf("card back of deck %d", deckID))
+	}
+}
+
+// ThumbnailHandler handles GET /thumbnails/*, serving the images of the
+// static image directory scaled to the thumbnail width
+func ThumbnailHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		opts := imaging.Options{Width: a.Config.ImageServer.ThumbnailWidth}
+		return useServeImage(c, a, c.Param("*"), opts, "image")
+	}
+}
+
+// useServeImage serves the static image at imgPath rendered as opts asks,
+// through the image cache, or the original as it is if opts asks for nothing
+// else. what names the image in errors.
+func useServeImage(c echo.Context, a *app.App, imgPath string, opts imaging.Options, what string) error {
+	original, info, err := openStaticImage(a.Config.Static.Dir, imgPath)
+	if err != nil {
+		return SendError(c, http.StatusNotFound, fmt.Errorf("%s: %w", what, err))
+	}
+	defer original.Close()
+
+	if cacheControl := config.CacheControl(a.Config.HTTPCache.Images); cacheControl != "" {
+		c.Response().Header().Set(echo.HeaderCacheControl, cacheControl)
+	}
+	if opts.Original(imgPath) {
+		// Set by ServeContent from the file name
+		c.Response().Header().Del(echo.HeaderContentType)
+		http.ServeContent(c.Response(), c.Request(), info.Name(), info.ModTime(), original)
+		return nil
+	}
+
+	opts = opts.Resolve(imgPath)
+	c.Response().Header().Set(echo.HeaderContentType, mime.TypeByExtension(imaging.Extension(opts.Format)))
+
+	key := opts.Key(imgPath, info.Size(), info.ModTime())
+	c.Response().Header().Set("ETag", `"`+strings.TrimSuffix(key, path.Ext(key))+`"`)
+	if a.Images != nil {
+		if cached, ok := a.Images.Get(key); ok {
+			defer cached.Close()
+			http.ServeContent(c.Response(), c.Request(), "", info.ModTime(), cached)
+			return nil
+		}
+	}
+
+	// The render may outlive this request and serve others, so it uses
+	// nothing of it: it opens the original again
+	dir, images, logger := a.Config.Static.Dir, a.Images, logging.FromContext(c)
+	rendered, err := a.Renderer.Do(c.Request().Context(), key, func(ctx context.Context) ([]byte, error) {
+		f, _, err := openStaticImage(dir, imgPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := imaging.Render(&buf, f, opts); err != nil {
+			return nil, err
+		}
+		if images != nil {
+			if err := images.Put(key, buf.Bytes()); err != nil {
+				logger.Warn("could not cache image", zap.String("path", imgPath), zap.Error(err))
+			}
+		}
+		return buf.Bytes(), nil
+	})
+	if err != nil {
+		c.Response().Header().Del("ETag")
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return SendError(c, http.StatusServiceUnavailable, fmt.Errorf("no time left to render %s", what))
+		}
+		logging.FromContext(c).Error("could not render image", zap.String("path", imgPath), zap.Error(err))
+		return SendError(c, http.StatusInternalServerError, fmt.Errorf("could not render %s", what))
+	}
+	http.ServeContent(c.Response(), c.Request(), "", info.ModTime(), bytes.NewReader(rendered))
+	return nil
+}
+
+// StaticImageHandler handles GET /images/*, serving the originals of the
+// static image directory
+func StaticImageHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		f, info, err := openStaticImage(a.Config.Static.Dir, c.Param("*"))
+		if err != nil {
+			return SendError(c, http.StatusNotFound, err)
+		}
+		defer f.Close()
+
+		if cacheControl := config.CacheControl(a.Config.HTTPCache.Images); cacheControl != "" {
+			c.Response().Header().Set(echo.HeaderCacheControl, cacheControl)
+		}
+		// Set by ServeContent from the file name
+		c.Response().Header().Del(echo.HeaderContentType)
+		http.ServeContent(c.Response(), c.Request(), info.Name(), info.ModTime(), f)
+		return nil
+	}
+}
+
+// useImageOptions reads the rendition requested by the w, h, fmt and fit
+// query parameters, each of them optional. w and h are rounded, see
+// roundDimension.
+func useImageOptions(c echo.Context, cfg *config.Config) (imaging.Options, error) {
+	maxDimension := cfg.ImageServer.MaxDimension
+	var opts imaging.Options
+	for _, dim := range []struct {
+		name   string
+		target *int
+	}{{"w", &opts.Width}, {"h", &opts.Height}} {
+		if value := c.QueryParam(dim.name); value != "" {
+			v, err := strconv.Atoi(value)
+			if err != nil || v < 1 || v > maxDimension {
+				return opts, fmt.Errorf("invalid %s: must be between 1 and %d", dim.name, maxDimension)
+			}
+			*dim.target = roundDimension(v, cfg)
+		}
+	}
+	opts.Format = c.QueryParam("fmt")
+	if opts.Format == "jpg" {
+		opts.Format = "jpeg"
+	}
+	if opts.Format != "" && !slices.Contains(imaging.Formats, opts.Format) {
+		return opts, fmt.Errorf("invalid fmt: must be one of %s", strings.Join(imaging.Formats, ", "))
+	}
+	opts.Fit = c.QueryParam("fit")
+	if opts.Fit != "" && !slices.Contains(imaging.Fits, opts.Fit) {
+		return opts, fmt.Errorf("invalid fit: must be one of %s", strings.Join(imaging.Fits, ", "))
+	}
+	return opts, nil
+}
+
+// roundDimension rounds up a requested width or height to a multiple of the
+// size step, unless it is the width of a configured size or of the thumbnails.
+// This bounds the renditions of an image that can be requested.
+func roundDimension(v int, cfg *config.Config) int {
+	if v == cfg.ImageServer.ThumbnailWidth ||
+		slices.ContainsFunc(cfg.Static.Sizes, func(size config.ImageSize) bool { return size.Width == v }) {
+		return v
+	}
+	step := cfg.ImageServer.SizeStep
+	return min((v+step-1)/step*step, cfg.ImageServer.MaxDimension)
+}
+
+// openStaticImage opens the regular file at path within the static image
+// directory, which it cannot escape
+func openStaticImage(dir, path string) (*os.File, fs.FileInfo, error) {
+	if path == "" {
+		return nil, nil, errors.New("no image")
+	}
+	f, err := os.OpenInRoot(dir, strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, nil, errors.New("image not found")
+	}
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		f.Close()
+		return nil, nil, errors.New("image not found")
+	}
+	return f, info, nil
+}
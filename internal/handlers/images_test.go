@@ -0,0 +1,266 @@
+package handlers_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/imaging"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/ilbagatto/tarot-api/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImages_TemplateIsFallbackForCardsWithoutImage(t *testing.T) {
+	utils.SetStaticURL("https://static.example.com")
+	t.Cleanup(func() { utils.SetStaticURL("") })
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{
+		Name:               "Rider-Waite",
+		MajorImageTemplate: "rws/{arcana}/{number}.jpg",
+		MinorImageTemplate: "rws/{suit}/{rank}-{number}.jpg",
+	})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Кубки", Genitive: "Кубков", Slug: "cups"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Туз"})
+	foolID := createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	magusID := createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 1, Name: "The Magician"})
+	createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+	ta.Store.SetCardImage(magusID, "rws/magician.png")
+
+	rec := ta.Request(http.MethodGet, "/cards/major?deckId=1", nil)
+	majors := decodeBody[[]models.CardMajor](t, rec.Body.Bytes())
+	require.Len(t, majors, 2)
+	assert.Equal(t, "https://static.example.com/images/rws/major/00.jpg", *majors[0].Image)
+	assert.Equal(t, "https://static.example.com/thumbnails/rws/major/00.jpg", *majors[0].Thumbnail)
+	assert.Equal(t, "https://static.example.com/images/rws/magician.png", *majors[1].Image)
+
+	rec = ta.Request(http.MethodGet, "/cards/minor/3", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "https://static.example.com/images/rws/cups/01-01.jpg", *decodeBody[models.CardMinor](t, rec.Body.Bytes()).Image)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, "rws/{arcana}/{number}.jpg", deck.MajorImageTemplate)
+
+	// Without a template, a card without an image has none
+	rec = ta.RequestJSON(http.MethodPatch, "/decks/1", map[string]any{"majorImageTemplate": nil})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = ta.Request(http.MethodGet, "/cards/major/"+strconv.FormatInt(foolID, 10), nil)
+	assert.Nil(t, decodeBody[models.CardMajor](t, rec.Body.Bytes()).Image)
+}
+
+func TestImages_CheckReportsMissingFiles(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	dir := t.TempDir()
+	ta.App.Config.Static.Dir = dir
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "rws", "major"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rws", "major", "00.jpg"), []byte("jpg"), 0o644))
+
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite", MajorImageTemplate: "rws/major/{number}.jpg"})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Кубки", Genitive: "Кубков"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Туз"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 1, Name: "The Magician"})
+	minorID := createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+	ta.Store.SetCardImage(minorID, "../secret.jpg")
+
+	rec := ta.Request(http.MethodGet, "/decks/1/images/check", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	report := decodeBody[handlers.ImageCheckReport](t, rec.Body.Bytes())
+	assert.Equal(t, 3, report.Checked)
+	assert.Equal(t, []handlers.MissingImage{
+		{CardImagePath: models.CardImagePath{CardID: 2, Arcana: "major", Path: "rws/major/01.jpg", Templated: true}, Error: "file does not exist"},
+		{CardImagePath: models.CardImagePath{CardID: minorID, Arcana: "minor", Path: "../secret.jpg"}, Error: "invalid image path"},
+	}, report.Missing)
+
+	rec = ta.Request(http.MethodGet, "/decks/7/images/check", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestImages_VariantsWithSizes(t *testing.T) {
+	utils.SetStaticURL("https://static.example.com")
+	utils.SetImageVariants([]config.ImageSize{
+		{Name: "thumb", Width: 240},
+		{Name: "full", Width: 1200},
+	}, []string{"png", "jpeg"})
+	t.Cleanup(func() {
+		utils.SetStaticURL("")
+		utils.SetImageVariants(nil, nil)
+	})
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{
+		Name:        "Rider-Waite",
+		BackImage:   "rws/back.png",
+		ImageWidth:  600,
+		ImageHeight: 1050,
+	})
+	foolID := createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	ta.Store.SetCardImage(foolID, "rws/major/00.png")
+
+	rec := ta.Request(http.MethodGet, "/cards/major/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []utils.ImageVariant{
+		{Size: "original", Format: "png", URL: "https://static.example.com/images/rws/major/00.png", Width: 600, Height: 1050},
+		{Size: "thumb", Format: "png", URL: "https://static.example.com/img/1/1?w=240&fmt=png", Width: 240, Height: 420},
+		{Size: "thumb", Format: "jpeg", URL: "https://static.example.com/img/1/1?w=240&fmt=jpeg", Width: 240, Height: 420},
+		{Size: "full", Format: "png", URL: "https://static.example.com/img/1/1?w=1200&fmt=png", Width: 600, Height: 1050},
+		{Size: "full", Format: "jpeg", URL: "https://static.example.com/img/1/1?w=1200&fmt=jpeg", Width: 600, Height: 1050},
+	}, decodeBody[models.CardMajor](t, rec.Body.Bytes()).Images)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, "https://static.example.com/images/rws/back.png", deck.BackImage)
+	assert.Equal(t, []utils.ImageVariant{
+		{Size: "original", Format: "png", URL: "https://static.example.com/images/rws/back.png", Width: 600, Height: 1050},
+		{Size: "thumb", Format: "png", URL: "https://static.example.com/img/1/back?w=240&fmt=png", Width: 240, Height: 420},
+		{Size: "thumb", Format: "jpeg", URL: "https://static.example.com/img/1/back?w=240&fmt=jpeg", Width: 240, Height: 420},
+		{Size: "full", Format: "png", URL: "https://static.example.com/img/1/back?w=1200&fmt=png", Width: 600, Height: 1050},
+		{Size: "full", Format: "jpeg", URL: "https://static.example.com/img/1/back?w=1200&fmt=jpeg", Width: 600, Height: 1050},
+	}, deck.BackImages)
+
+	rec = ta.RequestJSON(http.MethodPatch, "/decks/1", map[string]any{"imageWidth": -1})
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func writePNG(t *testing.T, path string, width, height int) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, width, height))))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+}
+
+func TestImages_ServeScaledCardImage(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	dir := t.TempDir()
+	ta.App.Config.Static.Dir = dir
+	images, err := imaging.NewDiskCache(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+	ta.App.Images = images
+	writePNG(t, filepath.Join(dir, "rws", "major", "00.png"), 400, 700)
+
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite", MajorImageTemplate: "rws/major/{number}.png"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 1, Name: "The Magician"})
+
+	rec := ta.Request(http.MethodGet, "/img/1/1?w=200&fmt=jpg", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "image/jpeg", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "public, max-age=86400", rec.Header().Get("Cache-Control"))
+	cfg, format, err := image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, []int{200, 350}, []int{cfg.Width, cfg.Height})
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// Served from the disk cache the second time
+	rec = ta.Request(http.MethodGet, "/img/1/1?w=200&fmt=jpeg", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, uint64(1), images.Stats().Hits)
+
+	rec = ta.Request(http.MethodGet, "/img/1/1?w=100&h=100&fit=cover", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	cfg, _, err = image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 100}, []int{cfg.Width, cfg.Height})
+
+	// Without parameters, the original
+	rec = ta.Request(http.MethodGet, "/img/1/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	cfg, _, err = image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 400, cfg.Width)
+
+	// Sizes other than the configured ones are rounded up to the size step
+	rec = ta.Request(http.MethodGet, "/img/1/1?w=201", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	cfg, _, err = image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 250, cfg.Width)
+	rec = ta.Request(http.MethodGet, "/img/1/1?w=240", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	cfg, _, err = image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 240, cfg.Width)
+
+	// The thumbnail of a static image
+	rec = ta.Request(http.MethodGet, "/thumbnails/rws/major/00.png", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	cfg, _, err = image.DecodeConfig(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, []int{240, 420}, []int{cfg.Width, cfg.Height})
+
+	for url, status := range map[string]int{
+		"/img/1/1?w=0":          http.StatusBadRequest,
+		"/img/1/1?w=100000":     http.StatusBadRequest,
+		"/img/1/1?fmt=tiff":     http.StatusBadRequest,
+		"/img/1/1?fit=stretch":  http.StatusBadRequest,
+		"/img/1/2":              http.StatusNotFound, // no file
+		"/img/1/7":              http.StatusNotFound,
+		"/img/7/1":              http.StatusNotFound,
+		"/images/../secret.png": http.StatusNotFound,
+		"/thumbnails/none.png":  http.StatusNotFound,
+	} {
+		rec = ta.Request(http.MethodGet, url, nil)
+		assert.Equal(t, status, rec.Code, url)
+	}
+}
+
+func TestImages_ServeScaledBackImage(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	dir := t.TempDir()
+	ta.App.Config.Static.Dir = dir
+	writePNG(t, filepath.Join(dir, "rws", "back.png"), 400, 700)
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Rider-Waite", BackImage: "rws/back.png"})
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	for _, format := range []string{"webp", "avif"} {
+		rec := ta.Request(http.MethodGet, "/img/1/back?w=200&fmt="+format, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "image/"+format, rec.Header().Get("Content-Type"))
+		cfg, decoded, err := image.DecodeConfig(rec.Body)
+		require.NoError(t, err)
+		assert.Equal(t, format, decoded)
+		assert.Equal(t, []int{200, 350}, []int{cfg.Width, cfg.Height})
+	}
+
+	for url, status := range map[string]int{
+		"/img/1/back?fmt=tiff": http.StatusBadRequest,
+		"/img/2/back":          http.StatusNotFound, // no card back
+		"/img/7/back":          http.StatusNotFound,
+	} {
+		rec := ta.Request(http.MethodGet, url, nil)
+		assert.Equal(t, status, rec.Code, url)
+	}
+}
+
+func TestImages_StaticOriginals(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	dir := t.TempDir()
+	ta.App.Config.Static.Dir = filepath.Join(dir, "images")
+	writePNG(t, filepath.Join(dir, "images", "rws", "back.png"), 10, 20)
+	writePNG(t, filepath.Join(dir, "secret.png"), 10, 20)
+
+	rec := ta.Request(http.MethodGet, "/images/rws/back.png", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.NotEmpty(t, rec.Header().Get("Last-Modified"))
+
+	for _, url := range []string{"/images/rws", "/images/rws/missing.png", "/images/..%2fsecret.png"} {
+		rec = ta.Request(http.MethodGet, url, nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code, url)
+	}
+}
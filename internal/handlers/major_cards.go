@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ilbagatto/tarot-api/internal/app"
@@ -27,7 +28,9 @@ func ListMajorCardsHandler(a *app.App) echo.HandlerFunc {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		cards, err := models.ListMajorCards(a.DB, deckID)
+		cards, err := useCached(c, a, fmt.Sprintf("cards:major:deck:%d", deckID), func() ([]models.CardMajor, error) {
+			return a.Repos.Cards.ListMajor(c.Request().Context(), deckID)
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -42,6 +45,7 @@ func ListMajorCardsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "CardMajor ID"
 // @Success 200 {object} models.CardMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /cards/major/{id} [get]
@@ -51,11 +55,12 @@ func GetMajorCardByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetMajorCardByID(a.DB, id)
+		src, err := a.Repos.Cards.GetMajor(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Major Card not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -67,7 +72,9 @@ func GetMajorCardByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param card body models.CardMajorInput true "CardMajor data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created card"
+// @Success 201 {object} models.CardMajor
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /cards/major [post]
@@ -77,12 +84,43 @@ func CreateMajorCardHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateMajorCard(a.DB, input)
+		id, err := a.Repos.Cards.CreateMajor(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "cards:major")
+		a.Metrics.EntityCreated("card_major")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Cards.GetMajor, "Major Card not found")
+	}
+}
+
+// BatchMajorCardsHandler creates and updates Major Arcana cards in one transaction
+// @Summary Create and update Major Arcana cards in a batch
+// @Description Each item creates a card, or updates the card with its id, conditionally if it has a version.
+// @Description In atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Param items body []models.CardMajorBatchItem true "Items to write"
+// @Param mode query string false "atomic or partial" Enums(atomic, partial)
+// @Success 200 {object} handlers.BatchResponse "All items written"
+// @Success 207 {object} handlers.BatchResponse "Some items failed in partial mode"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 422 {object} handlers.BatchResponse "Some items failed in atomic mode"
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /cards/major/batch [post]
+func BatchMajorCardsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return useBatch(c, a, batchSpec[models.CardMajorBatchItem]{
+			entity:   "cards:major",
+			metric:   "card_major",
+			notFound: "Major Card not found",
+			id:       func(item models.CardMajorBatchItem) int64 { return item.ID },
+			version:  func(item models.CardMajorBatchItem) int64 { return item.Version },
+			write:    a.Repos.Cards.BatchMajor,
+		})
 	}
 }
 
@@ -94,9 +132,13 @@ func CreateMajorCardHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "CardMajor ID"
 // @Param card body models.CardMajorInput true "Updated card"
-// @Success 204 "No Content"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.CardMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /cards/major/{id} [put]
 func UpdateMajorCardHandler(a *app.App) echo.HandlerFunc {
@@ -109,32 +151,75 @@ func UpdateMajorCardHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		if err := models.UpdateMajorCard(a.DB, id, input); err != nil {
+		version, err := useIfMatch(c, a, id, a.Repos.Cards.GetMajor)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Major Card not found")
+		}
+		if err := a.Repos.Cards.UpdateMajor(c.Request().Context(), id, input, version); err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Major Card not found")
 		}
+		useInvalidate(c, a, "cards:major")
 
-		return c.JSON(http.StatusOK, http.StatusNoContent)
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Cards.GetMajor, "Major Card not found")
+	}
+}
+
+// PatchMajorCardHandler partially updates a Major Arcana card
+// @Summary Partially update a Major Arcana card
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags cards
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "CardMajor ID"
+// @Param card body models.CardMajorInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.CardMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /cards/major/{id} [patch]
+func PatchMajorCardHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.CardMajor, models.CardMajorInput]{
+			entity:   "cards:major",
+			notFound: "Major Card not found",
+			get:      a.Repos.Cards.GetMajor,
+			input:    (*models.CardMajor).Input,
+			update:   a.Repos.Cards.UpdateMajor,
+		})
 	}
 }
 
 // DeleteMajorCardHandler deletes a card by ID
 // @Summary Delete a card
-// @Description Deletes a card by ID
+// @Description Moves a card to the trash, see /trash. A card used in combinations is only deleted with force=true
 // @Tags cards
 // @Param id path int true "CardMajor ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /cards/major/{id} [delete]
 func DeleteMajorCardHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteMajorCard(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.CardMajor]{
+			entity:     "cards:major",
+			notFound:   "Major Card not found",
+			get:        a.Repos.Cards.GetMajor,
+			dependents: a.Repos.Cards.Dependents,
+			del:        a.Repos.Cards.DeleteMajor,
+		})
 	}
 }
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -52,7 +53,9 @@ func ListMajorMeaningsHandler(a *app.App) echo.HandlerFunc {
 			}
 		}
 
-		result, err := models.ListMajorMeanings(a.DB, filters)
+		result, err := useCached(c, a, filterCacheKey("meanings:major", filters), func() ([]models.MeaningMajor, error) {
+			return a.Repos.Meanings.ListMajor(c.Request().Context(), filters)
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -68,6 +71,7 @@ func ListMajorMeaningsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "MajorMeaning ID"
 // @Success 200 {object} models.MeaningMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /meanings/major/{id} [get]
@@ -77,11 +81,12 @@ func GetMajorMeaningByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetMajorMeaningByID(a.DB, id)
+		src, err := a.Repos.Meanings.GetMajor(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "MajorMeaning not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -93,7 +98,9 @@ func GetMajorMeaningByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param MajorMeaning body models.MeaningMajorInput true "MajorMeaning data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created MajorMeaning"
+// @Success 201 {object} models.MeaningMajor
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /meanings/major [post]
@@ -103,12 +110,43 @@ func CreateMajorMeaningHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateMeaningMajor(a.DB, input)
+		id, err := a.Repos.Meanings.CreateMajor(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "meanings:major")
+		a.Metrics.EntityCreated("meaning_major")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Meanings.GetMajor, "Major Meaning not found")
+	}
+}
+
+// BatchMajorMeaningsHandler creates and updates Major Arcana meanings in one transaction
+// @Summary Create and update Major Arcana meanings in a batch
+// @Description Each item creates a meaning, or updates the meaning with its id, conditionally if it has a version.
+// @Description In atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.
+// @Tags meanings
+// @Accept json
+// @Produce json
+// @Param items body []models.MeaningMajorBatchItem true "Items to write"
+// @Param mode query string false "atomic or partial" Enums(atomic, partial)
+// @Success 200 {object} handlers.BatchResponse "All items written"
+// @Success 207 {object} handlers.BatchResponse "Some items failed in partial mode"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 422 {object} handlers.BatchResponse "Some items failed in atomic mode"
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/major/batch [post]
+func BatchMajorMeaningsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return useBatch(c, a, batchSpec[models.MeaningMajorBatchItem]{
+			entity:   "meanings:major",
+			metric:   "meaning_major",
+			notFound: "Major Meaning not found",
+			id:       func(item models.MeaningMajorBatchItem) int64 { return item.ID },
+			version:  func(item models.MeaningMajorBatchItem) int64 { return item.Version },
+			write:    a.Repos.Meanings.BatchMajor,
+		})
 	}
 }
 
@@ -120,9 +158,13 @@ func CreateMajorMeaningHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "MajorMeaning ID"
 // @Param MajorMeaning body models.MeaningMajorInput true "Updated MajorMeaning"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.MeaningMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /meanings/major/{id} [put]
 func UpdateMajorMeaningHandler(a *app.App) echo.HandlerFunc {
@@ -135,12 +177,47 @@ func UpdateMajorMeaningHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateMajorMeaning(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Meanings.GetMajor)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Major Meaning not found")
 		}
+		if err := a.Repos.Meanings.UpdateMajor(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Major Meaning not found")
+		}
+		useInvalidate(c, a, "meanings:major")
+
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Meanings.GetMajor, "Major Meaning not found")
+	}
+}
 
-		return c.JSON(http.StatusOK, updated)
+// PatchMajorMeaningHandler partially updates a Major Arcana meaning
+// @Summary Partially update a Major Arcana meaning
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags meanings
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "MeaningMajor ID"
+// @Param meaning body models.MeaningMajorInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.MeaningMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/major/{id} [patch]
+func PatchMajorMeaningHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.MeaningMajor, models.MeaningMajorInput]{
+			entity:   "meanings:major",
+			notFound: "Major Meaning not found",
+			get:      a.Repos.Meanings.GetMajor,
+			input:    (*models.MeaningMajor).Input,
+			update:   a.Repos.Meanings.UpdateMajor,
+		})
 	}
 }
 
@@ -149,19 +226,103 @@ func UpdateMajorMeaningHandler(a *app.App) echo.HandlerFunc {
 // @Description Deletes a MajorMeaning by ID
 // @Tags meanings
 // @Param id path int true "MajorMeaning ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only check that the delete would succeed"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /meanings/major/{id} [delete]
 func DeleteMajorMeaningHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
+		return useDelete(c, a, deleteSpec[models.MeaningMajor]{
+			entity:   "meanings:major",
+			notFound: "Major Meaning not found",
+			get:      a.Repos.Meanings.GetMajor,
+			del:      a.Repos.Meanings.DeleteMajor,
+		})
+	}
+}
+
+// ListMajorMeaningRevisionsHandler returns the revision history of a Major Arcana meaning
+// @Summary Get revisions of a Major Arcana meaning
+// @Description Returns every version of the meaning, newest first, with the fields changed from the version before
+// @Tags meanings
+// @Produce json
+// @Param id path int true "MeaningMajor ID"
+// @Success 200 {array} models.MeaningMajorRevision
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/major/{id}/revisions [get]
+func ListMajorMeaningRevisionsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := useIDParam(c)
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		if err := models.DeleteMajorMeaning(a.DB, id); err != nil {
+		ctx := c.Request().Context()
+		if _, err := a.Repos.Meanings.GetMajor(ctx, id); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Major Meaning not found")
+		}
+		revisions, err := a.Repos.Meanings.ListMajorRevisions(ctx, id)
+		if err != nil {
+			return useHandleDBError(c, err)
+		}
+		revisions, err = revisionChanges(revisions,
+			func(r *models.MeaningMajorRevision) any { return r.MeaningMajorInput },
+			func(r *models.MeaningMajorRevision) *json.RawMessage { return &r.Changes })
+		if err != nil {
 			return useHandleDBError(c, err)
 		}
-		return c.NoContent(http.StatusNoContent)
+		return c.JSON(http.StatusOK, revisions)
+	}
+}
+
+// RestoreMajorMeaningRevisionHandler rolls a Major Arcana meaning back to a revision
+// @Summary Restore a revision of a Major Arcana meaning
+// @Description Updates the meaning to the content of the given revision; the result is kept as a new revision
+// @Tags meanings
+// @Produce json
+// @Param id path int true "MeaningMajor ID"
+// @Param revision path int true "Revision to restore"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.MeaningMajor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/major/{id}/revisions/{revision}/restore [post]
+func RestoreMajorMeaningRevisionHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := useIDParam(c)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		revision, err := useIDParam(c, "revision")
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		ctx := c.Request().Context()
+		rev, err := a.Repos.Meanings.GetMajorRevision(ctx, id, revision)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Revision not found")
+		}
+		version, err := useIfMatch(c, a, id, a.Repos.Meanings.GetMajor)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Major Meaning not found")
+		}
+		if err := a.Repos.Meanings.UpdateMajor(ctx, id, rev.MeaningMajorInput, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Major Meaning not found")
+		}
+		useInvalidate(c, a, "meanings:major")
+
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Meanings.GetMajor, "Major Meaning not found")
 	}
 }
@@ -0,0 +1,96 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeanings_MajorFilters(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	first := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	second := createEntity(t, ta, "/sources", models.SourceInput{Name: "Waite"})
+	for _, input := range []models.MeaningMajorInput{
+		{Number: 0, Position: models.PositionReverted, Source: first, Meaning: "Безрассудство"},
+		{Number: 0, Position: models.PositionStraight, Source: first, Meaning: "Начало пути"},
+		{Number: 0, Position: models.PositionStraight, Source: second, Meaning: "Folly"},
+		{Number: 1, Position: models.PositionStraight, Source: first, Meaning: "Воля"},
+	} {
+		createEntity(t, ta, "/meanings/major", input)
+	}
+
+	rec := ta.Request(http.MethodGet, "/meanings/major?number=0&source=1", nil)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	list := decodeBody[[]models.MeaningMajor](t, rec.Body.Bytes())
+	require.Len(t, list, 2)
+	assert.Equal(t, models.PositionStraight, list[0].Position)
+	assert.Equal(t, models.PositionReverted, list[1].Position)
+}
+
+func TestMeanings_DuplicateConflicts(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	input := models.MeaningMajorInput{Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель"}
+	createEntity(t, ta, "/meanings/major", input)
+
+	rec := ta.RequestJSON(http.MethodPost, "/meanings/major", input)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestMeanings_PurgingSourceCascades(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	createEntity(t, ta, "/meanings/major", models.MeaningMajorInput{
+		Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель",
+	})
+
+	rec := ta.Request(http.MethodDelete, "/sources/1?force=true", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = ta.Request(http.MethodDelete, "/trash/source/1", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = ta.Request(http.MethodGet, "/meanings/major/1", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestMeanings_HiddenWithTrashedParents(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Cups", Genitive: "of Cups"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+	major := models.MeaningMajorInput{Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель"}
+	createEntity(t, ta, "/meanings/major", major)
+	minor := models.MeaningMinorInput{Suit: suitID, Rank: rankID, Position: models.PositionStraight, Source: sourceID, Meaning: "Love"}
+	createEntity(t, ta, "/meanings/minor", minor)
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/sources/1?force=true", nil).Code)
+
+	// Meanings of a trashed source are kept, out of sight, until it is purged
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/meanings/major/1", nil).Code)
+	assert.Empty(t, decodeBody[[]models.MeaningMajor](t, ta.Request(http.MethodGet, "/meanings/major", nil).Body.Bytes()))
+	assert.Equal(t, http.StatusNotFound, ta.RequestJSON(http.MethodPut, "/meanings/major/1", major).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodDelete, "/meanings/major/1", nil).Code)
+
+	major.Number = 6
+	assert.Equal(t, http.StatusConflict, ta.RequestJSON(http.MethodPost, "/meanings/major", major).Code)
+
+	require.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/source/1/restore", nil).Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/meanings/major/1", nil).Code)
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/ranks/1?force=true", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/meanings/minor/1", nil).Code)
+	assert.Empty(t, decodeBody[[]models.MeaningMinor](t, ta.Request(http.MethodGet, "/meanings/minor", nil).Body.Bytes()))
+
+	minor.Position = models.PositionReverted
+	assert.Equal(t, http.StatusConflict, ta.RequestJSON(http.MethodPost, "/meanings/minor", minor).Code)
+
+	require.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/rank/1/restore", nil).Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/meanings/minor/1", nil).Code)
+}
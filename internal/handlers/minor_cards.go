@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ilbagatto/tarot-api/internal/app"
@@ -27,7 +28,9 @@ func ListMinorCardsHandler(a *app.App) echo.HandlerFunc {
 			return SendError(c, http.StatusBadRequest, err)
 		}
 
-		cards, err := models.ListMinorCards(a.DB, deckID)
+		cards, err := useCached(c, a, fmt.Sprintf("cards:minor:deck:%d", deckID), func() ([]models.CardMinor, error) {
+			return a.Repos.Cards.ListMinor(c.Request().Context(), deckID)
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -42,6 +45,7 @@ func ListMinorCardsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "CardMinor ID"
 // @Success 200 {object} models.CardMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /cards/minor/{id} [get]
@@ -51,11 +55,12 @@ func GetMinorCardByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetMinorCardByID(a.DB, id)
+		src, err := a.Repos.Cards.GetMinor(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Minor Card not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -67,7 +72,9 @@ func GetMinorCardByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param card body models.CardMinorInput true "CardMinor data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created card"
+// @Success 201 {object} models.CardMinor
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /cards/minor [post]
@@ -77,12 +84,43 @@ func CreateMinorCardHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateMinorCard(a.DB, input)
+		id, err := a.Repos.Cards.CreateMinor(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "cards:minor")
+		a.Metrics.EntityCreated("card_minor")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Cards.GetMinor, "Minor Card not found")
+	}
+}
+
+// BatchMinorCardsHandler creates and updates Minor Arcana cards in one transaction
+// @Summary Create and update Minor Arcana cards in a batch
+// @Description Each item creates a card, or updates the card with its id, conditionally if it has a version.
+// @Description In atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Param items body []models.CardMinorBatchItem true "Items to write"
+// @Param mode query string false "atomic or partial" Enums(atomic, partial)
+// @Success 200 {object} handlers.BatchResponse "All items written"
+// @Success 207 {object} handlers.BatchResponse "Some items failed in partial mode"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 422 {object} handlers.BatchResponse "Some items failed in atomic mode"
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /cards/minor/batch [post]
+func BatchMinorCardsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return useBatch(c, a, batchSpec[models.CardMinorBatchItem]{
+			entity:   "cards:minor",
+			metric:   "card_minor",
+			notFound: "Minor Card not found",
+			id:       func(item models.CardMinorBatchItem) int64 { return item.ID },
+			version:  func(item models.CardMinorBatchItem) int64 { return item.Version },
+			write:    a.Repos.Cards.BatchMinor,
+		})
 	}
 }
 
@@ -94,9 +132,12 @@ func CreateMinorCardHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "CardMinor ID"
 // @Param card body models.CardMinorInput true "Updated card"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.CardMinor
-// @Success 204 "No Content"
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /cards/minor/{id} [put]
 func UpdateMinorCardHandler(a *app.App) echo.HandlerFunc {
@@ -109,32 +150,75 @@ func UpdateMinorCardHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		if err := models.UpdateMinorCard(a.DB, id, input); err != nil {
+		version, err := useIfMatch(c, a, id, a.Repos.Cards.GetMinor)
+		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Minor Card not found")
 		}
+		if err := a.Repos.Cards.UpdateMinor(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Minor Card not found")
+		}
+		useInvalidate(c, a, "cards:minor")
+
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Cards.GetMinor, "Minor Card not found")
+	}
+}
 
-		return c.NoContent(http.StatusNoContent)
+// PatchMinorCardHandler partially updates a Minor Arcana card
+// @Summary Partially update a Minor Arcana card
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags cards
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "CardMinor ID"
+// @Param card body models.CardMinorInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.CardMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /cards/minor/{id} [patch]
+func PatchMinorCardHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.CardMinor, models.CardMinorInput]{
+			entity:   "cards:minor",
+			notFound: "Minor Card not found",
+			get:      a.Repos.Cards.GetMinor,
+			input:    (*models.CardMinor).Input,
+			update:   a.Repos.Cards.UpdateMinor,
+		})
 	}
 }
 
 // DeleteMinorCardHandler deletes a card by ID
 // @Summary Delete a card
-// @Description Deletes a card by ID
+// @Description Moves a card to the trash, see /trash. A card used in combinations is only deleted with force=true
 // @Tags cards
 // @Param id path int true "CardMinor ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /cards/minor/{id} [delete]
 func DeleteMinorCardHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteMinorCard(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.CardMinor]{
+			entity:     "cards:minor",
+			notFound:   "Minor Card not found",
+			get:        a.Repos.Cards.GetMinor,
+			dependents: a.Repos.Cards.Dependents,
+			del:        a.Repos.Cards.DeleteMinor,
+		})
 	}
 }
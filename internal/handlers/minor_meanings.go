@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -57,7 +58,9 @@ func ListMinorMeaningsHandler(a *app.App) echo.HandlerFunc {
 			}
 		}
 
-		result, err := models.ListMinorMeanings(a.DB, filters)
+		result, err := useCached(c, a, filterCacheKey("meanings:minor", filters), func() ([]models.MeaningMinor, error) {
+			return a.Repos.Meanings.ListMinor(c.Request().Context(), filters)
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -73,6 +76,7 @@ func ListMinorMeaningsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "MinorMeaning ID"
 // @Success 200 {object} models.MeaningMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /meanings/minor/{id} [get]
@@ -82,11 +86,12 @@ func GetMinorMeaningByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetMinorMeaningByID(a.DB, id)
+		src, err := a.Repos.Meanings.GetMinor(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -98,7 +103,9 @@ func GetMinorMeaningByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param MinorMeaning body models.MeaningMinorInput true "MinorMeaning data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created MinorMeaning"
+// @Success 201 {object} models.MeaningMinor
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /meanings/minor [post]
@@ -108,12 +115,43 @@ func CreateMinorMeaningHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateMinorMeaning(a.DB, input)
+		id, err := a.Repos.Meanings.CreateMinor(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "meanings:minor")
+		a.Metrics.EntityCreated("meaning_minor")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Meanings.GetMinor, "Minor Meaning not found")
+	}
+}
+
+// BatchMinorMeaningsHandler creates and updates Minor Arcana meanings in one transaction
+// @Summary Create and update Minor Arcana meanings in a batch
+// @Description Each item creates a meaning, or updates the meaning with its id, conditionally if it has a version.
+// @Description In atomic mode (the default) nothing is written if an item fails; in partial mode the other items are.
+// @Tags meanings
+// @Accept json
+// @Produce json
+// @Param items body []models.MeaningMinorBatchItem true "Items to write"
+// @Param mode query string false "atomic or partial" Enums(atomic, partial)
+// @Success 200 {object} handlers.BatchResponse "All items written"
+// @Success 207 {object} handlers.BatchResponse "Some items failed in partial mode"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 422 {object} handlers.BatchResponse "Some items failed in atomic mode"
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/minor/batch [post]
+func BatchMinorMeaningsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return useBatch(c, a, batchSpec[models.MeaningMinorBatchItem]{
+			entity:   "meanings:minor",
+			metric:   "meaning_minor",
+			notFound: "Minor Meaning not found",
+			id:       func(item models.MeaningMinorBatchItem) int64 { return item.ID },
+			version:  func(item models.MeaningMinorBatchItem) int64 { return item.Version },
+			write:    a.Repos.Meanings.BatchMinor,
+		})
 	}
 }
 
@@ -125,9 +163,13 @@ func CreateMinorMeaningHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "MinorMeaning ID"
 // @Param MinorMeaning body models.MeaningMinorInput true "Updated MinorMeaning"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.MeaningMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /meanings/minor/{id} [put]
 func UpdateMinorMeaningHandler(a *app.App) echo.HandlerFunc {
@@ -140,12 +182,47 @@ func UpdateMinorMeaningHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateMinorMeaning(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Meanings.GetMinor)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
 		}
+		if err := a.Repos.Meanings.UpdateMinor(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
+		}
+		useInvalidate(c, a, "meanings:minor")
+
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Meanings.GetMinor, "Minor Meaning not found")
+	}
+}
 
-		return c.JSON(http.StatusOK, updated)
+// PatchMinorMeaningHandler partially updates a Minor Arcana meaning
+// @Summary Partially update a Minor Arcana meaning
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags meanings
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "MeaningMinor ID"
+// @Param meaning body models.MeaningMinorInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.MeaningMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/minor/{id} [patch]
+func PatchMinorMeaningHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.MeaningMinor, models.MeaningMinorInput]{
+			entity:   "meanings:minor",
+			notFound: "Minor Meaning not found",
+			get:      a.Repos.Meanings.GetMinor,
+			input:    (*models.MeaningMinor).Input,
+			update:   a.Repos.Meanings.UpdateMinor,
+		})
 	}
 }
 
@@ -154,19 +231,103 @@ func UpdateMinorMeaningHandler(a *app.App) echo.HandlerFunc {
 // @Description Deletes a MinorMeaning by ID
 // @Tags meanings
 // @Param id path int true "MinorMeaning ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only check that the delete would succeed"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /meanings/minor/{id} [delete]
 func DeleteMinorMeaningHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
+		return useDelete(c, a, deleteSpec[models.MeaningMinor]{
+			entity:   "meanings:minor",
+			notFound: "Minor Meaning not found",
+			get:      a.Repos.Meanings.GetMinor,
+			del:      a.Repos.Meanings.DeleteMinor,
+		})
+	}
+}
+
+// ListMinorMeaningRevisionsHandler returns the revision history of a Minor Arcana meaning
+// @Summary Get revisions of a Minor Arcana meaning
+// @Description Returns every version of the meaning, newest first, with the fields changed from the version before
+// @Tags meanings
+// @Produce json
+// @Param id path int true "MeaningMinor ID"
+// @Success 200 {array} models.MeaningMinorRevision
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/minor/{id}/revisions [get]
+func ListMinorMeaningRevisionsHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := useIDParam(c)
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		if err := models.DeleteMinorMeaning(a.DB, id); err != nil {
+		ctx := c.Request().Context()
+		if _, err := a.Repos.Meanings.GetMinor(ctx, id); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
+		}
+		revisions, err := a.Repos.Meanings.ListMinorRevisions(ctx, id)
+		if err != nil {
+			return useHandleDBError(c, err)
+		}
+		revisions, err = revisionChanges(revisions,
+			func(r *models.MeaningMinorRevision) any { return r.MeaningMinorInput },
+			func(r *models.MeaningMinorRevision) *json.RawMessage { return &r.Changes })
+		if err != nil {
 			return useHandleDBError(c, err)
 		}
-		return c.NoContent(http.StatusNoContent)
+		return c.JSON(http.StatusOK, revisions)
+	}
+}
+
+// RestoreMinorMeaningRevisionHandler rolls a Minor Arcana meaning back to a revision
+// @Summary Restore a revision of a Minor Arcana meaning
+// @Description Updates the meaning to the content of the given revision; the result is kept as a new revision
+// @Tags meanings
+// @Produce json
+// @Param id path int true "MeaningMinor ID"
+// @Param revision path int true "Revision to restore"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.MeaningMinor
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /meanings/minor/{id}/revisions/{revision}/restore [post]
+func RestoreMinorMeaningRevisionHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := useIDParam(c)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		revision, err := useIDParam(c, "revision")
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		ctx := c.Request().Context()
+		rev, err := a.Repos.Meanings.GetMinorRevision(ctx, id, revision)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Revision not found")
+		}
+		version, err := useIfMatch(c, a, id, a.Repos.Meanings.GetMinor)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
+		}
+		if err := a.Repos.Meanings.UpdateMinor(ctx, id, rev.MeaningMinorInput, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Minor Meaning not found")
+		}
+		useInvalidate(c, a, "meanings:minor")
+
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Meanings.GetMinor, "Minor Meaning not found")
 	}
 }
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEMergePatchJSON is the media type of JSON Merge Patch documents (RFC 7386)
+const MIMEMergePatchJSON = "application/merge-patch+json"
+
+// patchAttempts is how many times usePatch reads, merges and writes a resource
+// without If-Match before giving up on concurrent writes to it
+const patchAttempts = 3
+
+// patchSpec tells usePatch how to read and write one kind of resource
+type patchSpec[R, I any] struct {
+	entity   string // written entity, see cacheDependents
+	notFound string
+	get      func(ctx context.Context, id int64) (*R, error)
+	input    func(*R) I
+	update   func(ctx context.Context, id int64, input I, version int64) error
+}
+
+// usePatch implements PATCH: it loads the resource, applies the JSON Merge Patch
+// from the request body to its input form, stores the result and responds with
+// the updated resource. Fields absent from the patch keep their current values.
+// With If-Match, the patch only applies to the version the client has seen;
+// without it, to the version read, and is merged again if that changes.
+func usePatch[R, I any](c echo.Context, a *app.App, spec patchSpec[R, I]) error {
+	id, err := useIDParam(c)
+	if err != nil {
+		return SendError(c, http.StatusBadRequest, err)
+	}
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != MIMEMergePatchJSON && mediaType != echo.MIMEApplicationJSON {
+		return sendErrorResponse(c, http.StatusUnsupportedMediaType,
+			APIResponse{Error: "Content-Type must be " + MIMEMergePatchJSON})
+	}
+	patch, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return SendError(c, http.StatusBadRequest, errors.New("invalid request body"))
+	}
+
+	ctx := c.Request().Context()
+	ifMatch := c.Request().Header.Get(middleware.HeaderIfMatch) != ""
+	for attempt := 1; ; attempt++ {
+		current, err := spec.get(ctx, id)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, spec.notFound)
+		}
+		version, err := ifMatchVersion(c, a, current)
+		if err != nil {
+			return useHandleNotFoundOrDBError(c, err, spec.notFound)
+		}
+		if !ifMatch {
+			// The patch was merged into the version just read, so it must
+			// not overwrite a write made since
+			version = rowVersion(current).Version
+		}
+		input, err := applyMergePatch(spec.input(current), patch)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		err = spec.update(ctx, id, input, version)
+		if err == nil {
+			break
+		}
+		if !ifMatch && errors.Is(err, models.ErrVersionMismatch) {
+			if attempt < patchAttempts {
+				continue
+			}
+			return sendErrorResponse(c, http.StatusConflict,
+				APIResponse{Error: "Resource is being modified concurrently: retry later"})
+		}
+		return useHandleNotFoundOrDBError(c, err, spec.notFound)
+	}
+	useInvalidate(c, a, spec.entity)
+
+	return useRespondStored(c, http.StatusOK, id, spec.get, spec.notFound)
+}
+
+// applyMergePatch merges patch into the JSON form of input.
+// The patch must be an object; unknown fields are rejected rather than ignored,
+// so that a misspelt field does not turn into a silent no-op.
+func applyMergePatch[I any](input I, patch []byte) (I, error) {
+	var result I
+	if !bytes.HasPrefix(bytes.TrimSpace(patch), []byte("{")) {
+		return result, errors.New("invalid patch: must be a JSON object")
+	}
+	doc, err := json.Marshal(input)
+	if err != nil {
+		return result, err
+	}
+	merged, err := utils.MergePatch(doc, patch)
+	if err != nil {
+		return result, errors.New("invalid patch: malformed JSON")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(merged))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&result); err != nil {
+		return result, errors.New("invalid patch: " + strings.TrimPrefix(err.Error(), "json: "))
+	}
+	return result, nil
+}
@@ -0,0 +1,159 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func patch(ta *testutils.TestApp, path, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	ta.App.Echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPatch_DeckKeepsOmittedFields(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deck := models.DeckInput{Name: "Rider-Waite", Image: "rider.png", Sources: []models.IDOnly{{ID: sourceID}}}
+	createEntity(t, ta, "/decks", deck)
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/1", deck).Code)
+
+	rec := patch(ta, "/decks/1", handlers.MIMEMergePatchJSON, `{"name": "Rider-Waite-Smith"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	patched := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, "Rider-Waite-Smith", patched.Name)
+	assert.Equal(t, []models.Source{{ID: sourceID, Name: "Papus"}}, patched.Sources)
+
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, patched, decodeBody[models.Deck](t, rec.Body.Bytes()))
+	got, err := ta.App.Repos.Decks.Get(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "rider.png", got.ImagePath)
+}
+
+func TestPatch_NullRemovesField(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deck := models.DeckInput{Name: "Thoth", Sources: []models.IDOnly{{ID: sourceID}}}
+	createEntity(t, ta, "/decks", deck)
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/1", deck).Code)
+
+	rec := patch(ta, "/decks/1", echo.MIMEApplicationJSON, `{"sources": null}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	patched := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, "Thoth", patched.Name)
+	assert.Empty(t, patched.Sources)
+}
+
+func TestPatch_Source(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+
+	rec := patch(ta, "/sources/1", handlers.MIMEMergePatchJSON, `{"name": "Papus (1889)"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "Papus (1889)", decodeBody[models.Source](t, rec.Body.Bytes()).Name)
+}
+
+func TestPatch_Errors(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Marseille"})
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"unknown field", "/decks/1", handlers.MIMEMergePatchJSON, `{"nmae": "Rider"}`, http.StatusBadRequest},
+		{"not an object", "/decks/1", handlers.MIMEMergePatchJSON, `["name"]`, http.StatusBadRequest},
+		{"malformed", "/decks/1", handlers.MIMEMergePatchJSON, `{"name":`, http.StatusBadRequest},
+		{"wrong type", "/decks/1", handlers.MIMEMergePatchJSON, `{"name": 42}`, http.StatusBadRequest},
+		{"invalid id", "/decks/abc", handlers.MIMEMergePatchJSON, `{}`, http.StatusBadRequest},
+		{"unsupported media type", "/decks/1", echo.MIMETextPlain, `{"name": "Rider"}`, http.StatusUnsupportedMediaType},
+		{"missing", "/decks/42", handlers.MIMEMergePatchJSON, `{"name": "Rider"}`, http.StatusNotFound},
+		{"duplicate name", "/decks/1", handlers.MIMEMergePatchJSON, `{"name": "Marseille"}`, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := patch(ta, tt.path, tt.contentType, tt.body)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+
+	rec := ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Thoth", decodeBody[models.Deck](t, rec.Body.Bytes()).Name)
+}
+
+// racingMeanings changes a Major Arcana meaning before each of the first
+// races updates to it, as a concurrent request would
+type racingMeanings struct {
+	repository.MeaningRepository
+	races int
+}
+
+func (r *racingMeanings) UpdateMajor(ctx context.Context, id int64, input models.MeaningMajorInput, version int64) error {
+	if r.races > 0 {
+		r.races--
+		m, err := r.GetMajor(ctx, id)
+		if err != nil {
+			return err
+		}
+		concurrent := m.Input()
+		concurrent.Meaning += "!"
+		if err := r.MeaningRepository.UpdateMajor(ctx, id, concurrent, 0); err != nil {
+			return err
+		}
+	}
+	return r.MeaningRepository.UpdateMajor(ctx, id, input, version)
+}
+
+func TestPatch_MergesAgainAfterConcurrentWrite(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	createEntity(t, ta, "/meanings/major", models.MeaningMajorInput{
+		Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель",
+	})
+	ta.App.Repos.Meanings = &racingMeanings{MeaningRepository: ta.App.Repos.Meanings, races: 1}
+
+	rec := patch(ta, "/meanings/major/1", handlers.MIMEMergePatchJSON, `{"number": 6}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	patched := decodeBody[models.MeaningMajor](t, rec.Body.Bytes())
+	assert.Equal(t, 6, patched.Number)
+	assert.Equal(t, "Учитель!", patched.Meaning)
+}
+
+func TestPatch_ConflictsWhileWritesGoOn(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	createEntity(t, ta, "/meanings/major", models.MeaningMajorInput{
+		Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель",
+	})
+	ta.App.Repos.Meanings = &racingMeanings{MeaningRepository: ta.App.Repos.Meanings, races: 100}
+
+	rec := patch(ta, "/meanings/major/1", handlers.MIMEMergePatchJSON, `{"number": 6}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+
+	got, err := ta.App.Repos.Meanings.GetMajor(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.Number)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// errPreconditionRequired rejects an unconditional write when If-Match is required
+var errPreconditionRequired = errors.New("If-Match header is required")
+
+// versioned is implemented by resources embedding models.RowVersion
+type versioned interface {
+	Row() models.RowVersion
+}
+
+// rowVersion returns the version of resource, or the zero version when it has none
+func rowVersion(resource any) models.RowVersion {
+	if v, ok := resource.(versioned); ok {
+		return v.Row()
+	}
+	return models.RowVersion{}
+}
+
+// representationETag is the entity tag of resource: its row version, for
+// batch writes, and a hash of its JSON representation, e.g. "3-5d41402abc4b2a76".
+// The hash covers the data the representation embeds from other rows too
+// (image URLs, source names), which change without bumping the row version.
+func representationETag(resource any) string {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return ""
+	}
+	etag := middleware.ComputeETag(data)
+	if version := rowVersion(resource).Version; version != 0 {
+		etag = `"` + strconv.FormatInt(version, 10) + "-" + strings.Trim(etag, `"`) + `"`
+	}
+	return etag
+}
+
+// useETag sets the ETag of resource, so that a client can send it back in
+// If-Match when writing it
+func useETag(c echo.Context, resource any) {
+	if etag := representationETag(resource); etag != "" {
+		c.Response().Header().Set(middleware.HeaderETag, etag)
+	}
+}
+
+// useIfMatch evaluates If-Match against the current representation of resource
+// id and returns the row version a conditional write must expect, or 0 for an
+// unconditional write when the header is absent and not required.
+func useIfMatch[R any](c echo.Context, a *app.App,
+	id int64, get func(ctx context.Context, id int64) (*R, error)) (int64, error) {
+	if c.Request().Header.Get(middleware.HeaderIfMatch) == "" {
+		return 0, ifMatchMissing(a)
+	}
+	current, err := get(c.Request().Context(), id)
+	if err != nil {
+		return 0, err
+	}
+	return ifMatchVersion(c, a, current)
+}
+
+// ifMatchVersion is useIfMatch for an already loaded current resource
+func ifMatchVersion(c echo.Context, a *app.App, current any) (int64, error) {
+	ifMatch := c.Request().Header.Get(middleware.HeaderIfMatch)
+	if ifMatch == "" {
+		return 0, ifMatchMissing(a)
+	}
+	if !middleware.ETagMatchesStrong(ifMatch, representationETag(current)) {
+		return 0, models.ErrVersionMismatch
+	}
+	return rowVersion(current).Version, nil
+}
+
+func ifMatchMissing(a *app.App) error {
+	if a.Config.Concurrency.RequireIfMatch {
+		return errPreconditionRequired
+	}
+	return nil
+}
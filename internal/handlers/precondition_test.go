@@ -0,0 +1,160 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/ilbagatto/tarot-api/internal/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requestIfMatch sends body (if any) as JSON with the given If-Match header
+func requestIfMatch(t *testing.T, ta *testutils.TestApp, method, path, ifMatch string, body any) *httptest.ResponseRecorder {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if ifMatch != "" {
+		req.Header.Set(middleware.HeaderIfMatch, ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	ta.App.Echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func setupMeaning(t *testing.T) (*testutils.TestApp, models.MeaningMajorInput) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	input := models.MeaningMajorInput{Number: 5, Position: models.PositionStraight, Source: sourceID, Meaning: "Учитель"}
+	createEntity(t, ta, "/meanings/major", input)
+	return ta, input
+}
+
+func TestPrecondition_ETagFollowsRepresentation(t *testing.T) {
+	ta, input := setupMeaning(t)
+
+	rec := ta.Request(http.MethodGet, "/meanings/major/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get(middleware.HeaderETag)
+	assert.Equal(t, `"1-`+strings.Trim(middleware.ComputeETag(bytes.TrimSpace(rec.Body.Bytes())), `"`)+`"`, etag)
+
+	input.Meaning = "Иерофант"
+	rec = requestIfMatch(t, ta, http.MethodPut, "/meanings/major/1", etag, input)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated := rec.Header().Get(middleware.HeaderETag)
+	assert.True(t, strings.HasPrefix(updated, `"2-`), updated)
+	assert.Equal(t, updated, ta.Request(http.MethodGet, "/meanings/major/1", nil).Header().Get(middleware.HeaderETag))
+
+	rec = patch(ta, "/meanings/major/1", handlers.MIMEMergePatchJSON, `{"meaning": "Жрец"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.True(t, strings.HasPrefix(rec.Header().Get(middleware.HeaderETag), `"3-`))
+}
+
+func TestPrecondition_ETagCoversEmbeddedData(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	utils.SetStaticURL("https://static.example.com")
+	t.Cleanup(func() { utils.SetStaticURL("") })
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth", MajorImageTemplate: "a/{number}.jpg"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	etag := ta.Request(http.MethodGet, "/cards/major/1", nil).Header().Get(middleware.HeaderETag)
+
+	// The card row is unchanged, its image URL is not
+	rec := ta.RequestJSON(http.MethodPatch, "/decks/1", map[string]any{"majorImageTemplate": "b/{number}.png"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/cards/major/1", nil)
+	req.Header.Set(middleware.HeaderIfNoneMatch, etag)
+	rec = httptest.NewRecorder()
+	ta.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, *decodeBody[models.CardMajor](t, rec.Body.Bytes()).Image, "b/00.png")
+	assert.NotEqual(t, etag, rec.Header().Get(middleware.HeaderETag))
+	assert.Empty(t, rec.Header().Get(echo.HeaderLastModified))
+}
+
+func TestPrecondition_CachedDeckKeepsETag(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	etag := ta.Request(http.MethodGet, "/decks/1", nil).Header().Get(middleware.HeaderETag)
+	rec := requestIfMatch(t, ta, http.MethodPut, "/decks/1", etag, models.DeckInput{Name: "Thoth", Description: "Crowley"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	updated := rec.Header().Get(middleware.HeaderETag)
+
+	for range 2 {
+		rec := ta.Request(http.MethodGet, "/decks/1", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, updated, rec.Header().Get(middleware.HeaderETag))
+	}
+}
+
+func TestPrecondition_StaleIfMatchFails(t *testing.T) {
+	ta, input := setupMeaning(t)
+	etag := ta.Request(http.MethodGet, "/meanings/major/1", nil).Header().Get(middleware.HeaderETag)
+
+	first := input
+	first.Meaning = "Иерофант"
+	require.Equal(t, http.StatusOK, requestIfMatch(t, ta, http.MethodPut, "/meanings/major/1", etag, first).Code)
+
+	second := input
+	second.Meaning = "Папа"
+	rec := requestIfMatch(t, ta, http.MethodPut, "/meanings/major/1", etag, second)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	req := httptest.NewRequest(http.MethodPatch, "/meanings/major/1", bytes.NewBufferString(`{"meaning": "Папа"}`))
+	req.Header.Set(echo.HeaderContentType, handlers.MIMEMergePatchJSON)
+	req.Header.Set(middleware.HeaderIfMatch, etag)
+	rec = httptest.NewRecorder()
+	ta.App.Echo.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = requestIfMatch(t, ta, http.MethodDelete, "/meanings/major/1", etag, nil)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = ta.Request(http.MethodGet, "/meanings/major/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Иерофант", decodeBody[models.MeaningMajor](t, rec.Body.Bytes()).Meaning)
+}
+
+func TestPrecondition_RequiredIfMatch(t *testing.T) {
+	ta, input := setupMeaning(t)
+	ta.App.Config.Concurrency.RequireIfMatch = true
+
+	tests := []struct {
+		name    string
+		method  string
+		ifMatch string
+		want    int
+	}{
+		{"put without If-Match", http.MethodPut, "", http.StatusPreconditionRequired},
+		{"delete without If-Match", http.MethodDelete, "", http.StatusPreconditionRequired},
+		{"weak tag", http.MethodPut, `W/"1"`, http.StatusPreconditionFailed},
+		{"any version", http.MethodPut, "*", http.StatusOK},
+		{"current representation", http.MethodDelete, "current", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ifMatch == "current" {
+				tt.ifMatch = ta.Request(http.MethodGet, "/meanings/major/1", nil).Header().Get(middleware.HeaderETag)
+			}
+			rec := requestIfMatch(t, ta, tt.method, "/meanings/major/1", tt.ifMatch, input)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+
+	rec := patch(ta, "/sources/1", handlers.MIMEMergePatchJSON, `{"name": "Papus (1889)"}`)
+	assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+}
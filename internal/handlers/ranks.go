@@ -18,7 +18,9 @@ import (
 // @Router /ranks [get]
 func ListRanksHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		ranks, err := models.ListRanks(a.DB)
+		ranks, err := useCached(c, a, "ranks", func() ([]models.Rank, error) {
+			return a.Repos.Ranks.List(c.Request().Context())
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -33,6 +35,7 @@ func ListRanksHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Rank ID"
 // @Success 200 {object} models.Rank
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /ranks/{id} [get]
@@ -42,11 +45,12 @@ func GetRankByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetRankByID(a.DB, id)
+		src, err := a.Repos.Ranks.Get(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Rank not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -58,7 +62,9 @@ func GetRankByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param rank body models.RankInput true "Rank data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created rank"
+// @Success 201 {object} models.Rank
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /ranks [post]
@@ -68,12 +74,14 @@ func CreateRankHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateRank(a.DB, input)
+		id, err := a.Repos.Ranks.Create(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "ranks")
+		a.Metrics.EntityCreated("rank")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Ranks.Get, "Rank not found")
 	}
 }
 
@@ -85,9 +93,13 @@ func CreateRankHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Rank ID"
 // @Param rank body models.RankInput true "Updated rank"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.Rank
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /ranks/{id} [put]
 func UpdateRankHandler(a *app.App) echo.HandlerFunc {
@@ -100,33 +112,75 @@ func UpdateRankHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateRank(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Ranks.Get)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Rank not found")
 		}
+		if err := a.Repos.Ranks.Update(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Rank not found")
+		}
+		useInvalidate(c, a, "ranks")
 
-		return c.JSON(http.StatusOK, updated)
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Ranks.Get, "Rank not found")
+	}
+}
+
+// PatchRankHandler partially updates a rank
+// @Summary Partially update a rank
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags ranks
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "Rank ID"
+// @Param rank body models.RankInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.Rank
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /ranks/{id} [patch]
+func PatchRankHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.Rank, models.RankInput]{
+			entity:   "ranks",
+			notFound: "Rank not found",
+			get:      a.Repos.Ranks.Get,
+			input:    (*models.Rank).Input,
+			update:   a.Repos.Ranks.Update,
+		})
 	}
 }
 
 // DeleteRankHandler deletes a rank by ID
 // @Summary Delete a rank
-// @Description Deletes a rank by ID
+// @Description Moves a rank with its Minor Arcana cards to the trash, see /trash. A rank with cards or meanings is only deleted with force=true
 // @Tags ranks
 // @Param id path int true "Rank ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /ranks/{id} [delete]
 func DeleteRankHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteRank(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.Rank]{
+			entity:     "ranks",
+			notFound:   "Rank not found",
+			get:        a.Repos.Ranks.Get,
+			dependents: a.Repos.Ranks.Dependents,
+			del:        a.Repos.Ranks.Delete,
+		})
 	}
 }
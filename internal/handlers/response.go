@@ -1,16 +1,21 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
 	"github.com/labstack/echo/v4"
 )
 
 // APIResponse defines a standard JSON response format
 type APIResponse struct {
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Error     string `json:"error,omitempty"`
+	RequestID string `json:"requestId,omitempty" example:"3f2b8c1e9a7d4e6f8b0c2d4e6f8a0b1c"`
 }
 
 // ErrorResponse defines the standard error response structure
@@ -30,8 +35,35 @@ func NewErrorResponse(err error) APIResponse {
 
 // SendError sends a JSON error response with a status code
 func SendError(c echo.Context, statusCode int, err error) error {
-	return c.JSON(statusCode, NewErrorResponse(err))
+	return sendErrorResponse(c, statusCode, NewErrorResponse(err))
+}
 
+// sendErrorResponse tags an error response with the request ID and sends it
+func sendErrorResponse(c echo.Context, statusCode int, resp APIResponse) error {
+	resp.RequestID = middleware.GetRequestID(c)
+	return c.JSON(statusCode, resp)
+}
+
+// HTTPErrorHandler renders errors not handled by the handlers themselves
+// (unknown routes, panics recovered upstream, etc.) in the APIResponse format.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	msg := http.StatusText(status)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		status = he.Code
+		msg = fmt.Sprint(he.Message)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		_ = c.NoContent(status)
+		return
+	}
+	_ = sendErrorResponse(c, status, APIResponse{Error: msg})
 }
 
 // SendSuccess sends a JSON success response with a status code
@@ -46,13 +78,18 @@ func HTTPErrorFromDBError(err error) (int, APIResponse) {
 	}
 
 	msg := err.Error()
+	if errors.Is(err, models.ErrParentInTrash) {
+		return http.StatusConflict, APIResponse{Error: msg}
+	}
 
+	// PostgreSQL and SQLite word constraint violations differently
 	switch {
-	case strings.Contains(msg, "duplicate key"):
+	case strings.Contains(msg, "duplicate key"), strings.Contains(msg, "UNIQUE constraint failed"):
 		return http.StatusConflict, APIResponse{Error: "Duplicate entry: " + msg}
-	case strings.Contains(msg, "violates foreign key constraint"):
+	case strings.Contains(msg, "violates foreign key constraint"), strings.Contains(msg, "FOREIGN KEY constraint failed"):
 		return http.StatusConflict, APIResponse{Error: "Invalid reference: " + msg}
-	case strings.Contains(msg, "invalid input syntax"):
+	case strings.Contains(msg, "invalid input syntax"), strings.Contains(msg, "violates check constraint"),
+		strings.Contains(msg, "CHECK constraint failed"):
 		return http.StatusBadRequest, APIResponse{Error: "Invalid input: " + msg}
 	default:
 		return http.StatusInternalServerError, APIResponse{Error: msg}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/audit"
+)
+
+// revisionChanges sets the changes of every revision, oldest first, to its
+// differences from the revision before, and returns the revisions newest first.
+// content returns the revised fields of a revision.
+func revisionChanges[R any](revisions []R, content func(*R) any, changes func(*R) *json.RawMessage) ([]R, error) {
+	var previous any
+	for i := range revisions {
+		current := content(&revisions[i])
+		diff, err := audit.Diff(previous, current)
+		if err != nil {
+			return nil, err
+		}
+		*changes(&revisions[i]) = diff
+		previous = current
+	}
+	slices.Reverse(revisions)
+	return revisions, nil
+}
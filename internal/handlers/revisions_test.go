@@ -0,0 +1,84 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisions_MajorHistoryAndRestore(t *testing.T) {
+	ta, input := setupMeaning(t)
+	var etags []string
+	for _, meaning := range []string{"Иерофант", "Жрец"} {
+		input.Meaning = meaning
+		rec := ta.RequestJSON(http.MethodPut, "/meanings/major/1", input)
+		require.Equal(t, http.StatusOK, rec.Code)
+		etags = append(etags, rec.Header().Get(middleware.HeaderETag))
+	}
+
+	rec := ta.Request(http.MethodGet, "/meanings/major/1/revisions", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	revisions := decodeBody[[]models.MeaningMajorRevision](t, rec.Body.Bytes())
+	require.Len(t, revisions, 3)
+	assert.Equal(t, []int64{3, 2, 1}, []int64{revisions[0].Revision, revisions[1].Revision, revisions[2].Revision})
+	assert.Equal(t, "Жрец", revisions[0].Meaning)
+	assert.JSONEq(t, `{"meaning": {"before": "Иерофант", "after": "Жрец"}}`, string(revisions[0].Changes))
+	assert.Equal(t, "Учитель", revisions[2].Meaning)
+
+	rec = requestIfMatch(t, ta, http.MethodPost, "/meanings/major/1/revisions/1/restore", etags[0], nil)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = requestIfMatch(t, ta, http.MethodPost, "/meanings/major/1/revisions/1/restore", etags[1], nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "Учитель", decodeBody[models.MeaningMajor](t, rec.Body.Bytes()).Meaning)
+	assert.NotContains(t, etags, rec.Header().Get(middleware.HeaderETag))
+
+	rec = ta.Request(http.MethodGet, "/meanings/major/1/revisions", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	revisions = decodeBody[[]models.MeaningMajorRevision](t, rec.Body.Bytes())
+	require.Len(t, revisions, 4)
+	assert.JSONEq(t, `{"meaning": {"before": "Жрец", "after": "Учитель"}}`, string(revisions[0].Changes))
+}
+
+func TestRevisions_Minor(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Wands"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+	input := models.MeaningMinorInput{Suit: suitID, Rank: rankID, Position: models.PositionStraight, Source: sourceID, Meaning: "Начало"}
+	createEntity(t, ta, "/meanings/minor", input)
+	input.Position = models.PositionReverted
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/meanings/minor/1", input).Code)
+
+	rec := ta.Request(http.MethodPost, "/meanings/minor/1/revisions/1/restore", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, models.PositionStraight, decodeBody[models.MeaningMinor](t, rec.Body.Bytes()).Position)
+
+	rec = ta.Request(http.MethodGet, "/meanings/minor/1/revisions", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, decodeBody[[]models.MeaningMinorRevision](t, rec.Body.Bytes()), 3)
+}
+
+func TestRevisions_NotFound(t *testing.T) {
+	ta, _ := setupMeaning(t)
+
+	tests := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodGet, "/meanings/major/42/revisions", http.StatusNotFound},
+		{http.MethodPost, "/meanings/major/1/revisions/42/restore", http.StatusNotFound},
+		{http.MethodPost, "/meanings/major/1/revisions/x/restore", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ta.Request(tt.method, tt.path, nil).Code, tt.path)
+	}
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/meanings/major/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/meanings/major/1/revisions", nil).Code)
+}
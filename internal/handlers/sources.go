@@ -18,7 +18,7 @@ import (
 // @Router /sources [get]
 func ListSourcesHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		sources, err := models.ListSources(a.DB)
+		sources, err := a.Repos.Sources.List(c.Request().Context())
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -33,6 +33,7 @@ func ListSourcesHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Source ID"
 // @Success 200 {object} models.Source
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /sources/{id} [get]
@@ -42,11 +43,12 @@ func GetSourceByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetSourceByID(a.DB, id)
+		src, err := a.Repos.Sources.Get(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Source not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -58,7 +60,9 @@ func GetSourceByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param source body models.SourceInput true "Source data with list of deck IDs"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created source"
+// @Success 201 {object} models.Source
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /sources [post]
@@ -68,12 +72,14 @@ func CreateSourceHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateSource(a.DB, input)
+		id, err := a.Repos.Sources.Create(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "sources")
+		a.Metrics.EntityCreated("source")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Sources.Get, "Source not found")
 	}
 }
 
@@ -85,9 +91,13 @@ func CreateSourceHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Source ID"
 // @Param source body models.SourceInput true "Updated source with list of deck IDs"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.Source
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /sources/{id} [put]
 func UpdateSourceHandler(a *app.App) echo.HandlerFunc {
@@ -100,33 +110,75 @@ func UpdateSourceHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateSource(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Sources.Get)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Source not found")
 		}
+		if err := a.Repos.Sources.Update(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Source not found")
+		}
+		useInvalidate(c, a, "sources")
 
-		return c.JSON(http.StatusOK, updated)
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Sources.Get, "Source not found")
+	}
+}
+
+// PatchSourceHandler partially updates a source
+// @Summary Partially update a source
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags sources
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "Source ID"
+// @Param source body models.SourceInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.Source
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /sources/{id} [patch]
+func PatchSourceHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.Source, models.SourceInput]{
+			entity:   "sources",
+			notFound: "Source not found",
+			get:      a.Repos.Sources.Get,
+			input:    (*models.Source).Input,
+			update:   a.Repos.Sources.Update,
+		})
 	}
 }
 
 // DeleteSourceHandler deletes a source by ID
 // @Summary Delete a source
-// @Description Deletes a source by ID
+// @Description Moves a source to the trash, see /trash. A source with meanings or linked decks is only deleted with force=true
 // @Tags sources
 // @Param id path int true "Source ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /sources/{id} [delete]
 func DeleteSourceHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteSource(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.Source]{
+			entity:     "sources",
+			notFound:   "Source not found",
+			get:        a.Repos.Sources.Get,
+			dependents: a.Repos.Sources.Dependents,
+			del:        a.Repos.Sources.Delete,
+		})
 	}
 }
@@ -18,7 +18,7 @@ import (
 // @Router /spreads [get]
 func ListSpreadsHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		spreads, err := models.ListSpreads(a.DB)
+		spreads, err := a.Repos.Spreads.List(c.Request().Context())
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -33,6 +33,7 @@ func ListSpreadsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Spread ID"
 // @Success 200 {object} models.Spread
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /spreads/{id} [get]
@@ -42,11 +43,12 @@ func GetSpreadByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetSpreadByID(a.DB, id)
+		src, err := a.Repos.Spreads.Get(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Spread not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -58,7 +60,9 @@ func GetSpreadByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param spread body models.SpreadInput true "Spread data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created spread"
+// @Success 201 {object} models.Spread
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /spreads [post]
@@ -68,12 +72,13 @@ func CreateSpreadHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateSpread(a.DB, input)
+		id, err := a.Repos.Spreads.Create(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		a.Metrics.EntityCreated("spread")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Spreads.Get, "Spread not found")
 	}
 }
 
@@ -85,9 +90,13 @@ func CreateSpreadHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Spread ID"
 // @Param spread body models.SpreadInput true "Updated spread"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.Spread
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /spreads/{id} [put]
 func UpdateSpreadHandler(a *app.App) echo.HandlerFunc {
@@ -100,12 +109,46 @@ func UpdateSpreadHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateSpread(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Spreads.Get)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Spread not found")
 		}
+		if err := a.Repos.Spreads.Update(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Spread not found")
+		}
 
-		return c.JSON(http.StatusOK, updated)
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Spreads.Get, "Spread not found")
+	}
+}
+
+// PatchSpreadHandler partially updates a spread
+// @Summary Partially update a spread
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags spreads
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "Spread ID"
+// @Param spread body models.SpreadInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.Spread
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /spreads/{id} [patch]
+func PatchSpreadHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.Spread, models.SpreadInput]{
+			entity:   "spreads",
+			notFound: "Spread not found",
+			get:      a.Repos.Spreads.Get,
+			input:    (*models.Spread).Input,
+			update:   a.Repos.Spreads.Update,
+		})
 	}
 }
 
@@ -114,19 +157,23 @@ func UpdateSpreadHandler(a *app.App) echo.HandlerFunc {
 // @Description Deletes a spread by ID
 // @Tags spreads
 // @Param id path int true "Spread ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only check that the delete would succeed"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /spreads/{id} [delete]
 func DeleteSpreadHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteSpread(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.Spread]{
+			entity:   "spreads",
+			notFound: "Spread not found",
+			get:      a.Repos.Spreads.Get,
+			del:      a.Repos.Spreads.Delete,
+		})
 	}
 }
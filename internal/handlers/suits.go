@@ -18,7 +18,9 @@ import (
 // @Router /suits [get]
 func ListSuitsHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		suits, err := models.ListSuits(a.DB)
+		suits, err := useCached(c, a, "suits", func() ([]models.Suit, error) {
+			return a.Repos.Suits.List(c.Request().Context())
+		})
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
@@ -33,6 +35,7 @@ func ListSuitsHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Suit ID"
 // @Success 200 {object} models.Suit
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 404 {object} APIResponse
 // @Router /suits/{id} [get]
@@ -42,11 +45,12 @@ func GetSuitByIDHandler(a *app.App) echo.HandlerFunc {
 		if err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		src, err := models.GetSuitByID(a.DB, id)
+		src, err := a.Repos.Suits.Get(c.Request().Context(), id)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Suit not found")
 		}
 
+		useETag(c, src)
 		return c.JSON(http.StatusOK, src)
 	}
 }
@@ -58,7 +62,9 @@ func GetSuitByIDHandler(a *app.App) echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param suit body models.SuitInput true "Suit data"
-// @Success 201 {object} models.IDOnly "Returns the ID of the created suit"
+// @Success 201 {object} models.Suit
+// @Header 201 {string} Location "URL of the created resource"
+// @Header 201 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} APIResponse
 // @Failure 500 {object} APIResponse
 // @Router /suits [post]
@@ -68,12 +74,14 @@ func CreateSuitHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		id, err := models.CreateSuit(a.DB, input)
+		id, err := a.Repos.Suits.Create(c.Request().Context(), input)
 		if err != nil {
 			return useHandleDBError(c, err)
 		}
+		useInvalidate(c, a, "suits")
+		a.Metrics.EntityCreated("suit")
 
-		return c.JSON(http.StatusCreated, models.IDOnly{ID: *id})
+		return useRespondStored(c, http.StatusCreated, *id, a.Repos.Suits.Get, "Suit not found")
 	}
 }
 
@@ -85,9 +93,13 @@ func CreateSuitHandler(a *app.App) echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "Suit ID"
 // @Param suit body models.SuitInput true "Updated suit"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
 // @Success 200 {object} models.Suit
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
 // @Failure 400 {object} handlers.APIResponse
 // @Failure 404 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /suits/{id} [put]
 func UpdateSuitHandler(a *app.App) echo.HandlerFunc {
@@ -100,33 +112,75 @@ func UpdateSuitHandler(a *app.App) echo.HandlerFunc {
 		if err := useBind(c, &input); err != nil {
 			return SendError(c, http.StatusBadRequest, err)
 		}
-		updated, err := models.UpdateSuit(a.DB, id, input)
+		version, err := useIfMatch(c, a, id, a.Repos.Suits.Get)
 		if err != nil {
 			return useHandleNotFoundOrDBError(c, err, "Suit not found")
 		}
+		if err := a.Repos.Suits.Update(c.Request().Context(), id, input, version); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Suit not found")
+		}
+		useInvalidate(c, a, "suits")
 
-		return c.JSON(http.StatusOK, updated)
+		return useRespondStored(c, http.StatusOK, id, a.Repos.Suits.Get, "Suit not found")
+	}
+}
+
+// PatchSuitHandler partially updates a suit
+// @Summary Partially update a suit
+// @Description Applies a JSON Merge Patch (RFC 7386): only the supplied fields change, null resets a field
+// @Tags suits
+// @Accept json,application/merge-patch+json
+// @Produce json
+// @Param id path int true "Suit ID"
+// @Param suit body models.SuitInput true "Fields to change"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Success 200 {object} models.Suit
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse
+// @Failure 415 {object} handlers.APIResponse
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /suits/{id} [patch]
+func PatchSuitHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return usePatch(c, a, patchSpec[models.Suit, models.SuitInput]{
+			entity:   "suits",
+			notFound: "Suit not found",
+			get:      a.Repos.Suits.Get,
+			input:    (*models.Suit).Input,
+			update:   a.Repos.Suits.Update,
+		})
 	}
 }
 
 // DeleteSuitHandler deletes a suit by ID
 // @Summary Delete a suit
-// @Description Deletes a suit by ID
+// @Description Moves a suit with its Minor Arcana cards to the trash, see /trash. A suit with cards or meanings is only deleted with force=true
 // @Tags suits
 // @Param id path int true "Suit ID"
+// @Param If-Match header string false "ETag of the version being changed; required when REQUIRE_IF_MATCH is set"
+// @Param dry_run query bool false "Only report what the delete would remove"
+// @Param force query bool false "Also delete the dependents"
+// @Success 200 {object} handlers.DeleteReport "Dry run"
 // @Success 204 "No Content"
 // @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.DeleteReport
+// @Failure 412 {object} handlers.APIResponse
+// @Failure 428 {object} handlers.APIResponse
 // @Failure 500 {object} handlers.APIResponse
 // @Router /suits/{id} [delete]
 func DeleteSuitHandler(a *app.App) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		id, err := useIDParam((c))
-		if err != nil {
-			return SendError(c, http.StatusBadRequest, err)
-		}
-		if err := models.DeleteSuit(a.DB, id); err != nil {
-			return useHandleDBError(c, err)
-		}
-		return c.NoContent(http.StatusNoContent)
+		return useDelete(c, a, deleteSpec[models.Suit]{
+			entity:     "suits",
+			notFound:   "Suit not found",
+			get:        a.Repos.Suits.Get,
+			dependents: a.Repos.Suits.Dependents,
+			del:        a.Repos.Suits.Delete,
+		})
 	}
 }
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// trashCacheEntities maps trash entities to the cache entities they belong to
+var trashCacheEntities = map[string]string{
+	models.TrashDeck:      "decks",
+	models.TrashSource:    "sources",
+	models.TrashCardMajor: "cards:major",
+	models.TrashCardMinor: "cards:minor",
+	models.TrashSuit:      "suits",
+	models.TrashRank:      "ranks",
+}
+
+// checkTrashEntity validates the entity of a trash request
+func checkTrashEntity(entity string) error {
+	if !slices.Contains(models.TrashEntities, entity) {
+		return fmt.Errorf("invalid entity")
+	}
+	return nil
+}
+
+// ListTrashHandler returns the deleted decks, sources, cards, suits and ranks
+// @Summary Get the trash
+// @Description Returns the deleted decks, sources, cards, suits and ranks, most recently deleted first. They are purged after the configured retention.
+// @Tags trash
+// @Produce json
+// @Param entity query string false "Entity" Enums(deck, source, card_major, card_minor, suit, rank)
+// @Success 200 {array} models.TrashItem
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /trash [get]
+func ListTrashHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		entity := c.QueryParam("entity")
+		if entity != "" {
+			if err := checkTrashEntity(entity); err != nil {
+				return SendError(c, http.StatusBadRequest, err)
+			}
+		}
+		items, err := a.Repos.Trash.List(c.Request().Context(), entity)
+		if err != nil {
+			return useHandleDBError(c, err)
+		}
+		return c.JSON(http.StatusOK, items)
+	}
+}
+
+// RestoreTrashHandler handles POST /trash/:entity/:id/restore
+// @Summary Restore from the trash
+// @Description Takes a deleted deck, suit or rank (with its cards), source or card out of the trash and returns it
+// @Tags trash
+// @Produce json
+// @Param entity path string true "Entity" Enums(deck, source, card_major, card_minor, suit, rank)
+// @Param id path int true "Entity ID"
+// @Success 200 {object} object "The restored deck, source, card, suit or rank"
+// @Header 200 {string} ETag "Entity tag of the resource, for If-Match"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 409 {object} handlers.APIResponse "The name has been taken meanwhile, or the deck, suit or rank of the card is in the trash"
+// @Failure 500 {object} handlers.APIResponse
+// @Router /trash/{entity}/{id}/restore [post]
+func RestoreTrashHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		entity := c.Param("entity")
+		if err := checkTrashEntity(entity); err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		id, err := useIDParam(c)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		if err := a.Repos.Trash.Restore(c.Request().Context(), entity, id); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Not found in the trash")
+		}
+		useInvalidate(c, a, trashCacheEntities[entity])
+
+		switch entity {
+		case models.TrashDeck:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Decks.Get, "Deck not found")
+		case models.TrashSource:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Sources.Get, "Source not found")
+		case models.TrashCardMajor:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Cards.GetMajor, "Card not found")
+		case models.TrashSuit:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Suits.Get, "Suit not found")
+		case models.TrashRank:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Ranks.Get, "Rank not found")
+		default:
+			return useRespondStored(c, http.StatusOK, id, a.Repos.Cards.GetMinor, "Card not found")
+		}
+	}
+}
+
+// PurgeTrashHandler handles DELETE /trash/:entity/:id
+// @Summary Purge from the trash
+// @Description Deletes a deck (with its cards), source, suit or rank (with its cards and meanings) or card in the trash for good
+// @Tags trash
+// @Produce json
+// @Param entity path string true "Entity" Enums(deck, source, card_major, card_minor, suit, rank)
+// @Param id path int true "Entity ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.APIResponse
+// @Failure 404 {object} handlers.APIResponse
+// @Failure 500 {object} handlers.APIResponse
+// @Router /trash/{entity}/{id} [delete]
+func PurgeTrashHandler(a *app.App) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		entity := c.Param("entity")
+		if err := checkTrashEntity(entity); err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+		id, err := useIDParam(c)
+		if err != nil {
+			return SendError(c, http.StatusBadRequest, err)
+		}
+
+		if err := a.Repos.Trash.Purge(c.Request().Context(), entity, id); err != nil {
+			return useHandleNotFoundOrDBError(c, err, "Not found in the trash")
+		}
+		useInvalidate(c, a, trashCacheEntities[entity])
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,212 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash_DeckWithCardsIsRestored(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/decks/1?force=true", nil).Code)
+
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/decks/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/cards/major/1", nil).Code)
+	rec := ta.Request(http.MethodGet, "/decks", nil)
+	assert.NotContains(t, rec.Body.String(), "Thoth")
+	rec = ta.Request(http.MethodGet, "/cards/major?deck=1", nil)
+	assert.NotContains(t, rec.Body.String(), "The Fool")
+
+	rec = ta.Request(http.MethodGet, "/trash", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	items := decodeBody[[]models.TrashItem](t, rec.Body.Bytes())
+	require.Len(t, items, 1)
+	assert.Equal(t, models.TrashDeck, items[0].Entity)
+	assert.Equal(t, "Thoth", items[0].Name)
+
+	rec = ta.Request(http.MethodPost, "/trash/deck/1/restore", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "Thoth", decodeBody[models.Deck](t, rec.Body.Bytes()).Name)
+	assert.Equal(t, ta.Request(http.MethodGet, "/decks/1", nil).Header().Get(middleware.HeaderETag),
+		rec.Header().Get(middleware.HeaderETag))
+
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/cards/major/1", nil).Code)
+	rec = ta.Request(http.MethodGet, "/trash", nil)
+	assert.Empty(t, decodeBody[[]models.TrashItem](t, rec.Body.Bytes()))
+}
+
+func TestTrash_HidesTrashedEntities(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	sourceID := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name: "Thoth", Sources: []models.IDOnly{{ID: sourceID}},
+	}).Code)
+	suitID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Cups", Genitive: "of Cups"})
+	rankID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+	cardID := createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/sources/1?force=true", nil).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/cards/minor/1", nil).Code)
+
+	rec := ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Empty(t, deck.Sources)
+	assert.False(t, deck.HasMinorCards)
+	assert.NotContains(t, ta.Request(http.MethodGet, "/sources", nil).Body.String(), "Papus")
+
+	// Updates of trashed entities fail like those of missing ones
+	rec = ta.RequestJSON(http.MethodPut, "/sources/1", models.SourceInput{Name: "Papus"})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = ta.Request(http.MethodGet, "/trash?entity=card_minor", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	items := decodeBody[[]models.TrashItem](t, rec.Body.Bytes())
+	require.Len(t, items, 1)
+	assert.Equal(t, models.TrashItem{Entity: models.TrashCardMinor, ID: cardID, Name: "Ace of Cups", DeletedAt: items[0].DeletedAt}, items[0])
+}
+
+func TestTrash_NamesOfTrashedEntitiesCanBeReused(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/decks/1?force=true", nil).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/sources/1?force=true", nil).Code)
+
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+
+	// The originals cannot take their names back
+	assert.Equal(t, http.StatusConflict, ta.Request(http.MethodPost, "/trash/deck/1/restore", nil).Code)
+	assert.Equal(t, http.StatusConflict, ta.Request(http.MethodPost, "/trash/source/1/restore", nil).Code)
+
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/2", models.DeckInput{Name: "Book of Thoth"}).Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/deck/1/restore", nil).Code)
+}
+
+func TestTrash_CardsOfTrashedDeckConflict(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/cards/major/1", nil).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/decks/1?force=true", nil).Code)
+
+	rec := ta.RequestJSON(http.MethodPost, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 1, Name: "The Magician"})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "deck 1")
+
+	rec = ta.Request(http.MethodPost, "/trash/card_major/1/restore", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "deck 1")
+
+	require.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/deck/1/restore", nil).Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/card_major/1/restore", nil).Code)
+}
+
+func TestTrash_DeckKeepsLinksToTrashedSources(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	first := createEntity(t, ta, "/sources", models.SourceInput{Name: "Papus"})
+	second := createEntity(t, ta, "/sources", models.SourceInput{Name: "Waite"})
+	require.Equal(t, http.StatusOK, ta.RequestJSON(http.MethodPut, "/decks/1", models.DeckInput{
+		Name: "Thoth", Sources: []models.IDOnly{{ID: first}, {ID: second}},
+	}).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/sources/1?force=true", nil).Code)
+
+	rec := ta.RequestJSON(http.MethodPatch, "/decks/1", map[string]any{"description": "Crowley"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Len(t, decodeBody[models.Deck](t, rec.Body.Bytes()).Sources, 1)
+
+	require.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/source/1/restore", nil).Code)
+	rec = ta.Request(http.MethodGet, "/decks/1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	deck := decodeBody[models.Deck](t, rec.Body.Bytes())
+	assert.Equal(t, deckID, deck.ID)
+	require.Len(t, deck.Sources, 2)
+	assert.ElementsMatch(t, []int64{first, second}, []int64{deck.Sources[0].ID, deck.Sources[1].ID})
+}
+
+func TestTrash_Purge(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	createEntity(t, ta, "/cards/major", models.CardMajorInput{DeckID: deckID, Number: 0, Name: "The Fool"})
+
+	// Only trashed entities can be purged
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodDelete, "/trash/deck/1", nil).Code)
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/decks/1?force=true", nil).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/trash/deck/1", nil).Code)
+
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodPost, "/trash/deck/1/restore", nil).Code)
+	// The name is free again
+	createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+
+	rec := ta.Request(http.MethodGet, "/audit?entity=deck&entityId=1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	entries := decodeBody[[]models.AuditEntry](t, rec.Body.Bytes())
+	require.Len(t, entries, 3)
+	assert.Equal(t, []string{models.AuditPurge, models.AuditDelete, models.AuditCreate},
+		[]string{entries[0].Action, entries[1].Action, entries[2].Action})
+}
+
+func TestTrash_InvalidRequests(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+
+	tests := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodGet, "/trash?entity=spread", http.StatusBadRequest},
+		{http.MethodPost, "/trash/spread/1/restore", http.StatusBadRequest},
+		{http.MethodPost, "/trash/deck/x/restore", http.StatusBadRequest},
+		{http.MethodDelete, "/trash/meaning_major/1", http.StatusBadRequest},
+		{http.MethodPost, "/trash/card_major/1/restore", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ta.Request(tt.method, tt.path, nil).Code, tt.path)
+	}
+}
+
+func TestTrash_SuitAndRankTakeTheirCards(t *testing.T) {
+	ta := testutils.SetupMemoryApp()
+	deckID := createEntity(t, ta, "/decks", models.DeckInput{Name: "Thoth"})
+	cupsID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Cups", Genitive: "of Cups"})
+	wandsID := createEntity(t, ta, "/suits", models.SuitInput{Name: "Wands", Genitive: "of Wands"})
+	aceID := createEntity(t, ta, "/ranks", models.RankInput{Name: "Ace"})
+	createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: cupsID, RankID: aceID})
+	createEntity(t, ta, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: wandsID, RankID: aceID})
+
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/ranks/1?force=true", nil).Code)
+	require.Equal(t, http.StatusNoContent, ta.Request(http.MethodDelete, "/suits/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/ranks/1", nil).Code)
+	assert.Equal(t, http.StatusNotFound, ta.Request(http.MethodGet, "/cards/minor/2", nil).Code)
+	rec := ta.Request(http.MethodGet, "/trash?entity=card_minor", nil)
+	assert.Len(t, decodeBody[[]models.TrashItem](t, rec.Body.Bytes()), 2)
+
+	rec = ta.RequestJSON(http.MethodPost, "/cards/minor", models.CardMinorInput{DeckID: deckID, SuitID: wandsID, RankID: aceID})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "rank 1")
+
+	// The Ace of Cups stays in the trash along with its suit
+	rec = ta.Request(http.MethodPost, "/trash/rank/1/restore", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "Ace", decodeBody[models.Rank](t, rec.Body.Bytes()).Name)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/cards/minor/2", nil).Code)
+	rec = ta.Request(http.MethodPost, "/trash/card_minor/1/restore", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "suit 1")
+
+	require.Equal(t, http.StatusOK, ta.Request(http.MethodPost, "/trash/suit/1/restore", nil).Code)
+	assert.Equal(t, http.StatusOK, ta.Request(http.MethodGet, "/cards/minor/1", nil).Code)
+	rec = ta.Request(http.MethodGet, "/trash", nil)
+	assert.Empty(t, decodeBody[[]models.TrashItem](t, rec.Body.Bytes()))
+}
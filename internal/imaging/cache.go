@@ -0,0 +1,206 @@
+package imaging
+
+import (
+	"container/list"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// tempPrefix marks files being written, which are not cache entries yet
+const tempPrefix = ".tmp-"
+
+// Names of the cache entries, see Options.Key, and of the files being written.
+// Other files in the directory are not the cache's to keep or remove.
+var (
+	entryName = regexp.MustCompile(`^[0-9a-f]{64}(\.[a-z]+)$`)
+	tempName  = regexp.MustCompile(`^` + regexp.QuoteMeta(tempPrefix) + `[0-9]+$`)
+)
+
+type diskEntry struct {
+	key  string
+	size int64
+}
+
+// DiskCache is an LRU cache of files in a directory, bounded by their total size.
+// Entries are rendered images named by Options.Key.
+type DiskCache struct {
+	mu      sync.Mutex
+	dir     string
+	maxSize int64
+	size    int64
+	items   map[string]*list.Element
+	order   *list.List // front = most recently used
+	stats   CacheStats
+	now     func() time.Time
+}
+
+// CacheStats holds disk cache usage counters
+type CacheStats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Entries   int    `json:"entries"`
+	Size      int64  `json:"size"` // in bytes
+}
+
+// NewDiskCache opens the cache in dir, creating the directory if needed, and
+// holding at most maxSize bytes. Entries left by a previous run are kept, the
+// least recently used first evicted by their modification time, and the files
+// it was writing removed; other files are left alone.
+func NewDiskCache(dir string, maxSize int64) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type file struct {
+		entry   diskEntry
+		modTime time.Time
+	}
+	var existing []file
+	for _, f := range files {
+		if !f.Type().IsRegular() {
+			continue
+		}
+		if tempName.MatchString(f.Name()) {
+			os.Remove(filepath.Join(dir, f.Name()))
+			continue
+		}
+		if !isEntryName(f.Name()) {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		existing = append(existing, file{diskEntry{f.Name(), info.Size()}, info.ModTime()})
+	}
+	slices.SortFunc(existing, func(a, b file) int { return a.modTime.Compare(b.modTime) })
+
+	c := &DiskCache{
+		dir:     dir,
+		maxSize: maxSize,
+		items:   make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range existing {
+		c.add(f.entry)
+	}
+	c.evict()
+	return c, nil
+}
+
+// Get opens the cached file of key. The file stays readable when it is
+// evicted meanwhile; the caller closes it.
+func (c *DiskCache) Get(key string) (*os.File, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	f, err := os.Open(c.path(key))
+	if err != nil {
+		// Removed behind our back
+		c.remove(el)
+		c.stats.Misses++
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	// The modification time keeps the order of use across restarts
+	now := c.now()
+	_ = os.Chtimes(c.path(key), now, now)
+	c.stats.Hits++
+	return f, true
+}
+
+// Put stores data under key, evicting the least recently used files beyond
+// the size of the cache. Data larger than the whole cache is not stored.
+func (c *DiskCache) Put(key string, data []byte) error {
+	if int64(len(data)) > c.maxSize {
+		return nil
+	}
+	tmp, err := os.CreateTemp(c.dir, tempPrefix)
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if el, ok := c.items[key]; ok {
+		c.size -= el.Value.(*diskEntry).size
+		el.Value.(*diskEntry).size = int64(len(data))
+		c.size += int64(len(data))
+		c.order.MoveToFront(el)
+	} else {
+		c.add(diskEntry{key, int64(len(data))})
+	}
+	c.evict()
+	return nil
+}
+
+// Stats returns a snapshot of the usage counters
+func (c *DiskCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.stats
+	s.Entries = c.order.Len()
+	s.Size = c.size
+	return s
+}
+
+func (c *DiskCache) path(key string) string {
+	return filepath.Join(c.dir, key)
+}
+
+func (c *DiskCache) add(e diskEntry) {
+	c.items[e.key] = c.order.PushFront(&e)
+	c.size += e.size
+}
+
+func (c *DiskCache) evict() {
+	for c.size > c.maxSize && c.order.Len() > 0 {
+		el := c.order.Back()
+		os.Remove(c.path(el.Value.(*diskEntry).key))
+		c.remove(el)
+		c.stats.Evictions++
+	}
+}
+
+func (c *DiskCache) remove(el *list.Element) {
+	e := el.Value.(*diskEntry)
+	c.order.Remove(el)
+	delete(c.items, e.key)
+	c.size -= e.size
+}
+
+// isEntryName reports whether name is that of a cache entry
+func isEntryName(name string) bool {
+	m := entryName.FindStringSubmatch(name)
+	return m != nil && slices.ContainsFunc(Formats, func(format string) bool { return Extension(format) == m[1] })
+}
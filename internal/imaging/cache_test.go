@@ -0,0 +1,84 @@
+package imaging
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCached(t *testing.T, c *DiskCache, key string) (string, bool) {
+	t.Helper()
+	f, ok := c.Get(key)
+	if !ok {
+		return "", false
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(data), true
+}
+
+func TestDiskCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir, 10)
+	require.NoError(t, err)
+	now := time.Now()
+	c.now = func() time.Time { now = now.Add(time.Second); return now }
+
+	require.NoError(t, c.Put("a.png", []byte("aaaa")))
+	require.NoError(t, c.Put("b.png", []byte("bbbb")))
+	_, ok := readCached(t, c, "a.png") // b becomes the least recently used
+	require.True(t, ok)
+	require.NoError(t, c.Put("c.png", []byte("cccc")))
+
+	data, ok := readCached(t, c, "a.png")
+	assert.True(t, ok)
+	assert.Equal(t, "aaaa", data)
+	_, ok = readCached(t, c, "b.png")
+	assert.False(t, ok)
+	assert.NoFileExists(t, filepath.Join(dir, "b.png"))
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1, Evictions: 1, Entries: 2, Size: 8}, c.Stats())
+
+	// Larger than the whole cache: not stored
+	require.NoError(t, c.Put("d.png", []byte("ddddddddddd")))
+	_, ok = readCached(t, c, "d.png")
+	assert.False(t, ok)
+}
+
+func TestDiskCache_KeepsFilesAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := strings.Repeat("a", 64) + ".png"
+	newKey := strings.Repeat("b", 64) + ".webp"
+	old := time.Now().Add(-time.Hour)
+	for name, mod := range map[string]time.Time{
+		oldKey:                          old,
+		newKey:                          old.Add(time.Minute),
+		"notes.txt":                     old,
+		strings.Repeat("c", 64) + ".sh": old,
+		tempPrefix + "notes":            old,
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte("12345"), 0o644))
+		require.NoError(t, os.Chtimes(path, mod, mod))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, tempPrefix+"1"), []byte("partial"), 0o644))
+
+	c, err := NewDiskCache(dir, 5)
+	require.NoError(t, err)
+
+	_, ok := readCached(t, c, newKey)
+	assert.True(t, ok)
+	assert.NoFileExists(t, filepath.Join(dir, oldKey))
+	assert.NoFileExists(t, filepath.Join(dir, tempPrefix+"1"))
+	assert.Equal(t, 1, c.Stats().Entries)
+	// Files other than entries are neither counted nor removed
+	for _, name := range []string{"notes.txt", strings.Repeat("c", 64) + ".sh", tempPrefix + "notes"} {
+		assert.FileExists(t, filepath.Join(dir, name))
+	}
+}
@@ -0,0 +1,208 @@
+// Package imaging renders card images scaled, cropped and converted on the
+// fly, and keeps the renditions in a size-bounded disk cache.
+package imaging
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gen2brain/avif"
+	"github.com/gen2brain/webp"
+	"golang.org/x/image/draw"
+)
+
+// Ways of fitting an image into a box of both width and height
+const (
+	FitContain = "contain" // scale to fit within the box, keeping the aspect ratio
+	FitCover   = "cover"   // scale to cover the box, cropping the overflow around the centre
+	FitFill    = "fill"    // stretch to the box
+)
+
+// Fits are the supported ways of fitting an image into a box
+var Fits = []string{FitContain, FitCover, FitFill}
+
+// Formats are the formats images can be rendered in
+var Formats = []string{"jpeg", "png", "gif", "webp", "avif"}
+
+// MaxPixels bounds the size of the originals that are decoded
+const MaxPixels = 50_000_000
+
+// Quality of the lossy renditions, on the scales of their encoders
+const (
+	jpegQuality = 85
+	webpQuality = 80
+	avifQuality = 60
+)
+
+// ErrTooLarge is returned for originals of more than MaxPixels
+var ErrTooLarge = errors.New("image too large")
+
+// Options describe a rendition of an image
+type Options struct {
+	// Width and Height bound the rendition in pixels. With one of them 0, it
+	// follows from the other and the aspect ratio of the original; with both
+	// 0 the rendition has the size of the original. Images are never scaled up.
+	Width  int
+	Height int
+	// Format is one of Formats, "" for the format of the original
+	Format string
+	// Fit is one of Fits, "" for FitContain
+	Fit string
+}
+
+// Resolve fills the defaults of the options for the original at imgPath
+func (o Options) Resolve(imgPath string) Options {
+	if o.Format == "" {
+		o.Format = FormatOf(imgPath)
+	}
+	if o.Fit == "" {
+		o.Fit = FitContain
+	}
+	return o
+}
+
+// Original reports whether the options ask for the original at imgPath as it is
+func (o Options) Original(imgPath string) bool {
+	return o.Width == 0 && o.Height == 0 && (o.Format == "" || o.Format == FormatOf(imgPath))
+}
+
+// Key returns a name for the rendition of the original at imgPath, which has
+// size bytes and was modified at modTime. It changes with the original.
+func (o Options) Key(imgPath string, size int64, modTime time.Time) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%d\x00%d\x00%d\x00%d\x00%s\x00%s",
+		imgPath, size, modTime.UnixNano(), o.Width, o.Height, o.Format, o.Fit))
+	return hex.EncodeToString(sum[:]) + Extension(o.Format)
+}
+
+// FormatOf returns the format images are rendered in by default for the
+// original at imgPath: its own if it is one of Formats, png otherwise
+func FormatOf(imgPath string) string {
+	switch strings.ToLower(path.Ext(imgPath)) {
+	case ".jpg", ".jpeg":
+		return "jpeg"
+	case ".gif":
+		return "gif"
+	case ".webp":
+		return "webp"
+	case ".avif":
+		return "avif"
+	default:
+		return "png"
+	}
+}
+
+// Extension returns the file extension of a format
+func Extension(format string) string {
+	if format == "jpeg" {
+		return ".jpg"
+	}
+	return "." + format
+}
+
+// Render decodes the original read from r and writes its rendition to w.
+// The options must be resolved, see Options.Resolve.
+func Render(w io.Writer, r io.ReadSeeker, opts Options) error {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return err
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	crop, width, height := layout(src.Bounds(), opts)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if opts.Format == "jpeg" {
+		// JPEG has no transparency: flatten onto white rather than black
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	}
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Over, nil)
+
+	switch opts.Format {
+	case "jpeg":
+		return jpeg.Encode(w, dst, &jpeg.Options{Quality: jpegQuality})
+	case "gif":
+		return gif.Encode(w, dst, nil)
+	case "webp":
+		return webp.Encode(w, dst, webp.Options{Quality: webpQuality})
+	case "avif":
+		return avif.Encode(w, dst, avif.Options{Quality: avifQuality, QualityAlpha: avifQuality})
+	default:
+		return png.Encode(w, dst)
+	}
+}
+
+// layout returns the part of the original to render and the size of the rendition
+func layout(bounds image.Rectangle, opts Options) (image.Rectangle, int, int) {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	w, h := opts.Width, opts.Height
+	switch {
+	case w == 0 && h == 0:
+		return bounds, srcW, srcH
+	case h == 0:
+		w = min(w, srcW)
+		return bounds, w, scale(srcH, w, srcW)
+	case w == 0:
+		h = min(h, srcH)
+		return bounds, scale(srcW, h, srcH), h
+	}
+
+	switch opts.Fit {
+	case FitFill:
+		// A box larger than the original shrinks by the same factor on both
+		// sides, keeping its aspect ratio
+		if w <= srcW && h <= srcH {
+			return bounds, w, h
+		}
+		if srcW*h < srcH*w {
+			return bounds, srcW, scale(h, srcW, w)
+		}
+		return bounds, scale(w, srcH, h), srcH
+	case FitCover:
+		// The largest centred part of the original with the aspect ratio of the box
+		crop := bounds
+		if srcW*h > srcH*w {
+			cropW := scale(srcH, w, h)
+			crop.Min.X += (srcW - cropW) / 2
+			crop.Max.X = crop.Min.X + cropW
+		} else {
+			cropH := scale(srcW, h, w)
+			crop.Min.Y += (srcH - cropH) / 2
+			crop.Max.Y = crop.Min.Y + cropH
+		}
+		if w > crop.Dx() {
+			w, h = crop.Dx(), crop.Dy()
+		}
+		return crop, w, h
+	default:
+		if srcW*h > srcH*w {
+			w = min(w, srcW)
+			return bounds, w, scale(srcH, w, srcW)
+		}
+		h = min(h, srcH)
+		return bounds, scale(srcW, h, srcH), h
+	}
+}
+
+// scale returns n*num/den rounded, at least 1
+func scale(n, num, den int) int {
+	return max(1, (n*num+den/2)/den)
+}
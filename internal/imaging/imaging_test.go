@@ -0,0 +1,99 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_layout(t *testing.T) {
+	bounds := image.Rect(0, 0, 400, 700)
+	for _, tc := range []struct {
+		name string
+		opts Options
+		crop image.Rectangle
+		w, h int
+	}{
+		{"original", Options{}, bounds, 400, 700},
+		{"width", Options{Width: 200}, bounds, 200, 350},
+		{"height", Options{Height: 350}, bounds, 200, 350},
+		{"no upscale", Options{Width: 800}, bounds, 400, 700},
+		{"contain", Options{Width: 200, Height: 200, Fit: FitContain}, bounds, 114, 200},
+		{"cover", Options{Width: 200, Height: 200, Fit: FitCover}, image.Rect(0, 150, 400, 550), 200, 200},
+		{"cover beyond original", Options{Width: 1000, Height: 500, Fit: FitCover}, image.Rect(0, 250, 400, 450), 400, 200},
+		{"fill", Options{Width: 200, Height: 200, Fit: FitFill}, bounds, 200, 200},
+		{"fill beyond original", Options{Width: 800, Height: 800, Fit: FitFill}, bounds, 400, 400},
+		{"fill taller than original", Options{Width: 200, Height: 1400, Fit: FitFill}, bounds, 100, 700},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			crop, w, h := layout(bounds, tc.opts)
+			assert.Equal(t, tc.crop, crop)
+			assert.Equal(t, tc.w, w)
+			assert.Equal(t, tc.h, h)
+		})
+	}
+}
+
+func TestRender_ScalesAndConverts(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 40, 70))
+	for y := range 70 {
+		for x := range 40 {
+			src.Set(x, y, color.NRGBA{R: 200, A: 255})
+		}
+	}
+	var original bytes.Buffer
+	require.NoError(t, png.Encode(&original, src))
+
+	var out bytes.Buffer
+	opts := Options{Width: 20, Format: "jpeg"}.Resolve("rws/fool.png")
+	require.NoError(t, Render(&out, bytes.NewReader(original.Bytes()), opts))
+
+	img, err := jpeg.Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 20, 35), img.Bounds())
+
+	err = Render(&out, bytes.NewReader([]byte("not an image")), opts)
+	assert.Error(t, err)
+}
+
+func TestRender_Formats(t *testing.T) {
+	var original bytes.Buffer
+	require.NoError(t, png.Encode(&original, image.NewNRGBA(image.Rect(0, 0, 40, 70))))
+
+	for _, format := range Formats {
+		t.Run(format, func(t *testing.T) {
+			var out bytes.Buffer
+			opts := Options{Width: 20, Format: format}.Resolve("rws/fool.png")
+			require.NoError(t, Render(&out, bytes.NewReader(original.Bytes()), opts))
+
+			cfg, name, err := image.DecodeConfig(&out)
+			require.NoError(t, err)
+			assert.Equal(t, format, name)
+			assert.Equal(t, 20, cfg.Width)
+			assert.Equal(t, 35, cfg.Height)
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	assert.True(t, Options{}.Original("rws/fool.webp"))
+	assert.True(t, Options{Format: "jpeg"}.Original("rws/fool.JPG"))
+	assert.False(t, Options{Format: "png"}.Original("rws/fool.jpg"))
+	assert.False(t, Options{Width: 100}.Original("rws/fool.jpg"))
+
+	assert.Equal(t, Options{Format: "webp", Fit: FitContain}, Options{}.Resolve("rws/fool.webp"))
+	assert.Equal(t, Options{Format: "png", Fit: FitContain}, Options{}.Resolve("rws/fool.bmp"))
+
+	mod := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := Options{Width: 100, Format: "jpeg"}.Key("rws/fool.jpg", 1000, mod)
+	assert.Regexp(t, `^[0-9a-f]{64}\.jpg$`, key)
+	assert.NotEqual(t, key, Options{Width: 100, Format: "jpeg"}.Key("rws/fool.jpg", 1000, mod.Add(time.Second)))
+	assert.NotEqual(t, key, Options{Width: 101, Format: "jpeg"}.Key("rws/fool.jpg", 1000, mod))
+}
@@ -0,0 +1,50 @@
+package imaging
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Renderer runs renders, at most a bounded number at a time. A render
+// requested while the same one is running shares its result.
+type Renderer struct {
+	slots   chan struct{}
+	flight  singleflight.Group
+	timeout time.Duration
+}
+
+// NewRenderer returns a Renderer running at most maxConcurrent renders at a
+// time, each for at most timeout, waiting for a free slot included
+func NewRenderer(maxConcurrent int, timeout time.Duration) *Renderer {
+	return &Renderer{slots: make(chan struct{}, max(maxConcurrent, 1)), timeout: timeout}
+}
+
+// Do runs render for key once a slot is free, or joins the render of key that
+// is running, and waits for its result until ctx is done. The render does not
+// end with ctx, as other callers may share it: it gets a context of its own,
+// with the values of ctx and the timeout of the Renderer. The result is shared
+// by all the callers and must not be modified.
+func (r *Renderer) Do(ctx context.Context, key string, render func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	result := r.flight.DoChan(key, func() (any, error) {
+		renderCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+		defer cancel()
+		select {
+		case r.slots <- struct{}{}:
+		case <-renderCtx.Done():
+			return nil, renderCtx.Err()
+		}
+		defer func() { <-r.slots }()
+		return render(renderCtx)
+	})
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,110 @@
+package imaging
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_SharesRunningRenders(t *testing.T) {
+	r := NewRenderer(4, time.Minute)
+	var renders atomic.Int32
+	release := make(chan struct{})
+	render := func(context.Context) ([]byte, error) {
+		renders.Add(1)
+		<-release
+		return []byte("image"), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]byte, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := r.Do(context.Background(), "key", render)
+			assert.NoError(t, err)
+			results[i] = data
+		}()
+	}
+	// Let the callers join the render before it ends
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), renders.Load())
+	for _, data := range results {
+		assert.Equal(t, "image", string(data))
+	}
+}
+
+func TestRenderer_BoundsConcurrentRenders(t *testing.T) {
+	r := NewRenderer(1, time.Minute)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go r.Do(context.Background(), "first", func(context.Context) ([]byte, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	<-started
+
+	// No slot is free until the first render ends
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := r.Do(ctx, "second", func(context.Context) ([]byte, error) { return []byte("second"), nil })
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	data, err := r.Do(context.Background(), "second", func(context.Context) ([]byte, error) { return []byte("second"), nil })
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+}
+
+func TestRenderer_RenderOutlivesItsCaller(t *testing.T) {
+	r := NewRenderer(1, time.Minute)
+	started := make(chan struct{})
+	start := sync.OnceFunc(func() { close(started) })
+	release := make(chan struct{})
+	render := func(ctx context.Context) ([]byte, error) {
+		start()
+		<-release
+		return []byte("image"), ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := r.Do(ctx, "key", render)
+		first <- err
+	}()
+	<-started
+	joined := make(chan []byte)
+	go func() {
+		data, err := r.Do(context.Background(), "key", render)
+		assert.NoError(t, err)
+		joined <- data
+	}()
+
+	// The first caller stops waiting, the render goes on for the other
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	assert.Equal(t, "image", string(<-joined))
+}
+
+func TestRenderer_TimesOut(t *testing.T) {
+	r := NewRenderer(1, 50*time.Millisecond)
+
+	_, err := r.Do(context.Background(), "key", func(ctx context.Context) ([]byte, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,21 @@
+package logging
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const contextKey = "logger"
+
+// SetLogger attaches a request-scoped logger to the Echo context
+func SetLogger(c echo.Context, logger *zap.Logger) {
+	c.Set(contextKey, logger)
+}
+
+// FromContext returns the request-scoped logger, or a no-op logger if none is set
+func FromContext(c echo.Context) *zap.Logger {
+	if logger, ok := c.Get(contextKey).(*zap.Logger); ok && logger != nil {
+		return logger
+	}
+	return zap.NewNop()
+}
@@ -1,16 +1,12 @@
 package logging
 
 import (
-	"os"
-
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
-// NewLogger initializes a new zap logger based on LOG_FORMAT environment variable
-func NewLogger() *zap.Logger {
-	logFormat := os.Getenv("LOG_FORMAT") // Retrieve log format from environment variable
-
+// NewLogger initializes a new zap logger in the given format ("json", "color" or "development")
+func NewLogger(logFormat string) *zap.Logger {
 	var config zap.Config
 	if logFormat == "json" {
 		config = zap.NewProductionConfig() // JSON logging for production
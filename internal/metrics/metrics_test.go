@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_LabelsByRouteTemplate(t *testing.T) {
+	m := New(nil, nil)
+	e := echo.New()
+	e.Use(m.Middleware())
+	e.GET("/decks/:id", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	for _, path := range []string{"/decks/1", "/decks/2"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	count := testutil.ToFloat64(m.requests.WithLabelValues(http.MethodGet, "/decks/:id", "200"))
+	assert.Equal(t, float64(2), count)
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.inFlight))
+}
+
+func TestMiddleware_RecordsErrorStatus(t *testing.T) {
+	m := New(nil, nil)
+	e := echo.New()
+	e.Use(m.Middleware())
+	e.GET("/boom", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusTeapot)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues(http.MethodGet, "/boom", "418")))
+}
+
+func TestHandler_ExposesBusinessAndCacheMetrics(t *testing.T) {
+	c := cache.NewMemory(time.Minute, 10)
+	m := New(nil, c)
+	m.EntityCreated("deck")
+	c.Get(t.Context(), "missing")
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.True(t, strings.Contains(body, `tarot_entities_created_total{entity="deck"} 1`))
+	assert.True(t, strings.Contains(body, "tarot_cache_misses_total 1"))
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ilbagatto/tarot-api/internal/audit"
+	"github.com/labstack/echo/v4"
+)
+
+// Actor attributes the writes of a request to its client in the audit log:
+// "key:<fingerprint>" for one of apiKeys sent in X-API-Key, "ip:<address>"
+// otherwise. Keys themselves are never recorded, see KeyFingerprint.
+func Actor(apiKeys []string) echo.MiddlewareFunc {
+	keys := make(map[string]bool, len(apiKeys))
+	for _, key := range apiKeys {
+		keys[key] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor := "ip:" + c.RealIP()
+			if key := c.Request().Header.Get(HeaderAPIKey); keys[key] {
+				actor = "key:" + KeyFingerprint(key)
+			}
+			req := c.Request()
+			c.SetRequest(req.WithContext(audit.WithActor(req.Context(), actor)))
+			return next(c)
+		}
+	}
+}
+
+// KeyFingerprint identifies an API key without revealing it:
+// the first 12 hex digits of its SHA-256
+func KeyFingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/audit"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor(t *testing.T) {
+	e := echo.New()
+	e.Use(Actor([]string{"secret"}))
+	e.POST("/items", func(c echo.Context) error {
+		return c.String(http.StatusOK, audit.Actor(c.Request().Context()))
+	})
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   string
+	}{
+		{"known key", http.Header{HeaderAPIKey: {"secret"}}, "key:" + KeyFingerprint("secret")},
+		{"unknown key", http.Header{HeaderAPIKey: {"guess"}}, "ip:192.0.2.1"},
+		{"no key", nil, "ip:192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveFrom(e, http.MethodPost, "/items", "192.0.2.1:1234", tt.header)
+			assert.Equal(t, tt.want, rec.Body.String())
+		})
+	}
+	assert.Len(t, KeyFingerprint("secret"), 12)
+	assert.NotContains(t, KeyFingerprint("secret"), "secret")
+}
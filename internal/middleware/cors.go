@@ -1,46 +1,85 @@
 package middleware
 
 import (
-	"net/http"
 	"net/url"
-	"os"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	emw "github.com/labstack/echo/v4/middleware"
 )
 
-// CORSMiddleware sets up CORS only in development.
-// In production it becomes a no-op.
-func CORSMiddleware() echo.MiddlewareFunc {
-	if os.Getenv("APP_ENV") != "dev" {
-		// No-op outside of dev
+// CORSConfig configures cross-origin requests
+type CORSConfig struct {
+	// AllowOrigins lists origins such as "https://tarot.example.com";
+	// "https://*.example.com" matches any subdomain and "*" any origin
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight response
+	MaxAge time.Duration
+}
+
+// CORSMiddleware answers preflight requests and sets CORS headers for the
+// configured origins. In development any http(s)://localhost origin is
+// allowed as well. Without any allowed origin it is a no-op.
+func CORSMiddleware(cfg CORSConfig, devMode bool) echo.MiddlewareFunc {
+	if len(cfg.AllowOrigins) == 0 && !devMode {
 		return func(next echo.HandlerFunc) echo.HandlerFunc {
-			return func(c echo.Context) error {
-				return next(c)
-			}
+			return next
 		}
 	}
 
 	return emw.CORSWithConfig(emw.CORSConfig{
-		// Allow any origin on localhost (any port)
 		AllowOriginFunc: func(origin string) (bool, error) {
-			u, err := url.Parse(origin)
-			if err != nil {
-				return false, err
+			if devMode && isLocalhost(origin) {
+				return true, nil
 			}
-			host := u.Hostname()
-			scheme := u.Scheme
-			// accept only http or https on host "localhost"
-			ok := (scheme == "http" || scheme == "https") && host == "localhost"
-			return ok, nil
-		},
-		AllowMethods: []string{
-			http.MethodGet,
-			http.MethodOptions,
-		},
-		AllowHeaders: []string{
-			echo.HeaderOrigin,
-			echo.HeaderContentType,
+			for _, pattern := range cfg.AllowOrigins {
+				if MatchOrigin(pattern, origin) {
+					return true, nil
+				}
+			}
+			return false, nil
 		},
+		AllowMethods:     cfg.AllowMethods,
+		AllowHeaders:     cfg.AllowHeaders,
+		ExposeHeaders:    cfg.ExposeHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.MaxAge.Seconds()),
 	})
 }
+
+// MatchOrigin reports whether origin is allowed by pattern: "*", an exact
+// origin, or an origin whose host starts with "*." to match any subdomain
+// (but not the domain itself). Scheme and port must match exactly.
+func MatchOrigin(pattern, origin string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if strings.EqualFold(pattern, origin) {
+		return true
+	}
+
+	scheme, host, ok := strings.Cut(pattern, "://*.")
+	if !ok {
+		return false
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" || !strings.EqualFold(u.Scheme, scheme) {
+		return false
+	}
+	suffix := "." + strings.ToLower(host)
+	return strings.HasSuffix(strings.ToLower(u.Host), suffix) && len(u.Host) > len(suffix)
+}
+
+// isLocalhost accepts http or https on host "localhost", any port
+func isLocalhost(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Hostname() == "localhost"
+}
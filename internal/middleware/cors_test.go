@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOrigin(t *testing.T) {
+	tests := []struct {
+		pattern, origin string
+		want            bool
+	}{
+		{"*", "https://anything.test", true},
+		{"https://tarot.example.com", "https://tarot.example.com", true},
+		{"https://tarot.example.com", "http://tarot.example.com", false},
+		{"https://*.example.com", "https://admin.example.com", true},
+		{"https://*.example.com", "https://a.b.example.com", true},
+		{"https://*.example.com", "https://example.com", false},
+		{"https://*.example.com", "https://evilexample.com", false},
+		{"https://*.example.com", "https://admin.example.com:8443", false},
+		{"https://*.example.com:8443", "https://admin.example.com:8443", true},
+		{"https://*.example.com", "http://admin.example.com", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MatchOrigin(tt.pattern, tt.origin), "%s vs %s", tt.pattern, tt.origin)
+	}
+}
+
+func serveCORS(mw echo.MiddlewareFunc, method, origin string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.Use(mw)
+	e.PUT("/decks/1", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	req := httptest.NewRequest(method, "/decks/1", nil)
+	req.Header.Set(echo.HeaderOrigin, origin)
+	if method == http.MethodOptions {
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPut)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCORSMiddleware_ConfiguredOrigins(t *testing.T) {
+	mw := CORSMiddleware(CORSConfig{
+		AllowOrigins:     []string{"https://*.example.com"},
+		AllowMethods:     []string{http.MethodGet, http.MethodPut},
+		AllowHeaders:     []string{echo.HeaderContentType},
+		ExposeHeaders:    []string{"ETag"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}, false)
+
+	rec := serveCORS(mw, http.MethodOptions, "https://admin.example.com")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://admin.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Equal(t, "GET,PUT", rec.Header().Get(echo.HeaderAccessControlAllowMethods))
+	assert.Equal(t, "true", rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+	assert.Equal(t, "600", rec.Header().Get(echo.HeaderAccessControlMaxAge))
+
+	rec = serveCORS(mw, http.MethodPut, "https://admin.example.com")
+	assert.Equal(t, "ETag", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+
+	rec = serveCORS(mw, http.MethodPut, "https://evil.test")
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+
+	rec = serveCORS(mw, http.MethodPut, "http://localhost:3000")
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin), "localhost is only allowed in dev")
+}
+
+func TestCORSMiddleware_DevAllowsLocalhost(t *testing.T) {
+	rec := serveCORS(CORSMiddleware(CORSConfig{}, true), http.MethodPut, "http://localhost:3000")
+	assert.Equal(t, "http://localhost:3000", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
+
+func TestCORSMiddleware_DisabledWithoutOrigins(t *testing.T) {
+	rec := serveCORS(CORSMiddleware(CORSConfig{}, false), http.MethodPut, "http://localhost:3000")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Conditional request headers not predefined by Echo
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// bufferedWriter holds back the status code and body written by a handler,
+// so that a validator can be computed before anything reaches the client.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// Flush is a no-op: the whole body is sent at once when the handler returns.
+func (w *bufferedWriter) Flush() {}
+
+// HTTPCache adds conditional GET support to read endpoints.
+// Successful responses get a strong ETag computed from the response body
+// (unless the handler already set one) and the given Cache-Control value.
+// Requests carrying a matching If-None-Match, or an If-Modified-Since not older
+// than a Last-Modified header set by the handler, are answered with 304.
+func HTTPCache(cacheControl string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Method != http.MethodGet && req.Method != http.MethodHead {
+				return next(c)
+			}
+
+			res := c.Response()
+			orig := res.Writer
+			bw := &bufferedWriter{ResponseWriter: orig, status: http.StatusOK}
+			res.Writer = bw
+			err := next(c)
+			res.Writer = orig
+			if err != nil {
+				// Let Echo's error handler render the response from scratch
+				res.Committed = false
+				return err
+			}
+
+			header := res.Header()
+			if bw.status != http.StatusOK {
+				orig.WriteHeader(bw.status)
+				_, err := orig.Write(bw.body.Bytes())
+				return err
+			}
+
+			if header.Get(HeaderETag) == "" {
+				header.Set(HeaderETag, ComputeETag(bw.body.Bytes()))
+			}
+			if cacheControl != "" {
+				header.Set(echo.HeaderCacheControl, cacheControl)
+			}
+
+			if notModified(req, header) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				res.Status = http.StatusNotModified
+				orig.WriteHeader(http.StatusNotModified)
+				return nil
+			}
+
+			orig.WriteHeader(http.StatusOK)
+			if req.Method == http.MethodHead {
+				return nil
+			}
+			_, err = orig.Write(bw.body.Bytes())
+			return err
+		}
+	}
+}
+
+// ComputeETag returns a strong entity tag for the given representation.
+func ComputeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// notModified evaluates If-None-Match and If-Modified-Since as described in RFC 9110.
+// If-Modified-Since is only considered when If-None-Match is absent.
+func notModified(req *http.Request, header http.Header) bool {
+	if inm := req.Header.Get(HeaderIfNoneMatch); inm != "" {
+		return etagMatches(inm, header.Get(HeaderETag))
+	}
+
+	ims := req.Header.Get(echo.HeaderIfModifiedSince)
+	lm := header.Get(echo.HeaderLastModified)
+	if ims == "" || lm == "" {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(lm)
+	if err != nil {
+		return false
+	}
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// etagMatches performs the weak comparison required for If-None-Match.
+func etagMatches(list, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ETagMatchesStrong performs the strong comparison required for If-Match:
+// weak entity tags never match, "*" matches any current representation.
+func ETagMatchesStrong(list, etag string) bool {
+	if etag == "" || strings.HasPrefix(etag, "W/") {
+		return false
+	}
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
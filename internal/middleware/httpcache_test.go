@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedEcho(handler echo.HandlerFunc) *echo.Echo {
+	e := echo.New()
+	e.GET("/items", handler, HTTPCache("public, max-age=60"))
+	return e
+}
+
+func serve(e *echo.Echo, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHTTPCache_SetsETagAndCacheControl(t *testing.T) {
+	e := newCachedEcho(func(c echo.Context) error {
+		return c.JSON(http.StatusOK, []string{"a", "b"})
+	})
+
+	rec := serve(e, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ComputeETag(rec.Body.Bytes()), rec.Header().Get(HeaderETag))
+	assert.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+	assert.JSONEq(t, `["a","b"]`, rec.Body.String())
+}
+
+func TestHTTPCache_IfNoneMatchReturns304(t *testing.T) {
+	e := newCachedEcho(func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	})
+
+	first := serve(e, httptest.NewRequest(http.MethodGet, "/items", nil))
+	etag := first.Header().Get(HeaderETag)
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(HeaderIfNoneMatch, `"other", `+etag)
+	rec := serve(e, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Equal(t, etag, rec.Header().Get(HeaderETag))
+}
+
+func TestHTTPCache_StaleETagReturnsBody(t *testing.T) {
+	e := newCachedEcho(func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(HeaderIfNoneMatch, `"stale"`)
+	rec := serve(e, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Body.String())
+}
+
+func TestHTTPCache_IfModifiedSince(t *testing.T) {
+	modified := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	e := newCachedEcho(func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderLastModified, modified.Format(http.TimeFormat))
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(echo.HeaderIfModifiedSince, modified.Format(http.TimeFormat))
+	assert.Equal(t, http.StatusNotModified, serve(e, req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(echo.HeaderIfModifiedSince, modified.Add(-time.Hour).Format(http.TimeFormat))
+	assert.Equal(t, http.StatusOK, serve(e, req).Code)
+}
+
+func TestHTTPCache_ErrorsAreNotCached(t *testing.T) {
+	e := newCachedEcho(func(c echo.Context) error {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	})
+
+	rec := serve(e, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Header().Get(HeaderETag))
+	assert.Empty(t, rec.Header().Get(echo.HeaderCacheControl))
+	assert.Contains(t, rec.Body.String(), "not found")
+}
+
+func TestETagMatchesStrong(t *testing.T) {
+	tests := []struct {
+		list, etag string
+		want       bool
+	}{
+		{`"3"`, `"3"`, true},
+		{`"2", "3"`, `"3"`, true},
+		{`*`, `"3"`, true},
+		{`"2"`, `"3"`, false},
+		{`W/"3"`, `"3"`, false},
+		{`"3"`, `W/"3"`, false},
+		{`*`, ``, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ETagMatchesStrong(tt.list, tt.etag), "%s vs %s", tt.list, tt.etag)
+	}
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/logging"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RequestLogger writes one structured log line per request and makes a
+// request-scoped logger, tagged with the request ID, available to handlers
+// via logging.FromContext. It must run after RequestID.
+// Server errors are logged at error level, client errors at warn level.
+// Successful requests to skipRoutes (e.g. probes) are not logged.
+func RequestLogger(logger *zap.Logger, skipRoutes ...string) echo.MiddlewareFunc {
+	skip := make(map[string]bool, len(skipRoutes))
+	for _, route := range skipRoutes {
+		skip[route] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			reqLogger := logger.With(zap.String("request_id", GetRequestID(c)))
+			logging.SetLogger(c, reqLogger)
+
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			req := c.Request()
+			res := c.Response()
+			if skip[c.Path()] && res.Status < 400 {
+				return nil
+			}
+			fields := []zap.Field{
+				zap.String("method", req.Method),
+				zap.String("path", req.URL.Path),
+				zap.String("route", c.Path()),
+				zap.Int("status", res.Status),
+				zap.Duration("latency", time.Since(start)),
+				zap.Int64("bytes", res.Size),
+				zap.String("remote_ip", c.RealIP()),
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+			}
+
+			level := zapcore.InfoLevel
+			switch {
+			case res.Status >= 500:
+				level = zapcore.ErrorLevel
+			case res.Status >= 400:
+				level = zapcore.WarnLevel
+			}
+			reqLogger.Log(level, "request", fields...)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/logging"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRequestLogger_LogsRequestFields(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	e := echo.New()
+	e.Use(RequestID())
+	e.Use(RequestLogger(zap.New(core)))
+	e.GET("/decks/:id", func(c echo.Context) error {
+		return c.String(http.StatusOK, "deck")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/decks/7", nil))
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	fields := entry.ContextMap()
+	assert.Equal(t, zapcore.InfoLevel, entry.Level)
+	assert.Equal(t, "GET", fields["method"])
+	assert.Equal(t, "/decks/7", fields["path"])
+	assert.Equal(t, "/decks/:id", fields["route"])
+	assert.Equal(t, int64(200), fields["status"])
+	assert.Equal(t, int64(4), fields["bytes"])
+	assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), fields["request_id"])
+	assert.NotEmpty(t, fields["request_id"])
+}
+
+func TestRequestLogger_ServerErrorsAtErrorLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	e := echo.New()
+	e.Use(RequestID())
+	e.Use(RequestLogger(zap.New(core)))
+	e.GET("/fail", func(c echo.Context) error {
+		logging.FromContext(c).Info("inside handler")
+		return errors.New("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fail", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, "inside handler", logs.All()[0].Message)
+	assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), logs.All()[0].ContextMap()["request_id"])
+	entry := logs.All()[1]
+	assert.Equal(t, zapcore.ErrorLevel, entry.Level)
+	assert.Equal(t, "boom", entry.ContextMap()["error"])
+}
+
+func TestRequestLogger_SkipsProbeRoutes(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	e := echo.New()
+	e.Use(RequestLogger(zap.New(core), "/healthz"))
+	e.GET("/healthz", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, 0, logs.Len())
+}
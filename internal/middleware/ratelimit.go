@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+)
+
+// Rate limit headers, as in the IETF RateLimit header fields draft
+const (
+	HeaderAPIKey             = "X-API-Key"
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// sweepInterval is how often buckets of idle clients are dropped
+const sweepInterval = time.Minute
+
+// RateLimit is a token bucket refilled at Rate requests per second and holding
+// at most Burst requests. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitConfig configures RateLimiter
+type RateLimitConfig struct {
+	Read  RateLimit // GET, HEAD and OPTIONS requests
+	Write RateLimit // all other methods
+	// APIKeys are the X-API-Key values that get buckets of their own;
+	// other requests are limited per client IP (see IPExtractor)
+	APIKeys []string
+	// SkipRoutes are never limited (e.g. probes)
+	SkipRoutes []string
+}
+
+// RateLimiter limits every client to its own token buckets.
+// Each response carries RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset;
+// a request over the limit gets 429 with Retry-After (both in seconds).
+func RateLimiter(cfg RateLimitConfig) echo.MiddlewareFunc {
+	read := newBuckets(cfg.Read)
+	write := newBuckets(cfg.Write)
+	keys := make(map[string]bool, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		keys[key] = true
+	}
+	skip := make(map[string]bool, len(cfg.SkipRoutes))
+	for _, route := range cfg.SkipRoutes {
+		skip[route] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			b := write
+			if isReadMethod(c.Request().Method) {
+				b = read
+			}
+			if b == nil || skip[c.Path()] {
+				return next(c)
+			}
+
+			client := "ip:" + c.RealIP()
+			if key := c.Request().Header.Get(HeaderAPIKey); keys[key] {
+				client = "key:" + key
+			}
+
+			now := time.Now()
+			limiter := b.get(client, now)
+			reservation := limiter.ReserveN(now, 1)
+			delay := reservation.DelayFrom(now)
+			if delay > 0 {
+				reservation.CancelAt(now)
+			}
+
+			tokens := limiter.TokensAt(now)
+			h := c.Response().Header()
+			h.Set(HeaderRateLimitLimit, strconv.Itoa(b.limit.Burst))
+			h.Set(HeaderRateLimitRemaining, strconv.Itoa(max(0, int(tokens))))
+			h.Set(HeaderRateLimitReset, seconds(b.untilFull(tokens)))
+
+			if delay > 0 {
+				h.Set(echo.HeaderRetryAfter, seconds(delay))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+			}
+			return next(c)
+		}
+	}
+}
+
+// IPExtractor makes c.RealIP() return the client IP. X-Forwarded-For is only
+// trusted when the request comes from one of trustedProxies; without any,
+// the address of the direct peer is used.
+func IPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, network := range trustedProxies {
+		options = append(options, echo.TrustIPRange(network))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// seconds rounds d up to whole seconds
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// buckets holds the limiters of all clients for one RateLimit
+type buckets struct {
+	limit     RateLimit
+	mu        sync.Mutex
+	clients   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newBuckets returns nil when the limit is disabled
+func newBuckets(limit RateLimit) *buckets {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	return &buckets{limit: limit, clients: make(map[string]*bucket)}
+}
+
+// get returns the limiter of client, creating it on first use.
+// A bucket idle long enough to refill completely is dropped: a new one is identical.
+func (b *buckets) get(client string, now time.Time) *rate.Limiter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Sub(b.lastSweep) > sweepInterval {
+		for key, c := range b.clients {
+			if now.Sub(c.lastSeen) > b.untilFull(0) {
+				delete(b.clients, key)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	c, ok := b.clients[client]
+	if !ok {
+		c = &bucket{limiter: rate.NewLimiter(rate.Limit(b.limit.Rate), b.limit.Burst)}
+		b.clients[client] = c
+	}
+	c.lastSeen = now
+	return c.limiter
+}
+
+// untilFull returns how long a bucket holding tokens takes to refill
+func (b *buckets) untilFull(tokens float64) time.Duration {
+	missing := float64(b.limit.Burst) - tokens
+	if missing <= 0 {
+		return 0
+	}
+	return time.Duration(missing / b.limit.Rate * float64(time.Second))
+}
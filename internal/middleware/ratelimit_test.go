@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateLimitedEcho(cfg RateLimitConfig) *echo.Echo {
+	e := echo.New()
+	e.Use(RateLimiter(cfg))
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/items", ok)
+	e.POST("/items", ok)
+	e.GET("/healthz", ok)
+	return e
+}
+
+func serveFrom(e *echo.Echo, method, path, remoteAddr string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	for key, values := range header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimiter_RejectsOverBurst(t *testing.T) {
+	e := newRateLimitedEcho(RateLimitConfig{Read: RateLimit{Rate: 1, Burst: 2}})
+
+	rec := serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1234", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get(HeaderRateLimitLimit))
+	assert.Equal(t, "1", rec.Header().Get(HeaderRateLimitRemaining))
+	assert.Equal(t, "1", rec.Header().Get(HeaderRateLimitReset))
+
+	serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1234", nil)
+	rec = serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1234", nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+	assert.Equal(t, "0", rec.Header().Get(HeaderRateLimitRemaining))
+
+	// Other clients have buckets of their own
+	rec = serveFrom(e, http.MethodGet, "/items", "192.0.2.2:1234", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRateLimiter_SeparatesReadsAndWrites(t *testing.T) {
+	e := newRateLimitedEcho(RateLimitConfig{
+		Read:  RateLimit{Rate: 10, Burst: 10},
+		Write: RateLimit{Rate: 0.1, Burst: 1},
+	})
+
+	assert.Equal(t, http.StatusOK, serveFrom(e, http.MethodPost, "/items", "192.0.2.1:1", nil).Code)
+	rec := serveFrom(e, http.MethodPost, "/items", "192.0.2.1:1", nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "10", rec.Header().Get(echo.HeaderRetryAfter))
+
+	assert.Equal(t, http.StatusOK, serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1", nil).Code)
+}
+
+func TestRateLimiter_DisabledAndSkippedRoutes(t *testing.T) {
+	e := newRateLimitedEcho(RateLimitConfig{
+		Read:       RateLimit{Rate: 1, Burst: 1},
+		SkipRoutes: []string{"/healthz"},
+	})
+
+	for range 3 {
+		rec := serveFrom(e, http.MethodPost, "/items", "192.0.2.1:1", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(HeaderRateLimitLimit))
+		assert.Equal(t, http.StatusOK, serveFrom(e, http.MethodGet, "/healthz", "192.0.2.1:1", nil).Code)
+	}
+}
+
+func TestRateLimiter_KnownAPIKeysGetOwnBuckets(t *testing.T) {
+	e := newRateLimitedEcho(RateLimitConfig{
+		Read:    RateLimit{Rate: 1, Burst: 1},
+		APIKeys: []string{"partner"},
+	})
+	withKey := func(key string) http.Header { return http.Header{HeaderAPIKey: {key}} }
+
+	assert.Equal(t, http.StatusOK, serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1", nil).Code)
+	assert.Equal(t, http.StatusOK, serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1", withKey("partner")).Code)
+	// Unknown keys cannot be used to dodge the per-IP limit
+	assert.Equal(t, http.StatusTooManyRequests, serveFrom(e, http.MethodGet, "/items", "192.0.2.1:1", withKey("made-up")).Code)
+	// The key's bucket is shared across IPs
+	assert.Equal(t, http.StatusTooManyRequests, serveFrom(e, http.MethodGet, "/items", "192.0.2.9:1", withKey("partner")).Code)
+}
+
+func TestIPExtractor_TrustsOnlyConfiguredProxies(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	forwarded := http.Header{echo.HeaderXForwardedFor: {"203.0.113.7"}}
+
+	e := echo.New()
+	var seen string
+	e.GET("/", func(c echo.Context) error {
+		seen = c.RealIP()
+		return nil
+	})
+
+	e.IPExtractor = IPExtractor([]*net.IPNet{proxies})
+	serveFrom(e, http.MethodGet, "/", "10.1.2.3:1", forwarded)
+	assert.Equal(t, "203.0.113.7", seen)
+	serveFrom(e, http.MethodGet, "/", "192.168.1.1:1", forwarded)
+	assert.Equal(t, "192.168.1.1", seen, "private networks are not trusted unless listed")
+
+	e.IPExtractor = IPExtractor(nil)
+	serveFrom(e, http.MethodGet, "/", "10.1.2.3:1", forwarded)
+	assert.Equal(t, "10.1.2.3", seen)
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveWithRequestID(header string) (*httptest.ResponseRecorder, string) {
+	var seen string
+	e := echo.New()
+	e.Use(RequestID())
+	e.GET("/", func(c echo.Context) error {
+		seen = GetRequestID(c)
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		req.Header.Set(echo.HeaderXRequestID, header)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestRequestID_GeneratesID(t *testing.T) {
+	rec, seen := serveWithRequestID("")
+
+	assert.Len(t, seen, 32)
+	assert.Equal(t, seen, rec.Header().Get(echo.HeaderXRequestID))
+}
+
+func TestRequestID_AcceptsClientID(t *testing.T) {
+	rec, seen := serveWithRequestID("support-ticket-42")
+
+	assert.Equal(t, "support-ticket-42", seen)
+	assert.Equal(t, "support-ticket-42", rec.Header().Get(echo.HeaderXRequestID))
+}
+
+func TestRequestID_RejectsInvalidClientID(t *testing.T) {
+	for _, id := range []string{"has spaces", "<script>", strings.Repeat("a", 129)} {
+		_, seen := serveWithRequestID(id)
+		assert.NotEqual(t, id, seen)
+		assert.Len(t, seen, 32)
+	}
+}
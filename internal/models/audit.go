@@ -0,0 +1,113 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Audited actions
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore" // from the trash
+	AuditPurge   = "purge"   // from the trash
+)
+
+// AuditEntry records one create, update or delete of an entity, or its
+// restore or purge from the trash
+type AuditEntry struct {
+	ID       int64     `json:"id"`
+	At       time.Time `json:"at"`
+	Actor    string    `json:"actor" example:"key:3f2b8c1e9a7d"`
+	Action   string    `json:"action" example:"update"`
+	Entity   string    `json:"entity" example:"meaning_major"`
+	EntityID int64     `json:"entityId"`
+	// Changes maps every field that differs to its values before and after,
+	// e.g. {"meaning": {"before": "Teacher", "after": "Hierophant"}}
+	Changes json.RawMessage `json:"changes" swaggertype:"object"`
+}
+
+// AuditFilter selects audit entries; zero fields do not restrict the result
+type AuditFilter struct {
+	Entity   string
+	EntityID int64
+	Actor    string
+	From     time.Time // inclusive
+	To       time.Time // exclusive
+	Limit    int
+}
+
+// CreateAuditEntry appends an entry to the audit log
+func CreateAuditEntry(ctx context.Context, db *sql.DB, entry AuditEntry) error {
+	const query = `
+	INSERT INTO audit_log (at, actor, action, entity, entity_id, changes)
+	VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := conn(ctx, db).ExecContext(ctx, query,
+		entry.At.UTC(), entry.Actor, entry.Action, entry.Entity, entry.EntityID, string(entry.Changes))
+	return err
+}
+
+// ListAuditEntries returns the entries matching filter, newest first
+func ListAuditEntries(ctx context.Context, db *sql.DB, filter AuditFilter) (entries []AuditEntry, err error) {
+	ctx, span := startSpan(ctx, "ListAuditEntries")
+	defer func() { endSpan(span, len(entries), err) }()
+
+	var conditions []string
+	var args []any
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.Entity != "" {
+		where("entity = $%d", filter.Entity)
+	}
+	if filter.EntityID != 0 {
+		where("entity_id = $%d", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		where("actor = $%d", filter.Actor)
+	}
+	if !filter.From.IsZero() {
+		where("at >= $%d", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		where("at < $%d", filter.To.UTC())
+	}
+
+	query := `SELECT id, at, actor, action, entity, entity_id, changes FROM audit_log`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := conn(ctx, db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries = []AuditEntry{}
+	for rows.Next() {
+		var e AuditEntry
+		var changes []byte
+		if err := rows.Scan(&e.ID, &e.At, &e.Actor, &e.Action, &e.Entity, &e.EntityID, &changes); err != nil {
+			return nil, err
+		}
+		e.At = e.At.UTC()
+		e.Changes = changes
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+)
+
+// BatchResult is the outcome of one item of a batch write. An item with an ID
+// updates that entity, conditionally if its Version is not 0; an item without
+// one creates an entity.
+type BatchResult struct {
+	ID      int64 // the created or updated entity; 0 if nothing was written
+	Created bool
+	Err     error // why the item failed, nil if it did not
+}
+
+// runBatch writes n items in one transaction, item i by write. Each item runs
+// in a savepoint, so a failing one is rolled back alone and the others are
+// still tried, which reports the errors of all items. With atomic set, a
+// failing item rolls back the whole batch and no result has an ID.
+// An error is returned only if the transaction itself fails.
+func runBatch(ctx context.Context, db *sql.DB, n int, atomic bool,
+	write func(tx dbConn, i int) (id int64, created bool, err error)) ([]BatchResult, error) {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := make([]BatchResult, n)
+	failed := false
+	for i := range n {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_item"); err != nil {
+			return nil, err
+		}
+		id, created, err := write(tx, i)
+		if err != nil {
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_item"); err != nil {
+				return nil, err
+			}
+			results[i].Err = err
+			failed = true
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_item"); err != nil {
+			return nil, err
+		}
+		results[i] = BatchResult{ID: id, Created: created}
+	}
+
+	if atomic && failed {
+		for i := range results {
+			results[i].ID = 0
+		}
+		return results, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
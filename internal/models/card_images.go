@@ -1,6 +1,9 @@
 package models
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+)
 
 // CardImage represents an image associated with a tarot card
 type CardImage struct {
@@ -8,13 +11,80 @@ type CardImage struct {
 	Path   string `json:"path"`
 }
 
-func GetCardImageByCardID(db *sql.DB, cardID int64) (*CardImage, error) {
+func GetCardImageByCardID(ctx context.Context, db *sql.DB, cardID int64) (*CardImage, error) {
 	const query = `SELECT card, path FROM card_image WHERE card = $1`
 
 	var img CardImage
-	err := db.QueryRow(query, cardID).Scan(&img.CardID, &img.Path)
+	err := conn(ctx, db).QueryRowContext(ctx, query, cardID).Scan(&img.CardID, &img.Path)
 	if err != nil {
 		return nil, err
 	}
 	return &img, nil
 }
+
+// insertCardImage sets the image path of a new card; an empty path sets none
+func insertCardImage(ctx context.Context, tx dbConn, cardID int64, path string) error {
+	if path == "" {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, "INSERT INTO card_image (card, path) VALUES ($1, $2)", cardID, path)
+	return err
+}
+
+// CardImagePath is the image path of a card: its own or, without one, the
+// path resolved from the image template of its deck
+type CardImagePath struct {
+	CardID    int64  `json:"card" example:"12"`
+	Arcana    string `json:"arcana" example:"minor"`
+	Path      string `json:"path,omitempty" example:"rws/cups/01.jpg"` // empty if the card has no image
+	Templated bool   `json:"templated,omitempty"`                      // resolved from an image template
+}
+
+// ListCardImages returns the image paths of all cards of a deck,
+// the Major Arcana by number, then the Minor Arcana by suit and rank
+func ListCardImages(ctx context.Context, db *sql.DB, deckID int64) ([]CardImagePath, error) {
+	var exists bool
+	if err := conn(ctx, db).QueryRowContext(ctx, "SELECT true FROM deck WHERE id = $1 AND deleted_at IS NULL", deckID).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	const query = `
+		SELECT c.id, c.arcana, i.path, d.major_image_template, d.minor_image_template,
+			COALESCE(m.number, 0), COALESCE(n.suit, 0), COALESCE(s.slug, ''), COALESCE(n.rank, 0), COALESCE(r.slug, '')
+		FROM card c
+		JOIN deck d ON d.id = c.deck
+		LEFT JOIN card_major m ON m.card = c.id
+		LEFT JOIN card_minor n ON n.card = c.id
+		LEFT JOIN suit s ON s.id = n.suit
+		LEFT JOIN rank r ON r.id = n.rank
+		LEFT JOIN card_image i ON i.card = c.id
+		WHERE c.deck = $1 AND ` + cardVisible + `
+		ORDER BY c.arcana, m.number, n.suit, n.rank`
+
+	rows, err := conn(ctx, db).QueryContext(ctx, query, deckID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := []CardImagePath{}
+	for rows.Next() {
+		var img CardImagePath
+		var path sql.NullString
+		var majorTemplate, minorTemplate string
+		var card ImageCard
+		if err := rows.Scan(&img.CardID, &img.Arcana, &path, &majorTemplate, &minorTemplate,
+			&card.Number, &card.SuitID, &card.SuitSlug, &card.RankID, &card.RankSlug); err != nil {
+			return nil, err
+		}
+		card.Arcana = img.Arcana
+		template := majorTemplate
+		if img.Arcana == "minor" {
+			template = minorTemplate
+		}
+		img.Path = imagePath(path, template, card)
+		img.Templated = !path.Valid && img.Path != ""
+		images = append(images, img)
+	}
+	return images, rows.Err()
+}
@@ -1,38 +1,60 @@
 package models
 
-import "database/sql"
+import (
+	"context"
+
+	"github.com/ilbagatto/tarot-api/internal/utils"
+)
 
 type Card struct {
-	ID        int64        `json:"id"`
-	Name      string       `json:"name" example:"The Fool"`
-	DeckID    int64        `json:"deck" example:"1"`
-	Image     *string      `json:"image,omitempty"`     // Full URL
-	Thumbnail *string      `json:"thumbnail,omitempty"` // Full URL
-	Meanings  []MeaningRef `json:"meanings,omitempty"`
+	ID        int64   `json:"id"`
+	Name      string  `json:"name" example:"The Fool"`
+	DeckID    int64   `json:"deck" example:"1"`
+	Image     *string `json:"image,omitempty"`     // Full URL
+	Thumbnail *string `json:"thumbnail,omitempty"` // Full URL
+	// Images lists all variants of the image, for srcset
+	Images   []utils.ImageVariant `json:"images,omitempty"`
+	Meanings []MeaningRef         `json:"meanings,omitempty"`
+	RowVersion
 }
 
-func updateCard(tx *sql.Tx, deckID int64, id int64) error {
-	res, err := tx.Exec("UPDATE card SET deck = $1 WHERE id = $2", deckID, id)
-	if err != nil {
+// SetImage fills the image URLs from an image path, see imagePath, whose
+// original is width x height pixels (0 if unknown); an empty path leaves them unset
+func (c *Card) SetImage(path string, width, height int) {
+	if path != "" {
+		c.Image = utils.GetImageURL(path, false)
+		c.Thumbnail = utils.GetImageURL(path, true)
+		c.Images = utils.GetImageVariants(path, utils.CardImageRenderPath(c.DeckID, c.ID), width, height)
+	}
+}
+
+// cardVisible selects the cards c that are not in the trash, neither
+// by themselves nor along with their deck
+const cardVisible = "c.deleted_at IS NULL AND c.deck IN (SELECT id FROM deck WHERE deleted_at IS NULL)"
+
+// updateCard updates the card row, which holds the version of the card
+func updateCard(ctx context.Context, tx dbConn, deckID int64, id int64, version int64) error {
+	if err := checkNotTrashed(ctx, tx, "deck", deckID); err != nil {
 		return err
 	}
-	rowsAffected, err := res.RowsAffected()
+	query, args := ifVersion("UPDATE card SET deck = $1, "+bumpVersion+" WHERE id = $2 AND deleted_at IS NULL", []any{deckID, id}, version)
+	res, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
-	return nil
+	return checkWritten(res, version)
 }
 
-func insertCard(tx *sql.Tx, deckID int64, arcana string) (*int64, error) {
+func insertCard(ctx context.Context, tx dbConn, deckID int64, arcana string) (*int64, error) {
 	const insertCard = `
-		INSERT INTO card (deck, arcana)
-		VALUES ($1, $2)
+		INSERT INTO card (deck, arcana, updated_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)
 		RETURNING id`
+	if err := checkNotTrashed(ctx, tx, "deck", deckID); err != nil {
+		return nil, err
+	}
 	var cardID int64
-	if err := tx.QueryRow(insertCard, deckID, arcana).Scan(&cardID); err != nil {
+	if err := tx.QueryRowContext(ctx, insertCard, deckID, arcana).Scan(&cardID); err != nil {
 		return nil, err
 	}
 	return &cardID, nil
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/ilbagatto/tarot-api/internal/utils"
@@ -14,6 +15,13 @@ type DeckInput struct {
 	Image       string   `json:"image"` // Relative URL
 	Description string   `json:"description"`
 	Sources     []IDOnly `json:"sources"`
+	// Image paths of the cards that have none of their own, see ImageTemplate
+	MajorImageTemplate string `json:"majorImageTemplate,omitempty" example:"rws/major/{number}.jpg"`
+	MinorImageTemplate string `json:"minorImageTemplate,omitempty" example:"rws/{suit}/{number}.jpg"`
+	BackImage          string `json:"backImage,omitempty" example:"rws/back.jpg"` // Relative URL
+	// Size of the original card images in pixels, 0 if unknown
+	ImageWidth  int `json:"imageWidth,omitempty" example:"1100"`
+	ImageHeight int `json:"imageHeight,omitempty" example:"1925"`
 }
 
 // Deck represents a Tarot deck
@@ -25,6 +33,40 @@ type Deck struct {
 	Description   string   `json:"description"`
 	Sources       []Source `json:"sources,omitempty"`
 	HasMinorCards bool     `json:"hasMinorCards"`
+	ImagePath     string   `json:"-"` // Relative path, as stored
+	// Image paths of the cards that have none of their own, see ImageTemplate
+	MajorImageTemplate string `json:"majorImageTemplate,omitempty" example:"rws/major/{number}.jpg"`
+	MinorImageTemplate string `json:"minorImageTemplate,omitempty" example:"rws/{suit}/{number}.jpg"`
+	// Image of the card back: full URL and variants
+	BackImage     string               `json:"backImage,omitempty"`
+	BackImages    []utils.ImageVariant `json:"backImages,omitempty"`
+	BackImagePath string               `json:"-"` // Relative path, as stored
+	// Size of the original card images in pixels, 0 if unknown
+	ImageWidth  int `json:"imageWidth,omitempty" example:"1100"`
+	ImageHeight int `json:"imageHeight,omitempty" example:"1925"`
+	RowVersion
+}
+
+// Input returns the deck in input form, e.g. to apply a partial update to it
+func (d *Deck) Input() DeckInput {
+	input := DeckInput{Name: d.Name, Image: d.ImagePath, Description: d.Description,
+		MajorImageTemplate: d.MajorImageTemplate, MinorImageTemplate: d.MinorImageTemplate,
+		BackImage: d.BackImagePath, ImageWidth: d.ImageWidth, ImageHeight: d.ImageHeight}
+	for _, src := range d.Sources {
+		input.Sources = append(input.Sources, IDOnly{ID: src.ID})
+	}
+	return input
+}
+
+// SetBackImage fills the URLs of the card back from BackImagePath
+func (d *Deck) SetBackImage() {
+	if d.BackImagePath == "" {
+		return
+	}
+	if url := utils.GetImageURL(d.BackImagePath, false); url != nil {
+		d.BackImage = *url
+	}
+	d.BackImages = utils.GetImageVariants(d.BackImagePath, utils.DeckBackImageRenderPath(d.ID), d.ImageWidth, d.ImageHeight)
 }
 
 // DeckListItem represents a Tarot deck as list item, without related sources
@@ -68,8 +110,11 @@ func fetchDecksFromRows(rows *sql.Rows) ([]DeckListItem, error) {
 }
 
 // ListDecks retrieves all decks
-func ListDecks(db *sql.DB) ([]DeckListItem, error) {
-	rows, err := db.Query("SELECT id, name, image, has_minor_cards, description FROM deck_with_stats")
+func ListDecks(ctx context.Context, db *sql.DB) (decks []DeckListItem, err error) {
+	ctx, span := startSpan(ctx, "ListDecks")
+	defer func() { endSpan(span, len(decks), err) }()
+
+	rows, err := conn(ctx, db).QueryContext(ctx, "SELECT id, name, image, has_minor_cards, description FROM deck_with_stats")
 	if err != nil {
 		return nil, err
 	}
@@ -77,27 +122,37 @@ func ListDecks(db *sql.DB) ([]DeckListItem, error) {
 }
 
 // GetDeckByID retrieves a single deck and its sources
-func GetDeckByID(db *sql.DB, deckId int64) (*Deck, error) {
+func GetDeckByID(ctx context.Context, db *sql.DB, deckId int64) (*Deck, error) {
 	var deck Deck
 
 	// Main deck query
 	var img string
-	row := db.QueryRow("SELECT id, name, image, has_minor_cards, description FROM deck_with_stats WHERE id = $1", deckId)
-	if err := row.Scan(&deck.ID, &deck.Name, &img, &deck.HasMinorCards, &deck.Description); err != nil {
+	row := conn(ctx, db).QueryRowContext(ctx, `
+		SELECT s.id, s.name, s.image, s.has_minor_cards, s.description,
+			d.major_image_template, d.minor_image_template, d.back_image, d.image_width, d.image_height,
+			d.version, d.updated_at
+		FROM deck_with_stats s
+		JOIN deck d ON d.id = s.id
+		WHERE s.id = $1`, deckId)
+	if err := row.Scan(&deck.ID, &deck.Name, &img, &deck.HasMinorCards, &deck.Description,
+		&deck.MajorImageTemplate, &deck.MinorImageTemplate, &deck.BackImagePath, &deck.ImageWidth, &deck.ImageHeight,
+		&deck.Version, &deck.UpdatedAt); err != nil {
 		return nil, err
 	}
 
 	deck.Image = *utils.GetImageURL(img, false)
 	deck.Thumbnail = *utils.GetImageURL(img, false)
+	deck.ImagePath = img
+	deck.SetBackImage()
 
 	// Load related sources
 	query := `
 		SELECT s.id, s.name
 		FROM source s
 		INNER JOIN deck_source ds ON ds.source = s.id
-		WHERE ds.deck = $1
+		WHERE ds.deck = $1 AND s.deleted_at IS NULL
 	`
-	rows, err := db.Query(query, deck.ID)
+	rows, err := conn(ctx, db).QueryContext(ctx, query, deck.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -117,76 +172,88 @@ func GetDeckByID(db *sql.DB, deckId int64) (*Deck, error) {
 	return &deck, nil
 }
 
-// CreateDeck inserts a new deck into the database and returns the new ID
-func CreateDeck(db *sql.DB, deck DeckInput) (*int64, error) {
-	query := "INSERT INTO deck (name, image, description) VALUES ($1, $2, $3) RETURNING id"
+// CreateDeck inserts a new deck, linked to its sources, into the database and
+// returns the new ID
+func CreateDeck(ctx context.Context, db *sql.DB, deck DeckInput) (*int64, error) {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO deck (name, image, description, major_image_template, minor_image_template,
+			back_image, image_width, image_height, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP) RETURNING id`
 
 	var id int64
-	if err := db.QueryRow(query, deck.Name, deck.Image, deck.Description).Scan(&id); err != nil {
+	if err := tx.QueryRowContext(ctx, query, deck.Name, deck.Image, deck.Description,
+		deck.MajorImageTemplate, deck.MinorImageTemplate, deck.BackImage, deck.ImageWidth, deck.ImageHeight).Scan(&id); err != nil {
+		return nil, err
+	}
+	if err := insertDeckSources(ctx, tx, id, deck.Sources); err != nil {
 		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return &id, nil
 }
 
-// UpdateDeck updates an existing deck and its associated sources
-func UpdateDeck(db *sql.DB, deckID int64, input DeckInput) (*Deck, error) {
-	tx, err := db.Begin()
+// UpdateDeck updates an existing deck and its associated sources.
+// A non-zero version makes the update conditional, see ErrVersionMismatch.
+func UpdateDeck(ctx context.Context, db *sql.DB, deckID int64, input DeckInput, version int64) error {
+	tx, err := beginTx(ctx, db)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer tx.Rollback()
 
 	// Update deck fields
-	updateQuery := "UPDATE deck SET name = $1, image = $2, description = $3 WHERE id = $4"
-	res, err := tx.Exec(updateQuery, input.Name, input.Image, input.Description, deckID)
+	updateQuery, args := ifVersion("UPDATE deck SET name = $1, image = $2, description = $3, "+
+		"major_image_template = $4, minor_image_template = $5, back_image = $6, image_width = $7, image_height = $8, "+
+		bumpVersion+" WHERE id = $9 AND deleted_at IS NULL",
+		[]any{input.Name, input.Image, input.Description, input.MajorImageTemplate, input.MinorImageTemplate,
+			input.BackImage, input.ImageWidth, input.ImageHeight, deckID}, version)
+	res, err := tx.ExecContext(ctx, updateQuery, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return nil, err
-	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
+	if err := checkWritten(res, version); err != nil {
+		return err
 	}
 
-	// Remove existing sources
-	if _, err := tx.Exec("DELETE FROM deck_source WHERE deck = $1", deckID); err != nil {
-		return nil, err
+	// Remove existing sources. The deck does not show those in the trash, so
+	// their links are kept for when they are restored.
+	const deleteSources = `
+	DELETE FROM deck_source
+	WHERE deck = $1 AND source IN (SELECT id FROM source WHERE deleted_at IS NULL)`
+	if _, err := tx.ExecContext(ctx, deleteSources, deckID); err != nil {
+		return err
 	}
 
-	// Insert new sources
-	for _, src := range input.Sources {
-		_, err := tx.Exec("INSERT INTO deck_source (deck, source) VALUES ($1, $2)", deckID, src.ID)
-		if err != nil {
-			return nil, err
-		}
+	if err := insertDeckSources(ctx, tx, deckID, input.Sources); err != nil {
+		return err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
+	return tx.Commit()
+}
 
-	// Build the full deck response with attached sources
-	updated := &Deck{
-		ID:          deckID,
-		Name:        input.Name,
-		Image:       input.Image,
-		Description: input.Description,
-		Sources:     make([]Source, len(input.Sources)),
-	}
-	for i, src := range input.Sources {
-		updated.Sources[i] = Source{ID: src.ID}
+// insertDeckSources links a deck to the sources not linked to it yet
+func insertDeckSources(ctx context.Context, tx dbConn, deckID int64, sources []IDOnly) error {
+	const query = `
+	INSERT INTO deck_source (deck, source)
+	SELECT $1, $2
+	WHERE NOT EXISTS (SELECT 1 FROM deck_source WHERE deck = $1 AND source = $2)`
+	for _, src := range sources {
+		if _, err := tx.ExecContext(ctx, query, deckID, src.ID); err != nil {
+			return err
+		}
 	}
-
-	return updated, nil
+	return nil
 }
 
-// DeleteDeck removes a deck by ID
-func DeleteDeck(db *sql.DB, deckId int64) error {
-	query := "DELETE FROM deck WHERE id = $1"
-	_, err := db.Exec(query, deckId)
-	return err
+// DeleteDeck moves a deck, and with it its cards, to the trash
+func DeleteDeck(ctx context.Context, db *sql.DB, deckId int64, version int64) error {
+	return trashRow(ctx, conn(ctx, db), "deck", deckId, version)
 }
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Dependents lists what a delete removes along with an entity: the rows that
+// cascade from it and the links between decks and sources. Rows in the trash
+// are left out. An entity goes to the trash first and takes its dependents
+// along when purged; the cards of a deck, suit or rank are hidden with it
+// right away.
+type Dependents struct {
+	MajorCards    []int64 `json:"majorCards,omitempty"`
+	MinorCards    []int64 `json:"minorCards,omitempty"`
+	MajorMeanings []int64 `json:"majorMeanings,omitempty"`
+	MinorMeanings []int64 `json:"minorMeanings,omitempty"`
+	// Combinations counts the card combination meanings, which have no IDs
+	Combinations int     `json:"combinations,omitempty"`
+	Decks        []int64 `json:"decks,omitempty"`   // decks linked to a source
+	Sources      []int64 `json:"sources,omitempty"` // sources linked to a deck
+}
+
+// IsEmpty reports whether nothing depends on the entity
+func (d Dependents) IsEmpty() bool {
+	return len(d.MajorCards) == 0 && len(d.MinorCards) == 0 &&
+		len(d.MajorMeanings) == 0 && len(d.MinorMeanings) == 0 &&
+		d.Combinations == 0 && len(d.Decks) == 0 && len(d.Sources) == 0
+}
+
+// dependentsQuery fills one field of Dependents
+type dependentsQuery struct {
+	ids   *[]int64 // set from the IDs selected by query, unless count is set
+	count *int     // set from the count selected by query
+	query string   // refers to the entity ID as $1
+}
+
+// fetchDependents runs the queries for the entity id
+func fetchDependents(ctx context.Context, db *sql.DB, id int64, deps *Dependents, queries ...dependentsQuery) (*Dependents, error) {
+	for _, q := range queries {
+		if q.count != nil {
+			if err := conn(ctx, db).QueryRowContext(ctx, q.query, id).Scan(q.count); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ids, err := queryIDs(ctx, db, q.query, id)
+		if err != nil {
+			return nil, err
+		}
+		*q.ids = ids
+	}
+	return deps, nil
+}
+
+func queryIDs(ctx context.Context, db *sql.DB, query string, args ...any) ([]int64, error) {
+	rows, err := conn(ctx, db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetDeckDependents returns the cards of a deck, their combinations and the linked sources
+func GetDeckDependents(ctx context.Context, db *sql.DB, id int64) (*Dependents, error) {
+	var d Dependents
+	return fetchDependents(ctx, db, id, &d,
+		dependentsQuery{ids: &d.MajorCards, query: `
+			SELECT c.id FROM card c JOIN card_major m ON m.card = c.id
+			WHERE c.deck = $1 AND c.deleted_at IS NULL ORDER BY c.id`},
+		dependentsQuery{ids: &d.MinorCards, query: `
+			SELECT c.id FROM card c JOIN card_minor m ON m.card = c.id
+			WHERE c.deck = $1 AND c.deleted_at IS NULL ORDER BY c.id`},
+		dependentsQuery{count: &d.Combinations, query: `
+			SELECT COUNT(*) FROM card_combination
+			WHERE card_one IN (SELECT id FROM card WHERE deck = $1 AND deleted_at IS NULL)
+			OR card_two IN (SELECT id FROM card WHERE deck = $1 AND deleted_at IS NULL)`},
+		dependentsQuery{ids: &d.Sources, query: `
+			SELECT l.source FROM deck_source l JOIN source s ON s.id = l.source
+			WHERE l.deck = $1 AND s.deleted_at IS NULL ORDER BY l.source`},
+	)
+}
+
+// GetSourceDependents returns the meanings and combinations of a source and the linked decks
+func GetSourceDependents(ctx context.Context, db *sql.DB, id int64) (*Dependents, error) {
+	var d Dependents
+	return fetchDependents(ctx, db, id, &d,
+		dependentsQuery{ids: &d.MajorMeanings, query: `SELECT id FROM meaning_major WHERE source = $1 ORDER BY id`},
+		dependentsQuery{ids: &d.MinorMeanings, query: `
+			SELECT id FROM meaning_minor WHERE source = $1 AND ` + minorMeaningVisible + ` ORDER BY id`},
+		dependentsQuery{count: &d.Combinations, query: `SELECT COUNT(*) FROM card_combination WHERE source = $1`},
+		dependentsQuery{ids: &d.Decks, query: `
+			SELECT l.deck FROM deck_source l JOIN deck d ON d.id = l.deck
+			WHERE l.source = $1 AND d.deleted_at IS NULL ORDER BY l.deck`},
+	)
+}
+
+// GetSuitDependents returns the Minor Arcana cards and meanings of a suit
+// and the combinations of its cards
+func GetSuitDependents(ctx context.Context, db *sql.DB, id int64) (*Dependents, error) {
+	return minorArcanaDependents(ctx, db, "suit", id)
+}
+
+// GetRankDependents returns the Minor Arcana cards and meanings of a rank
+// and the combinations of its cards
+func GetRankDependents(ctx context.Context, db *sql.DB, id int64) (*Dependents, error) {
+	return minorArcanaDependents(ctx, db, "rank", id)
+}
+
+// minorArcanaDependents returns the dependents of the suit or rank id, column
+// of the card_minor and meaning_minor tables
+func minorArcanaDependents(ctx context.Context, db *sql.DB, column string, id int64) (*Dependents, error) {
+	var d Dependents
+	cards := `SELECT m.card FROM card_minor m JOIN card c ON c.id = m.card
+		WHERE m.` + column + ` = $1 AND c.deleted_at IS NULL`
+	return fetchDependents(ctx, db, id, &d,
+		dependentsQuery{ids: &d.MinorCards, query: cards + ` ORDER BY m.card`},
+		dependentsQuery{ids: &d.MinorMeanings, query: `
+			SELECT id FROM meaning_minor WHERE ` + column + ` = $1 AND ` + minorMeaningVisible + ` ORDER BY id`},
+		dependentsQuery{count: &d.Combinations, query: `
+			SELECT COUNT(*) FROM card_combination
+			WHERE card_one IN (` + cards + `)
+			OR card_two IN (` + cards + `)`},
+	)
+}
+
+// GetCardDependents returns the combinations of a Major or Minor Arcana card
+func GetCardDependents(ctx context.Context, db *sql.DB, id int64) (*Dependents, error) {
+	var d Dependents
+	return fetchDependents(ctx, db, id, &d,
+		dependentsQuery{count: &d.Combinations, query: `
+			SELECT COUNT(*) FROM card_combination WHERE card_one = $1 OR card_two = $1`},
+	)
+}
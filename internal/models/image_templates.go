@@ -0,0 +1,71 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// ImageTemplate is an image path relative to the static storage with
+// placeholders standing for the card:
+//
+//	{arcana}  major or minor
+//	{number}  the number of a Major Arcana card, the rank ID of a Minor Arcana one, in two digits
+//	{suit}    the slug of the suit, or its ID in two digits if it has none
+//	{rank}    the slug of the rank, or its ID in two digits if it has none
+//
+// e.g. "rws/major/{number}.jpg" or "rws/{suit}/{number}.jpg"
+type ImageTemplate string
+
+// ImageCard is what an ImageTemplate knows of a card
+type ImageCard struct {
+	Arcana   string // "major" or "minor"
+	Number   int    // Major Arcana only
+	SuitID   int64  // Minor Arcana only
+	SuitSlug string
+	RankID   int64
+	RankSlug string
+}
+
+// MajorImageCard returns the ImageCard of the Major Arcana card number
+func MajorImageCard(number int) ImageCard {
+	return ImageCard{Arcana: "major", Number: number}
+}
+
+// MinorImageCard returns the ImageCard of a Minor Arcana card
+func MinorImageCard(suit Suit, rank Rank) ImageCard {
+	return ImageCard{Arcana: "minor", SuitID: suit.ID, SuitSlug: suit.Slug, RankID: rank.ID, RankSlug: rank.Slug}
+}
+
+// Resolve returns the image path of card, "" for an empty template
+func (t ImageTemplate) Resolve(card ImageCard) string {
+	if t == "" {
+		return ""
+	}
+	number := card.Number
+	if card.Arcana == "minor" {
+		number = int(card.RankID)
+	}
+	return strings.NewReplacer(
+		"{arcana}", card.Arcana,
+		"{number}", fmt.Sprintf("%02d", number),
+		"{suit}", slugOrID(card.SuitSlug, card.SuitID),
+		"{rank}", slugOrID(card.RankSlug, card.RankID),
+	).Replace(string(t))
+}
+
+func slugOrID(slug string, id int64) string {
+	if slug != "" {
+		return slug
+	}
+	return fmt.Sprintf("%02d", id)
+}
+
+// imagePath returns the stored image path of a card or, without one, the path
+// resolved from the image template of its deck
+func imagePath(stored sql.NullString, template string, card ImageCard) string {
+	if stored.Valid {
+		return stored.String
+	}
+	return ImageTemplate(template).Resolve(card)
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 
-	"github.com/ilbagatto/tarot-api/internal/utils"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // CardMajor represents a Major Arcana card
@@ -22,33 +23,49 @@ type CardMajorInput struct {
 	OrgName string `json:"orgname,omitempty" example:"Le Mat"`
 }
 
+// CardMajorBatchItem is an item of a batch write of Major Arcana cards:
+// it creates a card, or updates card ID when set
+type CardMajorBatchItem struct {
+	ID      int64 `json:"id,omitempty" example:"0"`
+	Version int64 `json:"version,omitempty" example:"0"` // version the card is expected at; 0 updates it unconditionally
+	CardMajorInput
+}
+
+// Input returns the card in input form, e.g. to apply a partial update to it
+func (c *CardMajor) Input() CardMajorInput {
+	return CardMajorInput{DeckID: c.DeckID, Number: c.Number, Name: c.Name, OrgName: c.OrgName}
+}
+
 // ListMajorCards retrieves all Major Arcana cards for a given deck
-func ListMajorCards(db *sql.DB, deckID int64) ([]CardMajor, error) {
+func ListMajorCards(ctx context.Context, db *sql.DB, deckID int64) (cards []CardMajor, err error) {
+	ctx, span := startSpan(ctx, "ListMajorCards", attribute.Int64(attrDeckID, deckID))
+	defer func() { endSpan(span, len(cards), err) }()
+
 	const query = `
-		SELECT c.id, c.deck, m.number, m.name, m.orgname
+		SELECT c.id, c.deck, m.number, m.name, m.orgname, i.path, d.major_image_template, d.image_width, d.image_height
 		FROM card c
 		JOIN card_major m ON m.card = c.id
-		WHERE c.deck = $1
+		JOIN deck d ON d.id = c.deck
+		LEFT JOIN card_image i ON i.card = c.id
+		WHERE c.deck = $1 AND ` + cardVisible + `
 		ORDER BY m.number`
 
-	rows, err := db.Query(query, deckID)
+	rows, err := conn(ctx, db).QueryContext(ctx, query, deckID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var cards []CardMajor
 	for rows.Next() {
 		var card CardMajor
-		if err := rows.Scan(&card.ID, &card.DeckID, &card.Number, &card.Name, &card.OrgName); err != nil {
+		var img sql.NullString
+		var template string
+		var width, height int
+		if err := rows.Scan(&card.ID, &card.DeckID, &card.Number, &card.Name, &card.OrgName, &img, &template,
+			&width, &height); err != nil {
 			return nil, err
 		}
-
-		img, err := GetCardImageByCardID(db, card.ID)
-		if err == nil {
-			card.Image = utils.GetImageURL(img.Path, false)
-			card.Thumbnail = utils.GetImageURL(img.Path, true)
-		}
+		card.SetImage(imagePath(img, template, MajorImageCard(card.Number)), width, height)
 
 		cards = append(cards, card)
 	}
@@ -59,25 +76,27 @@ func ListMajorCards(db *sql.DB, deckID int64) ([]CardMajor, error) {
 }
 
 // GetMajorCardByID retrieves a Major Arcana card by its ID
-func GetMajorCardByID(db *sql.DB, id int64) (*CardMajor, error) {
+func GetMajorCardByID(ctx context.Context, db *sql.DB, id int64) (*CardMajor, error) {
 	var query = `
-		SELECT c.id, c.deck, m.number, m.name, m.orgname
+		SELECT c.id, c.deck, m.number, m.name, m.orgname, c.version, c.updated_at,
+			i.path, d.major_image_template, d.image_width, d.image_height
 		FROM card c
 		JOIN card_major m ON m.card = c.id
-		WHERE c.id = $1`
+		JOIN deck d ON d.id = c.deck
+		LEFT JOIN card_image i ON i.card = c.id
+		WHERE c.id = $1 AND ` + cardVisible
 
 	var card CardMajor
-	if err := db.QueryRow(query, id).Scan(
-		&card.ID, &card.DeckID, &card.Number, &card.Name, &card.OrgName,
+	var img sql.NullString
+	var template string
+	var width, height int
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id).Scan(
+		&card.ID, &card.DeckID, &card.Number, &card.Name, &card.OrgName, &card.Version, &card.UpdatedAt,
+		&img, &template, &width, &height,
 	); err != nil {
 		return nil, err
 	}
-
-	img, err := GetCardImageByCardID(db, card.ID)
-	if err == nil {
-		card.Image = utils.GetImageURL(img.Path, false)
-		card.Thumbnail = utils.GetImageURL(img.Path, true)
-	}
+	card.SetImage(imagePath(img, template, MajorImageCard(card.Number)), width, height)
 
 	// Load related meanings
 	query = `
@@ -87,9 +106,9 @@ func GetMajorCardByID(db *sql.DB, id int64) (*CardMajor, error) {
 		AND m.source IN (
 			SELECT source FROM deck_source WHERE deck = $2
 		)
-		ORDER BY m.source, m.number, m.position
-	`
-	rows, err := db.Query(query, card.Number, card.DeckID)
+		AND ` + majorMeaningVisible + `
+		ORDER BY m.source, m.number, ` + orderByPosition
+	rows, err := conn(ctx, db).QueryContext(ctx, query, card.Number, card.DeckID)
 	if err != nil {
 		return nil, err
 	}
@@ -110,49 +129,66 @@ func GetMajorCardByID(db *sql.DB, id int64) (*CardMajor, error) {
 }
 
 // CreateMajorCard inserts a new Major Arcana card into the database
-func CreateMajorCard(db *sql.DB, input CardMajorInput) (*int64, error) {
-	tx, err := db.Begin()
+func CreateMajorCard(ctx context.Context, db *sql.DB, input CardMajorInput) (*int64, error) {
+	tx, err := beginTx(ctx, db)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	defer tx.Rollback()
 
-	cardID, err := insertCard(tx, input.DeckID, "major")
+	cardID, err := createMajorCard(ctx, tx, input)
 	if err != nil {
 		return nil, err
 	}
-
-	const insertMajor = `
-		INSERT INTO card_major (card, number, name, orgname)
-		VALUES ($1, $2, $3, $4)`
-	if _, err := tx.Exec(insertMajor, &cardID, input.Number, input.Name, input.OrgName); err != nil {
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-
-	return cardID, nil
+	return &cardID, nil
 }
 
-// UpdateMajorCard updates an existing Major Arcana card
-func UpdateMajorCard(db *sql.DB, id int64, input CardMajorInput) error {
-	tx, err := db.Begin()
+// UpdateMajorCard updates an existing Major Arcana card, conditionally if version is not 0
+func UpdateMajorCard(ctx context.Context, db *sql.DB, id int64, input CardMajorInput, version int64) error {
+	tx, err := beginTx(ctx, db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+	defer tx.Rollback()
+
+	if err := updateMajorCard(ctx, tx, id, input, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BatchMajorCards creates and updates Major Arcana cards in one transaction, see runBatch
+func BatchMajorCards(ctx context.Context, db *sql.DB, items []CardMajorBatchItem, atomic bool) ([]BatchResult, error) {
+	return runBatch(ctx, db, len(items), atomic, func(tx dbConn, i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := createMajorCard(ctx, tx, item.CardMajorInput)
+			return id, true, err
 		}
-	}()
+		return item.ID, false, updateMajorCard(ctx, tx, item.ID, item.CardMajorInput, item.Version)
+	})
+}
 
-	if err := updateCard(tx, input.DeckID, id); err != nil {
+func createMajorCard(ctx context.Context, tx dbConn, input CardMajorInput) (int64, error) {
+	cardID, err := insertCard(ctx, tx, input.DeckID, "major")
+	if err != nil {
+		return 0, err
+	}
+
+	const insertMajor = `
+		INSERT INTO card_major (card, number, name, orgname)
+		VALUES ($1, $2, $3, $4)`
+	if _, err := tx.ExecContext(ctx, insertMajor, *cardID, input.Number, input.Name, input.OrgName); err != nil {
+		return 0, err
+	}
+	return *cardID, nil
+}
+
+func updateMajorCard(ctx context.Context, tx dbConn, id int64, input CardMajorInput, version int64) error {
+	if err := updateCard(ctx, tx, input.DeckID, id, version); err != nil {
 		return err
 	}
 
@@ -160,23 +196,14 @@ func UpdateMajorCard(db *sql.DB, id int64, input CardMajorInput) error {
 		UPDATE card_major
 		SET number = $1, name = $2, orgname = $3
 		WHERE card = $4`
-	res, err := tx.Exec(updateMajor, input.Number, input.Name, input.OrgName, id)
-	if err != nil {
-		return err
-	}
-	rowsAffected, err := res.RowsAffected()
+	res, err := tx.ExecContext(ctx, updateMajor, input.Number, input.Name, input.OrgName, id)
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
-
-	return nil
+	return checkWritten(res, 0)
 }
 
-// DeleteMajorCard deletes a Major Arcana card from the database
-func DeleteMajorCard(db *sql.DB, id int64) error {
-	_, err := db.Exec("DELETE FROM card WHERE id = $1", id)
-	return err
+// DeleteMajorCard moves a Major Arcana card to the trash
+func DeleteMajorCard(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return trashRow(ctx, conn(ctx, db), "card", id, version)
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/ilbagatto/tarot-api/internal/utils"
@@ -13,6 +14,7 @@ type MeaningMajor struct {
 	Position MeaningPosition `json:"position"` // straight or reversed
 	Source   int64           `json:"source"`
 	Meaning  string          `json:"meaning"`
+	RowVersion
 }
 
 // MeaningMajorInput is used to create or update a MeaningMajor
@@ -23,23 +25,38 @@ type MeaningMajorInput struct {
 	Meaning  string          `json:"meaning" example:"Spiritual wisdom and intuition"`
 }
 
+// MeaningMajorBatchItem is an item of a batch write of Major Arcana meanings:
+// it creates a meaning, or updates meaning ID when set
+type MeaningMajorBatchItem struct {
+	ID      int64 `json:"id,omitempty" example:"0"`
+	Version int64 `json:"version,omitempty" example:"0"` // version the meaning is expected at; 0 updates it unconditionally
+	MeaningMajorInput
+}
+
+// Input returns the meaning in input form, e.g. to apply a partial update to it
+func (m *MeaningMajor) Input() MeaningMajorInput {
+	return MeaningMajorInput{Number: m.Number, Position: m.Position, Source: m.Source, Meaning: m.Meaning}
+}
+
 // ListMajorMeaning returns all MeaningMajor entries for given number and source
-func ListMajorMeanings(db *sql.DB, filters map[string]any) ([]MeaningMajor, error) {
+func ListMajorMeanings(ctx context.Context, db *sql.DB, filters map[string]any) (meanings []MeaningMajor, err error) {
+	ctx, span := startSpan(ctx, "ListMajorMeanings")
+	defer func() { endSpan(span, len(meanings), err) }()
+
 	query := `
 		SELECT id, number, position, source, meaning
 		FROM meaning_major
 	`
 
 	whereClause, args := utils.BuildWhereClause(filters, 1)
-	query += " " + whereClause + " ORDER BY position"
+	query += " " + whereVisible(whereClause, majorMeaningVisible) + " ORDER BY " + orderByPosition
 
-	rows, err := db.Query(query, args...)
+	rows, err := conn(ctx, db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var meanings []MeaningMajor
 	for rows.Next() {
 		var m MeaningMajor
 		if err := rows.Scan(&m.ID, &m.Number, &m.Position, &m.Source, &m.Meaning); err != nil {
@@ -54,62 +71,100 @@ func ListMajorMeanings(db *sql.DB, filters map[string]any) ([]MeaningMajor, erro
 }
 
 // GetMajorMeaningByID retrieves a MeaningMajor by its unique ID
-func GetMajorMeaningByID(db *sql.DB, id int64) (*MeaningMajor, error) {
+func GetMajorMeaningByID(ctx context.Context, db *sql.DB, id int64) (*MeaningMajor, error) {
 	const query = `
-	SELECT id, number, position, source, meaning
+	SELECT id, number, position, source, meaning, version, updated_at
 	FROM meaning_major
-	WHERE id = $1`
+	WHERE id = $1 AND ` + majorMeaningVisible
 	var m MeaningMajor
-	if err := db.QueryRow(query, id).Scan(&m.ID, &m.Number, &m.Position, &m.Source, &m.Meaning); err != nil {
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id).Scan(
+		&m.ID, &m.Number, &m.Position, &m.Source, &m.Meaning, &m.Version, &m.UpdatedAt,
+	); err != nil {
 		return nil, err
 	}
 	return &m, nil
 }
 
-// CreateMeaningMajor inserts a new MeaningMajor record
-func CreateMeaningMajor(db *sql.DB, input MeaningMajorInput) (*int64, error) {
-	const query = `
-	INSERT INTO meaning_major (number, position, source, meaning)
-	VALUES ($1, $2, $3, $4)
-	RETURNING id`
-	var id int64
-	if err := db.QueryRow(query, input.Number, input.Position, input.Source, input.Meaning).Scan(&id); err != nil {
+// CreateMeaningMajor inserts a new MeaningMajor record along with its first revision
+func CreateMeaningMajor(ctx context.Context, db *sql.DB, input MeaningMajorInput) (*int64, error) {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
 		return nil, err
 	}
-	return &id, nil
-}
+	defer tx.Rollback()
 
-// UpdateMajorMeaning updates an existing record by ID
-func UpdateMajorMeaning(db *sql.DB, id int64, input MeaningMajorInput) (*MeaningMajor, error) {
-	const query = `
-	UPDATE meaning_major
-	SET number = $1, position = $2, source = $3, meaning = $4
-	WHERE id = $5`
-	res, err := db.Exec(query, input.Number, input.Position, input.Source, input.Meaning, id)
+	id, err := createMajorMeaning(ctx, tx, input)
 	if err != nil {
 		return nil, err
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
+	return &id, nil
+}
+
+// UpdateMajorMeaning updates an existing record by ID, conditionally if version is not 0,
+// and keeps the new version as a revision
+func UpdateMajorMeaning(ctx context.Context, db *sql.DB, id int64, input MeaningMajorInput, version int64) error {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return err
 	}
+	defer tx.Rollback()
 
-	updated := &MeaningMajor{
-		ID:       id,
-		Number:   input.Number,
-		Position: input.Position,
-		Source:   input.Source,
-		Meaning:  input.Meaning,
+	if err := updateMajorMeaning(ctx, tx, id, input, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BatchMajorMeanings creates and updates Major Arcana meanings in one transaction, see runBatch
+func BatchMajorMeanings(ctx context.Context, db *sql.DB, items []MeaningMajorBatchItem, atomic bool) ([]BatchResult, error) {
+	return runBatch(ctx, db, len(items), atomic, func(tx dbConn, i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := createMajorMeaning(ctx, tx, item.MeaningMajorInput)
+			return id, true, err
+		}
+		return item.ID, false, updateMajorMeaning(ctx, tx, item.ID, item.MeaningMajorInput, item.Version)
+	})
+}
+
+func createMajorMeaning(ctx context.Context, tx dbConn, input MeaningMajorInput) (int64, error) {
+	const query = `
+	INSERT INTO meaning_major (number, position, source, meaning, updated_at)
+	VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+	RETURNING id`
+	if err := checkNotTrashed(ctx, tx, "source", input.Source); err != nil {
+		return 0, err
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, query, input.Number, input.Position, input.Source, input.Meaning).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, saveMajorRevision(ctx, tx, id)
+}
+
+func updateMajorMeaning(ctx context.Context, tx dbConn, id int64, input MeaningMajorInput, version int64) error {
+	if err := checkNotTrashed(ctx, tx, "source", input.Source); err != nil {
+		return err
+	}
+	query, args := ifVersion(`
+	UPDATE meaning_major
+	SET number = $1, position = $2, source = $3, meaning = $4, `+bumpVersion+`
+	WHERE id = $5 AND `+majorMeaningVisible,
+		[]any{input.Number, input.Position, input.Source, input.Meaning, id}, version)
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if err := checkWritten(res, version); err != nil {
+		return err
 	}
-	return updated, nil
+	return saveMajorRevision(ctx, tx, id)
 }
 
 // DeleteMajorMeaning deletes a record from the meaning_major table
-func DeleteMajorMeaning(db *sql.DB, id int64) error {
-	const query = `DELETE FROM meaning_major WHERE id = $1`
-	_, err := db.Exec(query, id)
-	return err
+func DeleteMajorMeaning(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return deleteRow(ctx, conn(ctx, db), "meaning_major", id, version, majorMeaningVisible)
 }
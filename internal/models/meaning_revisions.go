@@ -0,0 +1,136 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// MeaningMajorRevision is a former or the current version of a MeaningMajor
+type MeaningMajorRevision struct {
+	Revision  int64     `json:"revision"` // the version of the meaning it copies
+	CreatedAt time.Time `json:"createdAt"`
+	MeaningMajorInput
+	// Changes holds the fields that differ from the previous revision
+	Changes json.RawMessage `json:"changes,omitempty" swaggertype:"object"`
+}
+
+// MeaningMinorRevision is a former or the current version of a MeaningMinor
+type MeaningMinorRevision struct {
+	Revision  int64     `json:"revision"` // the version of the meaning it copies
+	CreatedAt time.Time `json:"createdAt"`
+	MeaningMinorInput
+	// Changes holds the fields that differ from the previous revision
+	Changes json.RawMessage `json:"changes,omitempty" swaggertype:"object"`
+}
+
+// saveMajorRevision copies the current version of meaning id into its revisions
+func saveMajorRevision(ctx context.Context, tx dbConn, id int64) error {
+	const query = `
+	INSERT INTO meaning_major_revision (meaning_id, revision, created_at, number, position, source, meaning)
+	SELECT id, version, updated_at, number, position, source, COALESCE(meaning, '')
+	FROM meaning_major
+	WHERE id = $1`
+	_, err := tx.ExecContext(ctx, query, id)
+	return err
+}
+
+// saveMinorRevision copies the current version of meaning id into its revisions
+func saveMinorRevision(ctx context.Context, tx dbConn, id int64) error {
+	const query = `
+	INSERT INTO meaning_minor_revision (meaning_id, revision, created_at, suit, rank, position, source, meaning)
+	SELECT id, version, updated_at, suit, rank, position, source, COALESCE(meaning, '')
+	FROM meaning_minor
+	WHERE id = $1`
+	_, err := tx.ExecContext(ctx, query, id)
+	return err
+}
+
+// ListMajorMeaningRevisions returns the revisions of meaning id, oldest first
+func ListMajorMeaningRevisions(ctx context.Context, db *sql.DB, id int64) (revisions []MeaningMajorRevision, err error) {
+	ctx, span := startSpan(ctx, "ListMajorMeaningRevisions")
+	defer func() { endSpan(span, len(revisions), err) }()
+
+	const query = `
+	SELECT revision, created_at, number, position, source, meaning
+	FROM meaning_major_revision
+	WHERE meaning_id = $1
+	ORDER BY revision`
+	rows, err := conn(ctx, db).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r MeaningMajorRevision
+		if err := rows.Scan(&r.Revision, &r.CreatedAt, &r.Number, &r.Position, &r.Source, &r.Meaning); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// GetMajorMeaningRevision returns one revision of meaning id
+func GetMajorMeaningRevision(ctx context.Context, db *sql.DB, id, revision int64) (*MeaningMajorRevision, error) {
+	const query = `
+	SELECT revision, created_at, number, position, source, meaning
+	FROM meaning_major_revision
+	WHERE meaning_id = $1 AND revision = $2`
+	var r MeaningMajorRevision
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id, revision).Scan(
+		&r.Revision, &r.CreatedAt, &r.Number, &r.Position, &r.Source, &r.Meaning,
+	); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListMinorMeaningRevisions returns the revisions of meaning id, oldest first
+func ListMinorMeaningRevisions(ctx context.Context, db *sql.DB, id int64) (revisions []MeaningMinorRevision, err error) {
+	ctx, span := startSpan(ctx, "ListMinorMeaningRevisions")
+	defer func() { endSpan(span, len(revisions), err) }()
+
+	const query = `
+	SELECT revision, created_at, suit, rank, position, source, meaning
+	FROM meaning_minor_revision
+	WHERE meaning_id = $1
+	ORDER BY revision`
+	rows, err := conn(ctx, db).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r MeaningMinorRevision
+		if err := rows.Scan(&r.Revision, &r.CreatedAt, &r.Suit, &r.Rank, &r.Position, &r.Source, &r.Meaning); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// GetMinorMeaningRevision returns one revision of meaning id
+func GetMinorMeaningRevision(ctx context.Context, db *sql.DB, id, revision int64) (*MeaningMinorRevision, error) {
+	const query = `
+	SELECT revision, created_at, suit, rank, position, source, meaning
+	FROM meaning_minor_revision
+	WHERE meaning_id = $1 AND revision = $2`
+	var r MeaningMinorRevision
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id, revision).Scan(
+		&r.Revision, &r.CreatedAt, &r.Suit, &r.Rank, &r.Position, &r.Source, &r.Meaning,
+	); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -7,6 +7,28 @@ const (
 	PositionReverted MeaningPosition = "reverted"
 )
 
+// orderByPosition sorts straight meanings before reverted ones, as the
+// card_position enum does in PostgreSQL; SQLite stores positions as plain text.
+const orderByPosition = "CASE position WHEN 'straight' THEN 0 ELSE 1 END"
+
+// majorMeaningVisible selects the Major Arcana meanings whose source is not
+// in the trash
+const majorMeaningVisible = "source IN (SELECT id FROM source WHERE deleted_at IS NULL)"
+
+// minorMeaningVisible selects the Minor Arcana meanings whose source, suit
+// and rank are not in the trash
+const minorMeaningVisible = majorMeaningVisible + `
+	AND suit IN (SELECT id FROM suit WHERE deleted_at IS NULL)
+	AND rank IN (SELECT id FROM rank WHERE deleted_at IS NULL)`
+
+// whereVisible adds the condition visible to a WHERE clause, which may be empty
+func whereVisible(whereClause, visible string) string {
+	if whereClause == "" {
+		return "WHERE " + visible
+	}
+	return whereClause + " AND " + visible
+}
+
 type MeaningRef struct {
 	ID       int64  `json:"id"`
 	Position string `json:"position"`
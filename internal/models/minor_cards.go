@@ -1,9 +1,10 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 
-	"github.com/ilbagatto/tarot-api/internal/utils"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // CardMinor represents a Minor Arcana card
@@ -20,35 +21,58 @@ type CardMinorInput struct {
 	RankID int64 `json:"rank" example:"10"`
 }
 
+// CardMinorBatchItem is an item of a batch write of Minor Arcana cards:
+// it creates a card, or updates card ID when set
+type CardMinorBatchItem struct {
+	ID      int64 `json:"id,omitempty" example:"0"`
+	Version int64 `json:"version,omitempty" example:"0"` // version the card is expected at; 0 updates it unconditionally
+	CardMinorInput
+}
+
+// Input returns the card in input form, e.g. to apply a partial update to it
+func (c *CardMinor) Input() CardMinorInput {
+	return CardMinorInput{DeckID: c.DeckID, SuitID: c.SuitID, RankID: c.RankID}
+}
+
+// imageCard returns the ImageCard of the card, whose suit and rank have the given slugs
+func (c *CardMinor) imageCard(suitSlug, rankSlug string) ImageCard {
+	return MinorImageCard(Suit{ID: c.SuitID, Slug: suitSlug}, Rank{ID: c.RankID, Slug: rankSlug})
+}
+
 // ListMinorCards retrieves all Minor Arcana cards for a given deck
-func ListMinorCards(db *sql.DB, deckID int64) ([]CardMinor, error) {
+func ListMinorCards(ctx context.Context, db *sql.DB, deckID int64) (cards []CardMinor, err error) {
+	ctx, span := startSpan(ctx, "ListMinorCards", attribute.Int64(attrDeckID, deckID))
+	defer func() { endSpan(span, len(cards), err) }()
+
 	const query = `
-	SELECT c.id, CONCAT(r.name, ' ', s.genitive) AS name, c.deck, m.suit, m.rank
+	SELECT c.id, r.name || ' ' || s.genitive AS name, c.deck, m.suit, m.rank, i.path,
+		d.minor_image_template, s.slug, r.slug, d.image_width, d.image_height
 	FROM card_minor m
 	JOIN card c ON c.id = m.card
 	JOIN rank r ON r.id = m.rank
 	JOIN suit s ON s.id = m.suit
-	WHERE c.deck = $1
+	JOIN deck d ON d.id = c.deck
+	LEFT JOIN card_image i ON i.card = c.id
+	WHERE c.deck = $1 AND ` + cardVisible + `
 	ORDER BY m.suit, m.rank`
 
-	rows, err := db.Query(query, deckID)
+	rows, err := conn(ctx, db).QueryContext(ctx, query, deckID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var cards []CardMinor
 	for rows.Next() {
 		var card CardMinor
-		if err := rows.Scan(&card.ID, &card.Name, &card.DeckID, &card.SuitID, &card.RankID); err != nil {
+		var img sql.NullString
+		var template string
+		var suit, rank string
+		var width, height int
+		if err := rows.Scan(&card.ID, &card.Name, &card.DeckID, &card.SuitID, &card.RankID, &img,
+			&template, &suit, &rank, &width, &height); err != nil {
 			return nil, err
 		}
-
-		img, err := GetCardImageByCardID(db, card.ID)
-		if err == nil {
-			card.Image = utils.GetImageURL(img.Path, false)
-			card.Thumbnail = utils.GetImageURL(img.Path, true)
-		}
+		card.SetImage(imagePath(img, template, card.imageCard(suit, rank)), width, height)
 
 		cards = append(cards, card)
 	}
@@ -56,27 +80,30 @@ func ListMinorCards(db *sql.DB, deckID int64) ([]CardMinor, error) {
 }
 
 // GetMinorCardByID retrieves a Minor Arcana card by its ID
-func GetMinorCardByID(db *sql.DB, id int64) (*CardMinor, error) {
+func GetMinorCardByID(ctx context.Context, db *sql.DB, id int64) (*CardMinor, error) {
 	var query = `
-	SELECT c.id, CONCAT(r.name, ' ', s.genitive) AS name, c.deck, m.suit, m.rank
+	SELECT c.id, r.name || ' ' || s.genitive AS name, c.deck, m.suit, m.rank, c.version, c.updated_at,
+		i.path, d.minor_image_template, s.slug, r.slug, d.image_width, d.image_height
 	FROM card_minor m
 	JOIN card c ON c.id = m.card
 	JOIN rank r ON r.id = m.rank
-	JOIN suit s ON s.id = m.suit	
-	WHERE c.id = $1`
+	JOIN suit s ON s.id = m.suit
+	JOIN deck d ON d.id = c.deck
+	LEFT JOIN card_image i ON i.card = c.id
+	WHERE c.id = $1 AND ` + cardVisible
 
 	var card CardMinor
-	if err := db.QueryRow(query, id).Scan(
-		&card.ID, &card.Name, &card.DeckID, &card.SuitID, &card.RankID,
+	var img sql.NullString
+	var template string
+	var suit, rank string
+	var width, height int
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id).Scan(
+		&card.ID, &card.Name, &card.DeckID, &card.SuitID, &card.RankID, &card.Version, &card.UpdatedAt,
+		&img, &template, &suit, &rank, &width, &height,
 	); err != nil {
 		return nil, err
 	}
-
-	img, err := GetCardImageByCardID(db, card.ID)
-	if err == nil {
-		card.Image = utils.GetImageURL(img.Path, false)
-		card.Thumbnail = utils.GetImageURL(img.Path, true)
-	}
+	card.SetImage(imagePath(img, template, card.imageCard(suit, rank)), width, height)
 
 	// Load related meanings
 	query = `
@@ -86,9 +113,9 @@ func GetMinorCardByID(db *sql.DB, id int64) (*CardMinor, error) {
 		AND m.source IN (
 			SELECT source FROM deck_source WHERE deck = $3
 		)
-		ORDER BY m.source, m.suit, m.rank, m.position
-	`
-	rows, err := db.Query(query, card.SuitID, card.RankID, card.DeckID)
+		AND ` + majorMeaningVisible + `
+		ORDER BY m.source, m.suit, m.rank, ` + orderByPosition
+	rows, err := conn(ctx, db).QueryContext(ctx, query, card.SuitID, card.RankID, card.DeckID)
 	if err != nil {
 		return nil, err
 	}
@@ -109,73 +136,115 @@ func GetMinorCardByID(db *sql.DB, id int64) (*CardMinor, error) {
 }
 
 // CreateMinorCard inserts a new Minor Arcana card into the database
-func CreateMinorCard(db *sql.DB, input CardMinorInput) (*int64, error) {
-	tx, err := db.Begin()
+func CreateMinorCard(ctx context.Context, db *sql.DB, input CardMinorInput) (*int64, error) {
+	tx, err := beginTx(ctx, db)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	defer tx.Rollback()
 
-	// Insert into card
-	cardID, err := insertCard(tx, input.DeckID, "minor")
+	cardID, err := createMinorCard(ctx, tx, input)
 	if err != nil {
 		return nil, err
 	}
-	// Insert into card_minor
-	const insertMinor = `
-		INSERT INTO card_minor (card, suit, rank)
-		VALUES ($1, $2, $3)`
-	if _, err = tx.Exec(insertMinor, &cardID, input.SuitID, input.RankID); err != nil {
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-
-	return cardID, nil
+	return &cardID, nil
 }
 
-// UpdateMinorCard updates an existing Minor Arcana card
-func UpdateMinorCard(db *sql.DB, id int64, input CardMinorInput) error {
-	tx, err := db.Begin()
+// UpdateMinorCard updates an existing Minor Arcana card, conditionally if version is not 0
+func UpdateMinorCard(ctx context.Context, db *sql.DB, id int64, input CardMinorInput, version int64) error {
+	tx, err := beginTx(ctx, db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+	defer tx.Rollback()
+
+	if err := updateMinorCard(ctx, tx, id, input, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BatchMinorCards creates and updates Minor Arcana cards in one transaction, see runBatch
+func BatchMinorCards(ctx context.Context, db *sql.DB, items []CardMinorBatchItem, atomic bool) ([]BatchResult, error) {
+	return runBatch(ctx, db, len(items), atomic, func(tx dbConn, i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := createMinorCard(ctx, tx, item.CardMinorInput)
+			return id, true, err
 		}
-	}()
+		return item.ID, false, updateMinorCard(ctx, tx, item.ID, item.CardMinorInput, item.Version)
+	})
+}
 
-	if err := updateCard(tx, input.DeckID, id); err != nil {
+func createMinorCard(ctx context.Context, tx dbConn, input CardMinorInput) (int64, error) {
+	if err := checkSuitRankNotTrashed(ctx, tx, input); err != nil {
+		return 0, err
+	}
+	cardID, err := insertCard(ctx, tx, input.DeckID, "minor")
+	if err != nil {
+		return 0, err
+	}
+
+	const insertMinor = `
+		INSERT INTO card_minor (card, suit, rank)
+		VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, insertMinor, *cardID, input.SuitID, input.RankID); err != nil {
+		return 0, err
+	}
+	return *cardID, nil
+}
+
+func updateMinorCard(ctx context.Context, tx dbConn, id int64, input CardMinorInput, version int64) error {
+	if err := checkSuitRankNotTrashed(ctx, tx, input); err != nil {
+		return err
+	}
+	if err := updateCard(ctx, tx, input.DeckID, id, version); err != nil {
 		return err
 	}
 
 	const updateMinor = `
 		UPDATE card_minor SET suit = $1, rank = $2 WHERE card = $3`
-	res, err := tx.Exec(updateMinor, input.SuitID, input.RankID, id)
+	res, err := tx.ExecContext(ctx, updateMinor, input.SuitID, input.RankID, id)
 	if err != nil {
 		return err
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
+	return checkWritten(res, 0)
+}
+
+// checkSuitRankNotTrashed fails with ErrParentInTrash if the suit or rank of
+// a card is in the trash
+func checkSuitRankNotTrashed(ctx context.Context, tx dbConn, input CardMinorInput) error {
+	if err := checkNotTrashed(ctx, tx, "suit", input.SuitID); err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
-
-	return nil
+	return checkNotTrashed(ctx, tx, "rank", input.RankID)
+}
 
+// DeleteMinorCard moves a Minor Arcana card to the trash
+func DeleteMinorCard(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return trashRow(ctx, conn(ctx, db), "card", id, version)
 }
 
-func DeleteMinorCard(db *sql.DB, id int64) error {
-	const query = `DELETE FROM card WHERE id = $1`
-	_, err := db.Exec(query, id)
-	return err
+// trashWithMinorCards moves the suit or rank id of table to the trash together
+// with the Minor Arcana cards made of it, which cannot outlive it. The cards
+// get the deleted_at of the suit or rank, by which its restore finds them.
+func trashWithMinorCards(ctx context.Context, db *sql.DB, table string, id int64, version int64) error {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := trashRow(ctx, tx, table, id, version); err != nil {
+		return err
+	}
+	query := "UPDATE card SET deleted_at = (SELECT deleted_at FROM " + table + " WHERE id = $1), " + bumpVersion +
+		" WHERE deleted_at IS NULL AND id IN (SELECT card FROM card_minor WHERE " + table + " = $1)"
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/ilbagatto/tarot-api/internal/utils"
@@ -14,6 +15,7 @@ type MeaningMinor struct {
 	Position MeaningPosition `json:"position"`
 	Source   int64           `json:"source"`
 	Meaning  string          `json:"meaning"`
+	RowVersion
 }
 
 // MeaningMinorInput is used for creating or updating MeaningMinor records
@@ -25,23 +27,38 @@ type MeaningMinorInput struct {
 	Meaning  string          `json:"meaning" example:"Active communication and drive"`
 }
 
+// MeaningMinorBatchItem is an item of a batch write of Minor Arcana meanings:
+// it creates a meaning, or updates meaning ID when set
+type MeaningMinorBatchItem struct {
+	ID      int64 `json:"id,omitempty" example:"0"`
+	Version int64 `json:"version,omitempty" example:"0"` // version the meaning is expected at; 0 updates it unconditionally
+	MeaningMinorInput
+}
+
+// Input returns the meaning in input form, e.g. to apply a partial update to it
+func (m *MeaningMinor) Input() MeaningMinorInput {
+	return MeaningMinorInput{Suit: m.Suit, Rank: m.Rank, Position: m.Position, Source: m.Source, Meaning: m.Meaning}
+}
+
 // ListMinorMeaning returns all MeaningMinor entries for given suit, name, position and source
-func ListMinorMeanings(db *sql.DB, filters map[string]any) ([]MeaningMinor, error) {
+func ListMinorMeanings(ctx context.Context, db *sql.DB, filters map[string]any) (meanings []MeaningMinor, err error) {
+	ctx, span := startSpan(ctx, "ListMinorMeanings")
+	defer func() { endSpan(span, len(meanings), err) }()
+
 	query := `
 	SELECT id, suit, rank, position, source, meaning
 	FROM meaning_minor
 	`
 
 	whereClause, args := utils.BuildWhereClause(filters, 1)
-	query += " " + whereClause + " ORDER BY position"
+	query += " " + whereVisible(whereClause, minorMeaningVisible) + " ORDER BY " + orderByPosition
 
-	rows, err := db.Query(query, args...)
+	rows, err := conn(ctx, db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var meanings []MeaningMinor
 	for rows.Next() {
 		var m MeaningMinor
 		if err := rows.Scan(&m.ID, &m.Suit, &m.Rank, &m.Position, &m.Source, &m.Meaning); err != nil {
@@ -56,63 +73,109 @@ func ListMinorMeanings(db *sql.DB, filters map[string]any) ([]MeaningMinor, erro
 }
 
 // GetMinorMeaningByID retrieves a MeaningMinor by its unique ID
-func GetMinorMeaningByID(db *sql.DB, id int64) (*MeaningMinor, error) {
+func GetMinorMeaningByID(ctx context.Context, db *sql.DB, id int64) (*MeaningMinor, error) {
 	const query = `
-	SELECT id, suit, rank, position, source, meaning
+	SELECT id, suit, rank, position, source, meaning, version, updated_at
 	FROM meaning_minor
-	WHERE id = $1`
+	WHERE id = $1 AND ` + minorMeaningVisible
 	var m MeaningMinor
-	if err := db.QueryRow(query, id).Scan(
-		&m.ID, &m.Suit, &m.Rank, &m.Position, &m.Source, &m.Meaning,
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id).Scan(
+		&m.ID, &m.Suit, &m.Rank, &m.Position, &m.Source, &m.Meaning, &m.Version, &m.UpdatedAt,
 	); err != nil {
 		return nil, err
 	}
 	return &m, nil
 }
 
-// CreateMinorMeaning inserts a new record into meaning_minor
-func CreateMinorMeaning(db *sql.DB, input MeaningMinorInput) (*int64, error) {
-	const query = `
-	INSERT INTO meaning_minor (suit, rank, position, source, meaning)
-	VALUES ($1, $2, $3, $4, $5)
-	RETURNING id`
-	var id int64
-	if err := db.QueryRow(query, input.Suit, input.Rank, input.Position, input.Source, input.Meaning).Scan(&id); err != nil {
+// CreateMinorMeaning inserts a new record into meaning_minor along with its first revision
+func CreateMinorMeaning(ctx context.Context, db *sql.DB, input MeaningMinorInput) (*int64, error) {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
 		return nil, err
 	}
-	return &id, nil
-}
+	defer tx.Rollback()
 
-// UpdateMinorMeaning updates an existing record by ID
-func UpdateMinorMeaning(db *sql.DB, id int64, input MeaningMinorInput) (*MeaningMinor, error) {
-	const query = `
-	UPDATE meaning_minor
-	SET suit = $1, rank = $2, position = $3, source = $4, meaning = $5
-	WHERE id = $6`
-	res, err := db.Exec(query, input.Suit, input.Rank, input.Position, input.Source, input.Meaning, id)
+	id, err := createMinorMeaning(ctx, tx, input)
 	if err != nil {
 		return nil, err
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
+	return &id, nil
+}
+
+// UpdateMinorMeaning updates an existing record by ID, conditionally if version is not 0,
+// and keeps the new version as a revision
+func UpdateMinorMeaning(ctx context.Context, db *sql.DB, id int64, input MeaningMinorInput, version int64) error {
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return err
 	}
+	defer tx.Rollback()
 
-	updated := &MeaningMinor{
-		ID: id, Suit: input.Suit,
-		Rank:     input.Rank,
-		Position: input.Position,
-		Source:   input.Source,
-		Meaning:  input.Meaning,
+	if err := updateMinorMeaning(ctx, tx, id, input, version); err != nil {
+		return err
 	}
-	return updated, nil
+	return tx.Commit()
+}
+
+// BatchMinorMeanings creates and updates Minor Arcana meanings in one transaction, see runBatch
+func BatchMinorMeanings(ctx context.Context, db *sql.DB, items []MeaningMinorBatchItem, atomic bool) ([]BatchResult, error) {
+	return runBatch(ctx, db, len(items), atomic, func(tx dbConn, i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := createMinorMeaning(ctx, tx, item.MeaningMinorInput)
+			return id, true, err
+		}
+		return item.ID, false, updateMinorMeaning(ctx, tx, item.ID, item.MeaningMinorInput, item.Version)
+	})
+}
+
+func createMinorMeaning(ctx context.Context, tx dbConn, input MeaningMinorInput) (int64, error) {
+	const query = `
+	INSERT INTO meaning_minor (suit, rank, position, source, meaning, updated_at)
+	VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
+	RETURNING id`
+	if err := checkMeaningMinorParents(ctx, tx, input); err != nil {
+		return 0, err
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, query, input.Suit, input.Rank, input.Position, input.Source, input.Meaning).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, saveMinorRevision(ctx, tx, id)
+}
+
+func updateMinorMeaning(ctx context.Context, tx dbConn, id int64, input MeaningMinorInput, version int64) error {
+	if err := checkMeaningMinorParents(ctx, tx, input); err != nil {
+		return err
+	}
+	query, args := ifVersion(`
+	UPDATE meaning_minor
+	SET suit = $1, rank = $2, position = $3, source = $4, meaning = $5, `+bumpVersion+`
+	WHERE id = $6 AND `+minorMeaningVisible,
+		[]any{input.Suit, input.Rank, input.Position, input.Source, input.Meaning, id}, version)
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if err := checkWritten(res, version); err != nil {
+		return err
+	}
+	return saveMinorRevision(ctx, tx, id)
 }
 
 // DeleteMinorMeaning removes a record from the meaning_minor table by ID
-func DeleteMinorMeaning(db *sql.DB, id int64) error {
-	_, err := db.Exec(`DELETE FROM meaning_minor WHERE id = $1`, id)
-	return err
+func DeleteMinorMeaning(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return deleteRow(ctx, conn(ctx, db), "meaning_minor", id, version, minorMeaningVisible)
+}
+
+// checkMeaningMinorParents fails with ErrParentInTrash if the source, suit or
+// rank of a meaning is in the trash
+func checkMeaningMinorParents(ctx context.Context, tx dbConn, input MeaningMinorInput) error {
+	if err := checkNotTrashed(ctx, tx, "source", input.Source); err != nil {
+		return err
+	}
+	return checkSuitRankNotTrashed(ctx, tx, CardMinorInput{SuitID: input.Suit, RankID: input.Rank})
 }
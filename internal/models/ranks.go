@@ -1,21 +1,30 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 )
 
 type Rank struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
+	Slug string `json:"slug,omitempty" example:"ace"` // {rank} of image templates
+	RowVersion
 }
 
 type RankInput struct {
 	Name string `json:"name"`
+	Slug string `json:"slug,omitempty" example:"ace"`
+}
+
+// Input returns the rank in input form, e.g. to apply a partial update to it
+func (r *Rank) Input() RankInput {
+	return RankInput{Name: r.Name, Slug: r.Slug}
 }
 
 // ListRanks retrieves all ranks
-func ListRanks(db *sql.DB) ([]Rank, error) {
-	rows, err := db.Query(`SELECT id, name FROM rank ORDER BY id`)
+func ListRanks(ctx context.Context, db *sql.DB) ([]Rank, error) {
+	rows, err := conn(ctx, db).QueryContext(ctx, `SELECT id, name, slug FROM rank WHERE deleted_at IS NULL ORDER BY id`)
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +33,7 @@ func ListRanks(db *sql.DB) ([]Rank, error) {
 	var ranks []Rank
 	for rows.Next() {
 		var r Rank
-		if err := rows.Scan(&r.ID, &r.Name); err != nil {
+		if err := rows.Scan(&r.ID, &r.Name, &r.Slug); err != nil {
 			return nil, err
 		}
 		ranks = append(ranks, r)
@@ -33,51 +42,39 @@ func ListRanks(db *sql.DB) ([]Rank, error) {
 }
 
 // GetRankByID retrieves a single rank by ID
-func GetRankByID(db *sql.DB, id int64) (*Rank, error) {
+func GetRankByID(ctx context.Context, db *sql.DB, id int64) (*Rank, error) {
 	var r Rank
-	row := db.QueryRow(`SELECT id, name FROM rank WHERE id = $1`, id)
-	if err := row.Scan(&r.ID, &r.Name); err != nil {
+	row := conn(ctx, db).QueryRowContext(ctx, `SELECT id, name, slug, version, updated_at FROM rank WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err := row.Scan(&r.ID, &r.Name, &r.Slug, &r.Version, &r.UpdatedAt); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 // CreateRank inserts a new rank
-func CreateRank(db *sql.DB, r RankInput) (*int64, error) {
+func CreateRank(ctx context.Context, db *sql.DB, r RankInput) (*int64, error) {
 	var id int64
-	if err := db.QueryRow(
-		`INSERT INTO rank (name) VALUES ($1) RETURNING id`,
-		r.Name,
+	if err := conn(ctx, db).QueryRowContext(ctx,
+		`INSERT INTO rank (name, slug, updated_at) VALUES ($1, $2, CURRENT_TIMESTAMP) RETURNING id`,
+		r.Name, r.Slug,
 	).Scan(&id); err != nil {
 		return nil, err
 	}
 	return &id, nil
 }
 
-// UpdateRank updates an existing rank
-func UpdateRank(db *sql.DB, rankID int64, r RankInput) (*Rank, error) {
-	res, err := db.Exec(`UPDATE rank SET name = $1 WHERE id = $2`, r.Name, rankID)
-	if err != nil {
-		return nil, err
-	}
-	rowsAffected, err := res.RowsAffected()
+// UpdateRank updates an existing rank, conditionally if version is not 0
+func UpdateRank(ctx context.Context, db *sql.DB, rankID int64, r RankInput, version int64) error {
+	query, args := ifVersion(`UPDATE rank SET name = $1, slug = $2, `+bumpVersion+` WHERE id = $3 AND deleted_at IS NULL`,
+		[]any{r.Name, r.Slug, rankID}, version)
+	res, err := conn(ctx, db).ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
-	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
+		return err
 	}
-
-	updated := &Rank{
-		ID:   rankID,
-		Name: r.Name,
-	}
-
-	return updated, err
+	return checkWritten(res, version)
 }
 
-// DeleteRank deletes a rank by ID
-func DeleteRank(db *sql.DB, id int64) error {
-	_, err := db.Exec(`DELETE FROM rank WHERE id = $1`, id)
-	return err
+// DeleteRank moves a rank to the trash with its Minor Arcana cards
+func DeleteRank(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return trashWithMinorCards(ctx, db, "rank", id, version)
 }
@@ -0,0 +1,214 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Errors of ScaffoldDeck
+var (
+	ErrUnknownTradition = errors.New("unknown naming tradition")
+	ErrDeckNotEmpty     = errors.New("deck already has cards")
+)
+
+// DefaultTradition names the Major Arcana when ScaffoldInput has no tradition
+const DefaultTradition = "rider-waite"
+
+// majorName is the name of a Major Arcana card in a naming tradition
+type majorName struct {
+	name    string
+	orgName string
+}
+
+// traditions lists the Major Arcana of each naming tradition in number order,
+// named like the decks of the seed data
+var traditions = map[string][]majorName{
+	"rider-waite": {
+		{"Шут", "The Fool"},
+		{"Маг", "The Magician"},
+		{"Жрица", "The High Priestess"},
+		{"Императрица", "The Empress"},
+		{"Император", "The Emperor"},
+		{"Первосвященник", "The Hierophant"},
+		{"Влюбленные", "The Lovers"},
+		{"Повозка", "The Chariot"},
+		{"Сила", "Strength"},
+		{"Отшельник", "The Hermit"},
+		{"Колесо Фортуны", "Wheel Of Fortune"},
+		{"Справедливость", "Justice"},
+		{"Повешенный", "The Hanged Man"},
+		{"Смерть", "Death"},
+		{"Умеренность", "Temperance"},
+		{"Диавол", "The Devil"},
+		{"Башня", "The Tower"},
+		{"Звезда", "The Star"},
+		{"Луна", "The Moon"},
+		{"Солнце", "The Sun"},
+		{"Суд", "Judgement"},
+		{"Мир", "The World"},
+	},
+	"marseille": {
+		{"Шут", "Le Mat"},
+		{"Фокусник", "Le Bateleur"},
+		{"Жрица", "La Papesse"},
+		{"Императрица", "L'Imperatrice"},
+		{"Император", "L'Empereur"},
+		{"Первосвященник", "Le Pape"},
+		{"Влюбленные", "L'Amoureux"},
+		{"Колесница", "Le Chariot"},
+		{"Справедливость", "La Justice"},
+		{"Отшельник", "L'Hermite"},
+		{"Колесо Фортуны", "La Roue De Fortune"},
+		{"Сила", "La Force"},
+		{"Повешенный", "Le Pendu"},
+		{"Смерть", "La Mort"},
+		{"Умеренность", "Temperance"},
+		{"Дьявол", "Le Diable"},
+		{"Разрушаемая Башня", "La Maison Dieu"},
+		{"Звезда", "L'Etoile"},
+		{"Луна", "La Lune"},
+		{"Солнце", "Le Soleil"},
+		{"Суд", "Le Jugement"},
+		{"Мир", "Le Monde"},
+	},
+}
+
+// Traditions returns the names of the known naming traditions, sorted
+func Traditions() []string {
+	names := make([]string, 0, len(traditions))
+	for name := range traditions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ScaffoldInput tells how to fill a new deck with the standard 78 cards.
+// The image patterns are image templates, see ImageTemplate; the cards get
+// image paths of their own only from them.
+type ScaffoldInput struct {
+	Tradition   string `json:"tradition,omitempty" example:"marseille"` // rider-waite (default) or marseille
+	MajorImages string `json:"majorImages,omitempty" example:"thoth/major/{number}.jpg"`
+	MinorImages string `json:"minorImages,omitempty" example:"thoth/minor/{suit}/{rank}.jpg"`
+}
+
+// ScaffoldResult lists the cards created by a scaffold
+type ScaffoldResult struct {
+	MajorCards []int64 `json:"majorCards"`
+	MinorCards []int64 `json:"minorCards"`
+}
+
+// MajorCards returns the Major Arcana of deckID in the tradition of the input
+func (in ScaffoldInput) MajorCards(deckID int64) ([]CardMajorInput, error) {
+	tradition := in.Tradition
+	if tradition == "" {
+		tradition = DefaultTradition
+	}
+	names, ok := traditions[tradition]
+	if !ok {
+		return nil, fmt.Errorf("%w %q: must be one of %s", ErrUnknownTradition, tradition, strings.Join(Traditions(), ", "))
+	}
+	cards := make([]CardMajorInput, len(names))
+	for i, n := range names {
+		cards[i] = CardMajorInput{DeckID: deckID, Number: i, Name: n.name, OrgName: n.orgName}
+	}
+	return cards, nil
+}
+
+// MajorImage returns the image path of the Major Arcana card number, "" if
+// there is no pattern
+func (in ScaffoldInput) MajorImage(number int) string {
+	return ImageTemplate(in.MajorImages).Resolve(MajorImageCard(number))
+}
+
+// MinorImage returns the image path of the Minor Arcana card of a suit and
+// rank, "" if there is no pattern
+func (in ScaffoldInput) MinorImage(suit Suit, rank Rank) string {
+	return ImageTemplate(in.MinorImages).Resolve(MinorImageCard(suit, rank))
+}
+
+// ScaffoldDeck creates the 22 Major Arcana of a deck, named in the tradition
+// of the input, and a Minor Arcana card for every suit and rank, all in one
+// transaction. The deck must have no cards yet, see ErrDeckNotEmpty.
+func ScaffoldDeck(ctx context.Context, db *sql.DB, deckID int64, input ScaffoldInput) (*ScaffoldResult, error) {
+	majors, err := input.MajorCards(deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var hasCards bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM card c WHERE c.deck = d.id AND c.deleted_at IS NULL)
+		FROM deck d
+		WHERE d.id = $1 AND d.deleted_at IS NULL`, deckID).Scan(&hasCards)
+	if err != nil {
+		return nil, err
+	}
+	if hasCards {
+		return nil, ErrDeckNotEmpty
+	}
+
+	result := &ScaffoldResult{}
+	for _, card := range majors {
+		id, err := createMajorCard(ctx, tx, card)
+		if err != nil {
+			return nil, err
+		}
+		if err := insertCardImage(ctx, tx, id, input.MajorImage(card.Number)); err != nil {
+			return nil, err
+		}
+		result.MajorCards = append(result.MajorCards, id)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT s.id, s.slug, r.id, r.slug
+		FROM suit s CROSS JOIN rank r
+		WHERE s.deleted_at IS NULL AND r.deleted_at IS NULL
+		ORDER BY s.id, r.id`)
+	if err != nil {
+		return nil, err
+	}
+	type suitRank struct {
+		suit Suit
+		rank Rank
+	}
+	var minors []suitRank
+	for rows.Next() {
+		var m suitRank
+		if err := rows.Scan(&m.suit.ID, &m.suit.Slug, &m.rank.ID, &m.rank.Slug); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		minors = append(minors, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, m := range minors {
+		id, err := createMinorCard(ctx, tx, CardMinorInput{DeckID: deckID, SuitID: m.suit.ID, RankID: m.rank.ID})
+		if err != nil {
+			return nil, err
+		}
+		if err := insertCardImage(ctx, tx, id, input.MinorImage(m.suit, m.rank)); err != nil {
+			return nil, err
+		}
+		result.MinorCards = append(result.MinorCards, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
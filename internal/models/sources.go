@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 )
 
@@ -9,6 +10,7 @@ type Source struct {
 	ID    int64     `json:"id"`
 	Name  string    `json:"name"`
 	Decks []DeckRef `json:"decks,omitempty"`
+	RowVersion
 }
 
 // SourceInput represents input format for creating/updating sources
@@ -17,6 +19,15 @@ type SourceInput struct {
 	Decks []IDOnly `json:"decks"`
 }
 
+// Input returns the source in input form, e.g. to apply a partial update to it
+func (s *Source) Input() SourceInput {
+	input := SourceInput{Name: s.Name}
+	for _, deck := range s.Decks {
+		input.Decks = append(input.Decks, IDOnly{ID: deck.ID})
+	}
+	return input
+}
+
 // SourceListItem represents a source without related decks, as represented in list.
 type SourceListItem struct {
 	ID   int64  `json:"id"`
@@ -24,9 +35,9 @@ type SourceListItem struct {
 }
 
 // ListSources retrieves all sources
-func ListSources(db *sql.DB) ([]SourceListItem, error) {
+func ListSources(ctx context.Context, db *sql.DB) ([]SourceListItem, error) {
 	var sources []SourceListItem
-	rows, err := db.Query("SELECT id, name FROM source")
+	rows, err := conn(ctx, db).QueryContext(ctx, "SELECT id, name FROM source WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, err
 	}
@@ -46,21 +57,21 @@ func ListSources(db *sql.DB) ([]SourceListItem, error) {
 }
 
 // GetSourceByID retrieves a single source by ID
-func GetSourceByID(db *sql.DB, id int64) (*Source, error) {
+func GetSourceByID(ctx context.Context, db *sql.DB, id int64) (*Source, error) {
 	var src Source
 
 	// Fetch the source
-	row := db.QueryRow("SELECT id, name FROM source WHERE id = $1", id)
-	if err := row.Scan(&src.ID, &src.Name); err != nil {
+	row := conn(ctx, db).QueryRowContext(ctx, "SELECT id, name, version, updated_at FROM source WHERE id = $1 AND deleted_at IS NULL", id)
+	if err := row.Scan(&src.ID, &src.Name, &src.Version, &src.UpdatedAt); err != nil {
 		return nil, err
 	}
 
 	// Fetch related decks
-	rows, err := db.Query(`
+	rows, err := conn(ctx, db).QueryContext(ctx, `
 		SELECT d.id, d.name, d.description
 		FROM deck d
 		INNER JOIN deck_source ds ON ds.deck = d.id
-		WHERE ds.source = $1
+		WHERE ds.source = $1 AND d.deleted_at IS NULL
 	`, id)
 	if err != nil {
 		return nil, err
@@ -82,10 +93,10 @@ func GetSourceByID(db *sql.DB, id int64) (*Source, error) {
 }
 
 // CreateSource inserts a new source
-func CreateSource(db *sql.DB, input SourceInput) (*int64, error) {
-	query := "INSERT INTO source (name) VALUES ($1) RETURNING id"
+func CreateSource(ctx context.Context, db *sql.DB, input SourceInput) (*int64, error) {
+	query := "INSERT INTO source (name, updated_at) VALUES ($1, CURRENT_TIMESTAMP) RETURNING id"
 	var id int64
-	err := db.QueryRow(query, input.Name).Scan(&id)
+	err := conn(ctx, db).QueryRowContext(ctx, query, input.Name).Scan(&id)
 	if err != nil {
 		return nil, err
 	}
@@ -93,32 +104,18 @@ func CreateSource(db *sql.DB, input SourceInput) (*int64, error) {
 	return &id, nil
 }
 
-// UpdateSource updates an existing source
-func UpdateSource(db *sql.DB, sourceID int64, input SourceInput) (*Source, error) {
-	query := "UPDATE source SET name = $1 WHERE id = $2"
-	res, err := db.Exec(query, input.Name, sourceID)
+// UpdateSource updates an existing source, conditionally if version is not 0
+func UpdateSource(ctx context.Context, db *sql.DB, sourceID int64, input SourceInput, version int64) error {
+	query, args := ifVersion("UPDATE source SET name = $1, "+bumpVersion+" WHERE id = $2 AND deleted_at IS NULL",
+		[]any{input.Name, sourceID}, version)
+	res, err := conn(ctx, db).ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return nil, err
-	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
-	}
-
-	updated := &Source{
-		ID:   sourceID,
-		Name: input.Name,
-	}
-
-	return updated, nil
+	return checkWritten(res, version)
 }
 
-// DeleteSource deletes a source by ID
-func DeleteSource(db *sql.DB, id int64) error {
-	query := "DELETE FROM source WHERE id = $1"
-	_, err := db.Exec(query, id)
-	return err
+// DeleteSource moves a source to the trash
+func DeleteSource(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return trashRow(ctx, conn(ctx, db), "source", id, version)
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 )
 
@@ -21,11 +22,24 @@ type Spread struct {
 	UpsideDown  bool   `json:"upside_down"`
 	NumCards    int16  `json:"num_cards"`
 	Description string `json:"description,omitempty"`
+	RowVersion
+}
+
+// Input returns the spread in input form, e.g. to apply a partial update to it
+func (s *Spread) Input() SpreadInput {
+	return SpreadInput{
+		Name:        s.Name,
+		MajorArcana: s.MajorArcana,
+		MinorArcana: s.MinorArcana,
+		UpsideDown:  s.UpsideDown,
+		NumCards:    s.NumCards,
+		Description: s.Description,
+	}
 }
 
 // ListSpreads retrieves all spreads
-func ListSpreads(db *sql.DB) ([]Spread, error) {
-	rows, err := db.Query(`SELECT id, name, major_arcana, minor_arcana, upside_down, num_cards, description FROM spread`)
+func ListSpreads(ctx context.Context, db *sql.DB) ([]Spread, error) {
+	rows, err := conn(ctx, db).QueryContext(ctx, `SELECT id, name, major_arcana, minor_arcana, upside_down, num_cards, description FROM spread`)
 	if err != nil {
 		return nil, err
 	}
@@ -43,61 +57,44 @@ func ListSpreads(db *sql.DB) ([]Spread, error) {
 }
 
 // GetSpreadByID retrieves a single spread by ID
-func GetSpreadByID(db *sql.DB, id int64) (*Spread, error) {
+func GetSpreadByID(ctx context.Context, db *sql.DB, id int64) (*Spread, error) {
 	var s Spread
-	row := db.QueryRow(`SELECT id, name, major_arcana, minor_arcana, upside_down, num_cards, description FROM spread WHERE id = $1`, id)
-	if err := row.Scan(&s.ID, &s.Name, &s.MajorArcana, &s.MinorArcana, &s.UpsideDown, &s.NumCards, &s.Description); err != nil {
+	row := conn(ctx, db).QueryRowContext(ctx, `SELECT id, name, major_arcana, minor_arcana, upside_down, num_cards, description, version, updated_at FROM spread WHERE id = $1`, id)
+	if err := row.Scan(&s.ID, &s.Name, &s.MajorArcana, &s.MinorArcana, &s.UpsideDown, &s.NumCards, &s.Description, &s.Version, &s.UpdatedAt); err != nil {
 		return nil, err
 	}
 	return &s, nil
 }
 
 // CreateSpread inserts a new spread
-func CreateSpread(db *sql.DB, s SpreadInput) (*int64, error) {
+func CreateSpread(ctx context.Context, db *sql.DB, s SpreadInput) (*int64, error) {
 	query := `
-	INSERT INTO spread (name, major_arcana, minor_arcana, upside_down, num_cards, description)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	INSERT INTO spread (name, major_arcana, minor_arcana, upside_down, num_cards, description, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
 	RETURNING id`
 	var id int64
-	if err := db.QueryRow(query, s.Name, s.MajorArcana, s.MinorArcana, s.UpsideDown, s.NumCards, s.Description).Scan(&id); err != nil {
+	if err := conn(ctx, db).QueryRowContext(ctx, query, s.Name, s.MajorArcana, s.MinorArcana, s.UpsideDown, s.NumCards, s.Description).Scan(&id); err != nil {
 		return nil, err
 	}
 	return &id, nil
 }
 
-// UpdateSpread updates an existing spread
-func UpdateSpread(db *sql.DB, spreadID int64, s SpreadInput) (*Spread, error) {
-	res, err := db.Exec(`
+// UpdateSpread updates an existing spread, conditionally if version is not 0
+func UpdateSpread(ctx context.Context, db *sql.DB, spreadID int64, s SpreadInput, version int64) error {
+	query, args := ifVersion(`
 	UPDATE spread
-	SET name = $1, major_arcana = $2, minor_arcana = $3, upside_down = $4, num_cards = $5, description = $6
+	SET name = $1, major_arcana = $2, minor_arcana = $3, upside_down = $4, num_cards = $5, description = $6,
+		`+bumpVersion+`
 	WHERE id = $7`,
-		s.Name, s.MajorArcana, s.MinorArcana, s.UpsideDown, s.NumCards, s.Description, spreadID)
+		[]any{s.Name, s.MajorArcana, s.MinorArcana, s.UpsideDown, s.NumCards, s.Description, spreadID}, version)
+	res, err := conn(ctx, db).ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return nil, err
-	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
-	}
-
-	updated := &Spread{
-		ID:          spreadID,
-		Name:        s.Name,
-		MajorArcana: s.MajorArcana,
-		MinorArcana: s.MinorArcana,
-		UpsideDown:  s.UpsideDown,
-		NumCards:    s.NumCards,
-		Description: s.Description,
-	}
-
-	return updated, err
+	return checkWritten(res, version)
 }
 
 // DeleteSpread deletes a spread by ID
-func DeleteSpread(db *sql.DB, id int64) error {
-	_, err := db.Exec("DELETE FROM spread WHERE id = $1", id)
-	return err
+func DeleteSpread(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return deleteRow(ctx, conn(ctx, db), "spread", id, version)
 }
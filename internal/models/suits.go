@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 )
 
@@ -9,17 +10,25 @@ type Suit struct {
 	Name        string `json:"name"`
 	Genitive    string `json:"genitive"`
 	Description string `json:"description,omitempty"`
+	Slug        string `json:"slug,omitempty" example:"wands"` // {suit} of image templates
+	RowVersion
 }
 
 type SuitInput struct {
 	Name        string `json:"name"`
 	Genitive    string `json:"genitive"`
 	Description string `json:"description,omitempty"`
+	Slug        string `json:"slug,omitempty" example:"wands"`
+}
+
+// Input returns the suit in input form, e.g. to apply a partial update to it
+func (s *Suit) Input() SuitInput {
+	return SuitInput{Name: s.Name, Genitive: s.Genitive, Description: s.Description, Slug: s.Slug}
 }
 
 // ListSuits retrieves all suits
-func ListSuits(db *sql.DB) ([]Suit, error) {
-	rows, err := db.Query(`SELECT id, name, genitive, COALESCE(description, '') FROM suit ORDER BY id`)
+func ListSuits(ctx context.Context, db *sql.DB) ([]Suit, error) {
+	rows, err := conn(ctx, db).QueryContext(ctx, `SELECT id, name, genitive, COALESCE(description, ''), slug FROM suit WHERE deleted_at IS NULL ORDER BY id`)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +37,7 @@ func ListSuits(db *sql.DB) ([]Suit, error) {
 	var suits []Suit
 	for rows.Next() {
 		var s Suit
-		if err := rows.Scan(&s.ID, &s.Name, &s.Genitive, &s.Description); err != nil {
+		if err := rows.Scan(&s.ID, &s.Name, &s.Genitive, &s.Description, &s.Slug); err != nil {
 			return nil, err
 		}
 		suits = append(suits, s)
@@ -37,56 +46,41 @@ func ListSuits(db *sql.DB) ([]Suit, error) {
 }
 
 // GetSuitByID retrieves a single suit by ID
-func GetSuitByID(db *sql.DB, id int64) (*Suit, error) {
+func GetSuitByID(ctx context.Context, db *sql.DB, id int64) (*Suit, error) {
 	var s Suit
-	row := db.QueryRow(`SELECT id, name, genitive, COALESCE(description, '') FROM suit WHERE id = $1`, id)
-	if err := row.Scan(&s.ID, &s.Name, &s.Genitive, &s.Description); err != nil {
+	row := conn(ctx, db).QueryRowContext(ctx, `SELECT id, name, genitive, COALESCE(description, ''), slug, version, updated_at FROM suit WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err := row.Scan(&s.ID, &s.Name, &s.Genitive, &s.Description, &s.Slug, &s.Version, &s.UpdatedAt); err != nil {
 		return nil, err
 	}
 	return &s, nil
 }
 
 // CreateSuit inserts a new suit
-func CreateSuit(db *sql.DB, s SuitInput) (*int64, error) {
+func CreateSuit(ctx context.Context, db *sql.DB, s SuitInput) (*int64, error) {
 	var id int64
-	if err := db.QueryRow(
-		`INSERT INTO suit (name, genitive, description) VALUES ($1, $2, $3) RETURNING id`,
-		s.Name, s.Genitive, s.Description,
+	if err := conn(ctx, db).QueryRowContext(ctx,
+		`INSERT INTO suit (name, genitive, description, slug, updated_at) VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP) RETURNING id`,
+		s.Name, s.Genitive, s.Description, s.Slug,
 	).Scan(&id); err != nil {
 		return nil, err
 	}
 	return &id, nil
 }
 
-// UpdateSuit updates an existing suit
-func UpdateSuit(db *sql.DB, suitID int64, s SuitInput) (*Suit, error) {
-	res, err := db.Exec(
-		`UPDATE suit SET name = $1, genitive = $2, description = $3 WHERE id = $4`,
-		s.Name, s.Genitive, s.Description, suitID,
+// UpdateSuit updates an existing suit, conditionally if version is not 0
+func UpdateSuit(ctx context.Context, db *sql.DB, suitID int64, s SuitInput, version int64) error {
+	query, args := ifVersion(
+		`UPDATE suit SET name = $1, genitive = $2, description = $3, slug = $4, `+bumpVersion+` WHERE id = $5 AND deleted_at IS NULL`,
+		[]any{s.Name, s.Genitive, s.Description, s.Slug, suitID}, version,
 	)
+	res, err := conn(ctx, db).ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
-	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return nil, err
-	}
-	if rowsAffected == 0 {
-		return nil, sql.ErrNoRows
+		return err
 	}
-
-	updated := &Suit{
-		ID:          suitID,
-		Name:        s.Name,
-		Genitive:    s.Genitive,
-		Description: s.Description,
-	}
-
-	return updated, nil
+	return checkWritten(res, version)
 }
 
-// DeleteSuit deletes a suit by ID
-func DeleteSuit(db *sql.DB, id int64) error {
-	_, err := db.Exec(`DELETE FROM suit WHERE id = $1`, id)
-	return err
+// DeleteSuit moves a suit to the trash with its Minor Arcana cards
+func DeleteSuit(ctx context.Context, db *sql.DB, id int64, version int64) error {
+	return trashWithMinorCards(ctx, db, "suit", id, version)
 }
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attribute keys shared by model operations
+const (
+	attrDeckID   = "tarot.deck_id"
+	attrRowCount = "db.response.returned_rows"
+)
+
+func tracer() trace.Tracer {
+	return otel.Tracer("github.com/ilbagatto/tarot-api/internal/models")
+}
+
+// startSpan opens a span around a model operation.
+// SQL statements executed with the returned context become its children.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "models."+name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the number of returned rows and the error, if any.
+// sql.ErrNoRows is an expected outcome and is not marked as a failure.
+func endSpan(span trace.Span, rows int, err error) {
+	span.SetAttributes(attribute.Int(attrRowCount, rows))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,273 @@
+package models
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Entities that are moved to the trash when deleted
+const (
+	TrashDeck      = "deck"
+	TrashSource    = "source"
+	TrashCardMajor = "card_major"
+	TrashCardMinor = "card_minor"
+	TrashSuit      = "suit"
+	TrashRank      = "rank"
+)
+
+// TrashEntities lists the entities kept in the trash
+var TrashEntities = []string{TrashDeck, TrashSource, TrashCardMajor, TrashCardMinor, TrashSuit, TrashRank}
+
+// ErrParentInTrash is returned by a write that would put a card into a deck,
+// suit or rank in the trash, or take it out of the trash while one of those
+// stays there
+var ErrParentInTrash = errors.New("parent is in the trash")
+
+// TrashItem is a soft-deleted entity
+type TrashItem struct {
+	Entity    string    `json:"entity" example:"deck"`
+	ID        int64     `json:"id"`
+	Name      string    `json:"name" example:"Tarot de Marseille"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// trashTable describes how the trashed rows of an entity are stored
+type trashTable struct {
+	table string // table holding deleted_at
+	// query selects id, name and deleted_at of the rows of the entity as t
+	query string
+	// arcana restricts the rows of the card table to one arcana
+	arcana string
+	// cards is set for a suit or rank, whose Minor Arcana cards go to the
+	// trash with it: the card_minor column referring to it. other is the
+	// column referring to the other parent of the cards.
+	cards, other string
+}
+
+var trashTables = map[string]trashTable{
+	TrashDeck:   {table: "deck", query: `SELECT t.id, t.name, t.deleted_at FROM deck t`},
+	TrashSource: {table: "source", query: `SELECT t.id, t.name, t.deleted_at FROM source t`},
+	TrashCardMajor: {table: "card", arcana: "major", query: `
+		SELECT t.id, m.name, t.deleted_at
+		FROM card t
+		JOIN card_major m ON m.card = t.id`},
+	TrashCardMinor: {table: "card", arcana: "minor", query: `
+		SELECT t.id, r.name || ' ' || s.genitive, t.deleted_at
+		FROM card t
+		JOIN card_minor m ON m.card = t.id
+		JOIN rank r ON r.id = m.rank
+		JOIN suit s ON s.id = m.suit`},
+	TrashSuit: {table: "suit", cards: "suit", other: "rank", query: `SELECT t.id, t.name, t.deleted_at FROM suit t`},
+	TrashRank: {table: "rank", cards: "rank", other: "suit", query: `SELECT t.id, t.name, t.deleted_at FROM rank t`},
+}
+
+// where returns the condition selecting the trashed row $1 of the entity
+func (t trashTable) where() string {
+	where := " WHERE id = $1 AND deleted_at IS NOT NULL"
+	if t.arcana != "" {
+		where += " AND arcana = '" + t.arcana + "'"
+	}
+	return where
+}
+
+// listTrash returns the trashed rows of entity matching condition, which may refer to $1
+func listTrash(ctx context.Context, db *sql.DB, entity, condition string, args ...any) ([]TrashItem, error) {
+	t, ok := trashTables[entity]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	rows, err := conn(ctx, db).QueryContext(ctx, t.query+" WHERE t.deleted_at IS NOT NULL"+condition, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []TrashItem
+	for rows.Next() {
+		item := TrashItem{Entity: entity}
+		if err := rows.Scan(&item.ID, &item.Name, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		item.DeletedAt = item.DeletedAt.UTC()
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// ListTrash returns the trashed entities, most recently deleted first
+// (then by entity and ID).
+// An empty entity lists all of them.
+func ListTrash(ctx context.Context, db *sql.DB, entity string) (items []TrashItem, err error) {
+	ctx, span := startSpan(ctx, "ListTrash")
+	defer func() { endSpan(span, len(items), err) }()
+
+	entities := TrashEntities
+	if entity != "" {
+		entities = []string{entity}
+	}
+	items = []TrashItem{}
+	for _, entity := range entities {
+		list, err := listTrash(ctx, db, entity, "")
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, list...)
+	}
+	slices.SortFunc(items, func(a, b TrashItem) int {
+		return cmp.Or(b.DeletedAt.Compare(a.DeletedAt), cmp.Compare(a.Entity, b.Entity), cmp.Compare(a.ID, b.ID))
+	})
+	return items, nil
+}
+
+// GetTrashItem returns the trashed entity id
+func GetTrashItem(ctx context.Context, db *sql.DB, entity string, id int64) (*TrashItem, error) {
+	items, err := listTrash(ctx, db, entity, " AND t.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &items[0], nil
+}
+
+// RestoreTrashItem takes the entity id out of the trash, a suit or rank with
+// the cards trashed along with it. Like an update, it increments the version.
+func RestoreTrashItem(ctx context.Context, db *sql.DB, entity string, id int64) error {
+	t, ok := trashTables[entity]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if t.table == "card" {
+		if err := checkCardParentsNotTrashed(ctx, tx, id); err != nil {
+			return err
+		}
+	}
+	if t.cards != "" {
+		// A card whose other parent is in the trash as well stays there, now
+		// along with that parent
+		query := "UPDATE card SET deleted_at = (" + `
+				SELECT o.deleted_at FROM card_minor m
+				JOIN ` + t.other + ` o ON o.id = m.` + t.other + `
+				WHERE m.card = card.id), ` + bumpVersion + `
+			WHERE deleted_at = (SELECT deleted_at FROM ` + t.table + ` WHERE id = $1)
+			AND id IN (SELECT card FROM card_minor WHERE ` + t.cards + ` = $1)`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return err
+		}
+	}
+	res, err := tx.ExecContext(ctx, "UPDATE "+t.table+" SET deleted_at = NULL, "+bumpVersion+t.where(), id)
+	if err != nil {
+		return err
+	}
+	if err := checkWritten(res, 0); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// checkCardParentsNotTrashed fails with ErrParentInTrash if the deck of the
+// card id, or the suit or rank of a Minor Arcana card, is in the trash
+func checkCardParentsNotTrashed(ctx context.Context, db dbConn, id int64) error {
+	var deckID int64
+	var suitID, rankID sql.NullInt64
+	err := db.QueryRowContext(ctx, `
+		SELECT c.deck, m.suit, m.rank
+		FROM card c
+		LEFT JOIN card_minor m ON m.card = c.id
+		WHERE c.id = $1`, id).Scan(&deckID, &suitID, &rankID)
+	if err != nil {
+		return err
+	}
+	if err := checkNotTrashed(ctx, db, "deck", deckID); err != nil {
+		return err
+	}
+	if suitID.Valid {
+		return checkSuitRankNotTrashed(ctx, db, CardMinorInput{SuitID: suitID.Int64, RankID: rankID.Int64})
+	}
+	return nil
+}
+
+// checkNotTrashed fails with ErrParentInTrash if the row id of table (deck,
+// suit or rank) is in the trash; a missing row is left to the foreign keys
+func checkNotTrashed(ctx context.Context, db dbConn, table string, id int64) error {
+	var trashed bool
+	err := db.QueryRowContext(ctx, "SELECT deleted_at IS NOT NULL FROM "+table+" WHERE id = $1", id).Scan(&trashed)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	case err != nil:
+		return err
+	case trashed:
+		return fmt.Errorf("%w: %s %d", ErrParentInTrash, table, id)
+	}
+	return nil
+}
+
+// PurgeTrashItem deletes the trashed entity id for good, with everything
+// that cascades from it and, for a suit or rank, its Minor Arcana cards
+func PurgeTrashItem(ctx context.Context, db *sql.DB, entity string, id int64) error {
+	t, ok := trashTables[entity]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	tx, err := beginTx(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if t.cards != "" {
+		var trashed bool
+		if err := tx.QueryRowContext(ctx, "SELECT true FROM "+t.table+t.where(), id).Scan(&trashed); err != nil {
+			return err
+		}
+		// The cards do not cascade from it
+		query := "DELETE FROM card WHERE id IN (SELECT card FROM card_minor WHERE " + t.cards + " = $1)"
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return err
+		}
+	}
+	res, err := tx.ExecContext(ctx, "DELETE FROM "+t.table+t.where(), id)
+	if err != nil {
+		return err
+	}
+	if err := checkWritten(res, 0); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// PurgeTrash deletes the entities trashed before the given time for good and
+// returns them. Cards go first, so that the ones of a purged deck, suit or
+// rank are not removed along with it before they are reported.
+func PurgeTrash(ctx context.Context, db *sql.DB, before time.Time) (purged []TrashItem, err error) {
+	ctx, span := startSpan(ctx, "PurgeTrash")
+	defer func() { endSpan(span, len(purged), err) }()
+
+	purged = []TrashItem{}
+	for _, entity := range []string{TrashCardMajor, TrashCardMinor, TrashDeck, TrashSource, TrashSuit, TrashRank} {
+		items, err := listTrash(ctx, db, entity, " AND t.deleted_at < $1", before.UTC())
+		if err != nil {
+			return purged, err
+		}
+		for _, item := range items {
+			if err := PurgeTrashItem(ctx, db, entity, item.ID); err != nil {
+				return purged, err
+			}
+			purged = append(purged, item)
+		}
+	}
+	return purged, nil
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txKey struct{}
+
+// dbConn runs statements and queries, on the pool or within a transaction
+type dbConn interface {
+	execer
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// InTx runs fn in a transaction on db, which is committed if fn succeeds and
+// rolled back otherwise. The functions of this package called with the
+// context fn gets join the transaction rather than using the pool. Called
+// with such a context, InTx joins the transaction as well.
+func InTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// conn returns the transaction ctx joins (see InTx), or else db
+func conn(ctx context.Context, db *sql.DB) dbConn {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// txn is a transaction begun by beginTx
+type txn struct {
+	*sql.Tx
+	ctx       context.Context
+	savepoint bool // a savepoint within the transaction ctx joins
+	done      bool
+}
+
+// beginTx begins a transaction on db. Within the transaction ctx joins, it
+// sets a savepoint instead, so that a rollback undoes only what follows it.
+func beginTx(ctx context.Context, db *sql.DB) (*txn, error) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	if !ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &txn{Tx: tx, ctx: ctx}, nil
+	}
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT nested_tx"); err != nil {
+		return nil, err
+	}
+	return &txn{Tx: tx, ctx: ctx, savepoint: true}, nil
+}
+
+// Commit commits the transaction, or releases the savepoint
+func (t *txn) Commit() error {
+	if !t.savepoint {
+		return t.Tx.Commit()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.ExecContext(t.ctx, "RELEASE SAVEPOINT nested_tx")
+	return err
+}
+
+// Rollback rolls back the transaction, or to the savepoint. Like that of
+// sql.Tx, it does nothing after Commit, which allows deferring it.
+func (t *txn) Rollback() error {
+	if !t.savepoint {
+		return t.Tx.Rollback()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	if _, err := t.ExecContext(t.ctx, "ROLLBACK TO SAVEPOINT nested_tx"); err != nil {
+		return err
+	}
+	_, err := t.ExecContext(t.ctx, "RELEASE SAVEPOINT nested_tx")
+	return err
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrVersionMismatch is returned by a conditional update or delete when the
+// row is no longer at the expected version, i.e. it was changed meanwhile
+var ErrVersionMismatch = errors.New("row version mismatch")
+
+// RowVersion tracks the changes of a row: Version starts at 1 and is
+// incremented by every update, UpdatedAt is the time of the last write.
+// It is not part of the JSON form; the API sends it in the ETag.
+type RowVersion struct {
+	Version   int64     `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Row returns the version of a resource embedding RowVersion
+func (v RowVersion) Row() RowVersion {
+	return v
+}
+
+// SetRow replaces the version of a resource embedding RowVersion
+func (v *RowVersion) SetRow(row RowVersion) {
+	*v = row
+}
+
+// bumpVersion is part of the SET clause of every update of a versioned row
+const bumpVersion = "version = version + 1, updated_at = CURRENT_TIMESTAMP"
+
+// ifVersion restricts a write of a single row to the given version by adding
+// a condition on it; version 0 leaves the write unconditional
+func ifVersion(query string, args []any, version int64) (string, []any) {
+	if version == 0 {
+		return query, args
+	}
+	return query + fmt.Sprintf(" AND version = $%d", len(args)+1), append(args, version)
+}
+
+// checkWritten reports a write that changed no row: as ErrVersionMismatch when
+// it was conditional, as sql.ErrNoRows otherwise
+func checkWritten(res sql.Result, version int64) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		if version != 0 {
+			return ErrVersionMismatch
+		}
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// execer runs statements, on the pool or within a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// deleteRow deletes the row id of table, if it meets the conditions given. A
+// missing row is reported as sql.ErrNoRows, or as ErrVersionMismatch by a
+// conditional delete.
+func deleteRow(ctx context.Context, db execer, table string, id int64, version int64, conds ...string) error {
+	query := "DELETE FROM " + table + " WHERE id = $1"
+	for _, cond := range conds {
+		query += " AND " + cond
+	}
+	query, args := ifVersion(query, []any{id}, version)
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return checkWritten(res, version)
+}
+
+// trashRow soft-deletes the row id of table by setting its deleted_at, with
+// the semantics of deleteRow; a row already in the trash counts as missing.
+// The row stays in the trash until it is restored or purged.
+func trashRow(ctx context.Context, db execer, table string, id int64, version int64) error {
+	query, args := ifVersion("UPDATE "+table+" SET deleted_at = CURRENT_TIMESTAMP, "+bumpVersion+
+		" WHERE id = $1 AND deleted_at IS NULL", []any{id}, version)
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return checkWritten(res, version)
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type audit struct{ s *Store }
+
+func (r audit) Record(ctx context.Context, entry models.AuditEntry) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	entry.ID = r.s.nextID("audit_log")
+	entry.At = entry.At.UTC()
+	r.s.auditLog = append(r.s.auditLog, entry)
+	return nil
+}
+
+func (r audit) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	list := []models.AuditEntry{}
+	for i := len(r.s.auditLog) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(list) == filter.Limit {
+			break
+		}
+		e := r.s.auditLog[i]
+		if (filter.Entity != "" && e.Entity != filter.Entity) ||
+			(filter.EntityID != 0 && e.EntityID != filter.EntityID) ||
+			(filter.Actor != "" && e.Actor != filter.Actor) ||
+			(!filter.From.IsZero() && e.At.Before(filter.From)) ||
+			(!filter.To.IsZero() && !e.At.Before(filter.To)) {
+			continue
+		}
+		list = append(list, e)
+	}
+	return list, nil
+}
+
+// transactor runs fn as it is: the store applies every write at once and
+// cannot roll it back. Recording in its audit log never fails, so a write is
+// not left without its entry.
+type transactor struct{}
+
+func (transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"maps"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+// snapshot holds copies of the tables written by batches
+type snapshot struct {
+	seq            map[string]int64
+	cards          map[int64]cardRow
+	meaningsMajor  map[int64]models.MeaningMajor
+	meaningsMinor  map[int64]models.MeaningMinor
+	revisionsMajor map[int64][]models.MeaningMajorRevision
+	revisionsMinor map[int64][]models.MeaningMinorRevision
+	versions       map[rowKey]models.RowVersion
+}
+
+func (s *Store) snapshot() snapshot {
+	return snapshot{
+		seq:            maps.Clone(s.seq),
+		cards:          maps.Clone(s.cards),
+		meaningsMajor:  maps.Clone(s.meaningsMajor),
+		meaningsMinor:  maps.Clone(s.meaningsMinor),
+		revisionsMajor: maps.Clone(s.revisionsMajor),
+		revisionsMinor: maps.Clone(s.revisionsMinor),
+		versions:       maps.Clone(s.versions),
+	}
+}
+
+func (s *Store) restore(snap snapshot) {
+	s.seq = snap.seq
+	s.cards = snap.cards
+	s.meaningsMajor = snap.meaningsMajor
+	s.meaningsMinor = snap.meaningsMinor
+	s.revisionsMajor = snap.revisionsMajor
+	s.revisionsMinor = snap.revisionsMinor
+	s.versions = snap.versions
+}
+
+// batch writes n items like a transaction does, item i by write, which must
+// change nothing when it fails. With atomic set, a failing item undoes the
+// whole batch. The caller holds the lock.
+func (s *Store) batch(n int, atomic bool, write func(i int) (int64, bool, error)) []models.BatchResult {
+	var saved snapshot
+	if atomic {
+		saved = s.snapshot()
+	}
+
+	results := make([]models.BatchResult, n)
+	failed := false
+	for i := range n {
+		id, created, err := write(i)
+		if err != nil {
+			results[i].Err = err
+			failed = true
+			continue
+		}
+		results[i] = models.BatchResult{ID: id, Created: created}
+	}
+
+	if atomic && failed {
+		s.restore(saved)
+		for i := range results {
+			results[i].ID = 0
+		}
+	}
+	return results
+}
@@ -0,0 +1,403 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type cards struct{ s *Store }
+
+func (r cards) ListMajor(ctx context.Context, deckID int64) ([]models.CardMajor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.CardMajor
+	for _, id := range sortedIDs(r.s.cards) {
+		if card := r.s.cards[id]; card.arcana == "major" && card.deck == deckID && r.s.cardVisible(id) {
+			list = append(list, r.s.majorCard(id))
+		}
+	}
+	slices.SortStableFunc(list, func(a, b models.CardMajor) int { return cmp.Compare(a.Number, b.Number) })
+	return list, nil
+}
+
+func (r cards) GetMajor(ctx context.Context, id int64) (*models.CardMajor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if card, ok := r.s.cards[id]; !ok || card.arcana != "major" || !r.s.cardVisible(id) {
+		return nil, sql.ErrNoRows
+	}
+	card := r.s.majorCard(id)
+	card.RowVersion = r.s.rowVersion("card", id)
+
+	var meanings []models.MeaningMajor
+	for _, m := range r.s.meaningsMajor {
+		if m.Number == card.Number && r.s.deckUsesSource(card.DeckID, m.Source) && r.s.majorMeaningVisible(m) {
+			meanings = append(meanings, m)
+		}
+	}
+	slices.SortFunc(meanings, func(a, b models.MeaningMajor) int {
+		return cmp.Or(cmp.Compare(a.Source, b.Source), cmp.Compare(positionOrder(a.Position), positionOrder(b.Position)))
+	})
+	for _, m := range meanings {
+		card.Meanings = append(card.Meanings, models.MeaningRef{ID: m.ID, Position: string(m.Position), SourceID: m.Source})
+	}
+	return &card, nil
+}
+
+func (r cards) CreateMajor(ctx context.Context, input models.CardMajorInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	id, err := r.s.createMajorCard(input)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r cards) UpdateMajor(ctx context.Context, id int64, input models.CardMajorInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.updateMajorCard(id, input, version)
+}
+
+func (r cards) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("card", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.cards[id]; !ok || r.s.trashed("card", id) {
+		return sql.ErrNoRows
+	}
+	r.s.moveToTrash("card", id)
+	return nil
+}
+
+func (r cards) BatchMajor(ctx context.Context, items []models.CardMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.batch(len(items), atomic, func(i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := r.s.createMajorCard(item.CardMajorInput)
+			return id, true, err
+		}
+		return item.ID, false, r.s.updateMajorCard(item.ID, item.CardMajorInput, item.Version)
+	}), nil
+}
+
+func (r cards) ListMinor(ctx context.Context, deckID int64) ([]models.CardMinor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.CardMinor
+	for _, id := range sortedIDs(r.s.cards) {
+		if card := r.s.cards[id]; card.arcana == "minor" && card.deck == deckID && r.s.cardVisible(id) {
+			list = append(list, r.s.minorCard(id))
+		}
+	}
+	slices.SortStableFunc(list, func(a, b models.CardMinor) int {
+		return cmp.Or(cmp.Compare(a.SuitID, b.SuitID), cmp.Compare(a.RankID, b.RankID))
+	})
+	return list, nil
+}
+
+func (r cards) GetMinor(ctx context.Context, id int64) (*models.CardMinor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if card, ok := r.s.cards[id]; !ok || card.arcana != "minor" || !r.s.cardVisible(id) {
+		return nil, sql.ErrNoRows
+	}
+	card := r.s.minorCard(id)
+	card.RowVersion = r.s.rowVersion("card", id)
+
+	var meanings []models.MeaningMinor
+	for _, m := range r.s.meaningsMinor {
+		if m.Suit == card.SuitID && m.Rank == card.RankID && r.s.deckUsesSource(card.DeckID, m.Source) && r.s.minorMeaningVisible(m) {
+			meanings = append(meanings, m)
+		}
+	}
+	slices.SortFunc(meanings, func(a, b models.MeaningMinor) int {
+		return cmp.Or(cmp.Compare(a.Source, b.Source), cmp.Compare(positionOrder(a.Position), positionOrder(b.Position)))
+	})
+	for _, m := range meanings {
+		card.Meanings = append(card.Meanings, models.MeaningRef{ID: m.ID, Position: string(m.Position), SourceID: m.Source})
+	}
+	return &card, nil
+}
+
+func (r cards) CreateMinor(ctx context.Context, input models.CardMinorInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	id, err := r.s.createMinorCard(input)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r cards) UpdateMinor(ctx context.Context, id int64, input models.CardMinorInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.updateMinorCard(id, input, version)
+}
+
+func (r cards) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("card", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.cards[id]; !ok || r.s.trashed("card", id) {
+		return sql.ErrNoRows
+	}
+	r.s.moveToTrash("card", id)
+	return nil
+}
+
+func (r cards) BatchMinor(ctx context.Context, items []models.CardMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.batch(len(items), atomic, func(i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := r.s.createMinorCard(item.CardMinorInput)
+			return id, true, err
+		}
+		return item.ID, false, r.s.updateMinorCard(item.ID, item.CardMinorInput, item.Version)
+	}), nil
+}
+
+func (r cards) ListImages(ctx context.Context, deckID int64) ([]models.CardImagePath, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.decks[deckID]; !ok || r.s.trashed("deck", deckID) {
+		return nil, sql.ErrNoRows
+	}
+	var ids []int64
+	for _, id := range sortedIDs(r.s.cards) {
+		if r.s.cards[id].deck == deckID && r.s.cardVisible(id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortStableFunc(ids, func(a, b int64) int {
+		x, y := r.s.cards[a], r.s.cards[b]
+		return cmp.Or(cmp.Compare(x.arcana, y.arcana), cmp.Compare(x.number, y.number),
+			cmp.Compare(x.suit, y.suit), cmp.Compare(x.rank, y.rank))
+	})
+
+	images := []models.CardImagePath{}
+	for _, id := range ids {
+		_, stored := r.s.images[id]
+		path := r.s.imagePath(id)
+		images = append(images, models.CardImagePath{
+			CardID: id, Arcana: r.s.cards[id].arcana, Path: path, Templated: !stored && path != "",
+		})
+	}
+	return images, nil
+}
+
+func (r cards) Scaffold(ctx context.Context, deckID int64, input models.ScaffoldInput) (*models.ScaffoldResult, error) {
+	majors, err := input.MajorCards(deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.decks[deckID]; !ok || r.s.trashed("deck", deckID) {
+		return nil, sql.ErrNoRows
+	}
+	for id, card := range r.s.cards {
+		if card.deck == deckID && !r.s.trashed("card", id) {
+			return nil, models.ErrDeckNotEmpty
+		}
+	}
+
+	// The deck exists, so nothing below can fail
+	result := &models.ScaffoldResult{}
+	for _, card := range majors {
+		id, _ := r.s.createMajorCard(card)
+		r.s.setImage(id, input.MajorImage(card.Number))
+		result.MajorCards = append(result.MajorCards, id)
+	}
+	for _, suitID := range sortedIDs(r.s.suits) {
+		for _, rankID := range sortedIDs(r.s.ranks) {
+			if r.s.trashed("suit", suitID) || r.s.trashed("rank", rankID) {
+				continue
+			}
+			id, _ := r.s.createMinorCard(models.CardMinorInput{DeckID: deckID, SuitID: suitID, RankID: rankID})
+			r.s.setImage(id, input.MinorImage(r.s.suits[suitID], r.s.ranks[rankID]))
+			result.MinorCards = append(result.MinorCards, id)
+		}
+	}
+	return result, nil
+}
+
+func (s *Store) createMajorCard(input models.CardMajorInput) (int64, error) {
+	if err := s.checkCardDeck(input.DeckID); err != nil {
+		return 0, err
+	}
+	id := s.nextID("card")
+	s.cards[id] = cardRow{deck: input.DeckID, arcana: "major", number: input.Number, name: input.Name, orgName: input.OrgName}
+	s.touch("card", id)
+	return id, nil
+}
+
+func (s *Store) updateMajorCard(id int64, input models.CardMajorInput, version int64) error {
+	if err := s.checkVersion("card", id, version); err != nil {
+		return err
+	}
+
+	if card, ok := s.cards[id]; !ok || card.arcana != "major" || s.trashed("card", id) {
+		return sql.ErrNoRows
+	}
+	if err := s.checkCardDeck(input.DeckID); err != nil {
+		return err
+	}
+	s.cards[id] = cardRow{deck: input.DeckID, arcana: "major", number: input.Number, name: input.Name, orgName: input.OrgName}
+	s.touch("card", id)
+	return nil
+}
+
+func (s *Store) createMinorCard(input models.CardMinorInput) (int64, error) {
+	if err := s.checkMinorCardRefs(input); err != nil {
+		return 0, err
+	}
+	id := s.nextID("card")
+	s.cards[id] = cardRow{deck: input.DeckID, arcana: "minor", suit: input.SuitID, rank: input.RankID}
+	s.touch("card", id)
+	return id, nil
+}
+
+func (s *Store) updateMinorCard(id int64, input models.CardMinorInput, version int64) error {
+	if err := s.checkVersion("card", id, version); err != nil {
+		return err
+	}
+
+	if card, ok := s.cards[id]; !ok || card.arcana != "minor" || s.trashed("card", id) {
+		return sql.ErrNoRows
+	}
+	if err := s.checkMinorCardRefs(input); err != nil {
+		return err
+	}
+	s.cards[id] = cardRow{deck: input.DeckID, arcana: "minor", suit: input.SuitID, rank: input.RankID}
+	s.touch("card", id)
+	return nil
+}
+
+func (s *Store) majorCard(id int64) models.CardMajor {
+	row := s.cards[id]
+	card := models.CardMajor{Number: row.number, Name: row.name, OrgName: row.orgName}
+	card.Card = s.baseCard(id, row.name)
+	return card
+}
+
+func (s *Store) minorCard(id int64) models.CardMinor {
+	row := s.cards[id]
+	card := models.CardMinor{SuitID: row.suit, RankID: row.rank}
+	card.Card = s.baseCard(id, s.ranks[row.rank].Name+" "+s.suits[row.suit].Genitive)
+	return card
+}
+
+func (s *Store) baseCard(id int64, name string) models.Card {
+	card := models.Card{ID: id, Name: name, DeckID: s.cards[id].deck}
+	deck := s.decks[card.DeckID]
+	card.SetImage(s.imagePath(id), deck.imageWidth, deck.imageHeight)
+	return card
+}
+
+// imagePath returns the stored image path of a card or, without one, the path
+// resolved from the image template of its deck
+func (s *Store) imagePath(id int64) string {
+	if path, ok := s.images[id]; ok {
+		return path
+	}
+	row := s.cards[id]
+	deck := s.decks[row.deck]
+	if row.arcana == "major" {
+		return models.ImageTemplate(deck.majorTemplate).Resolve(models.MajorImageCard(row.number))
+	}
+	return models.ImageTemplate(deck.minorTemplate).Resolve(models.MinorImageCard(s.suits[row.suit], s.ranks[row.rank]))
+}
+
+// checkCardDeck fails unless the deck of a card exists and is not in the trash
+func (s *Store) checkCardDeck(deckID int64) error {
+	if _, ok := s.decks[deckID]; !ok {
+		return invalidReference("card_deck_fkey")
+	}
+	if s.trashed("deck", deckID) {
+		return fmt.Errorf("%w: deck %d", models.ErrParentInTrash, deckID)
+	}
+	return nil
+}
+
+func (s *Store) checkMinorCardRefs(input models.CardMinorInput) error {
+	if err := s.checkCardDeck(input.DeckID); err != nil {
+		return err
+	}
+	if _, ok := s.suits[input.SuitID]; !ok {
+		return invalidReference("card_minor_suit_fkey")
+	}
+	if _, ok := s.ranks[input.RankID]; !ok {
+		return invalidReference("card_minor_rank_fkey")
+	}
+	return s.checkCardParents(cardRow{arcana: "minor", suit: input.SuitID, rank: input.RankID})
+}
+
+// checkCardParents fails with models.ErrParentInTrash if the deck of a card,
+// or the suit or rank of a Minor Arcana card, is in the trash
+func (s *Store) checkCardParents(card cardRow) error {
+	switch {
+	case s.trashed("deck", card.deck):
+		return fmt.Errorf("%w: deck %d", models.ErrParentInTrash, card.deck)
+	case card.arcana != "minor":
+		return nil
+	case s.trashed("suit", card.suit):
+		return fmt.Errorf("%w: suit %d", models.ErrParentInTrash, card.suit)
+	case s.trashed("rank", card.rank):
+		return fmt.Errorf("%w: rank %d", models.ErrParentInTrash, card.rank)
+	}
+	return nil
+}
+
+// cardVisible reports whether a card is in the trash neither by itself
+// nor along with its deck
+func (s *Store) cardVisible(id int64) bool {
+	return !s.trashed("card", id) && !s.trashed("deck", s.cards[id].deck)
+}
+
+// setImage sets the image path of a card; an empty path sets none
+func (s *Store) setImage(cardID int64, path string) {
+	if path != "" {
+		s.images[cardID] = path
+	}
+}
+
+// deleteCard removes a card with its image
+func (s *Store) deleteCard(id int64) {
+	delete(s.cards, id)
+	delete(s.images, id)
+	delete(s.versions, rowKey{"card", id})
+	delete(s.deleted, rowKey{"card", id})
+}
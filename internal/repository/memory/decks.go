@@ -0,0 +1,204 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type decks struct{ s *Store }
+
+func (r decks) List(ctx context.Context) ([]models.DeckListItem, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.DeckListItem
+	for _, id := range sortedIDs(r.s.decks) {
+		if r.s.trashed("deck", id) {
+			continue
+		}
+		d := r.s.decks[id]
+		list = append(list, models.DeckListItem{
+			ID:            id,
+			Name:          d.name,
+			Image:         imageURL(d.image, false),
+			Thumbnail:     imageURL(d.image, true),
+			Description:   d.description,
+			HasMinorCards: r.s.hasMinorCards(id),
+		})
+	}
+	return list, nil
+}
+
+func (r decks) Get(ctx context.Context, id int64) (*models.Deck, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	d, ok := r.s.decks[id]
+	if !ok || r.s.trashed("deck", id) {
+		return nil, sql.ErrNoRows
+	}
+	deck := &models.Deck{
+		ID:                 id,
+		Name:               d.name,
+		Image:              imageURL(d.image, false),
+		Thumbnail:          imageURL(d.image, false),
+		Description:        d.description,
+		HasMinorCards:      r.s.hasMinorCards(id),
+		ImagePath:          d.image,
+		RowVersion:         r.s.rowVersion("deck", id),
+		MajorImageTemplate: d.majorTemplate,
+		MinorImageTemplate: d.minorTemplate,
+		BackImagePath:      d.backImage,
+		ImageWidth:         d.imageWidth,
+		ImageHeight:        d.imageHeight,
+	}
+	deck.SetBackImage()
+	for _, srcID := range r.s.deckSources[id] {
+		if r.s.trashed("source", srcID) {
+			continue
+		}
+		deck.Sources = append(deck.Sources, models.Source{ID: srcID, Name: r.s.sources[srcID]})
+	}
+	return deck, nil
+}
+
+func (r decks) Create(ctx context.Context, input models.DeckInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.s.deckNameTaken(input.Name, 0) {
+		return nil, duplicate("deck_name_unique_idx")
+	}
+	if err := checkDeckInput(input); err != nil {
+		return nil, err
+	}
+	sourceIDs, err := r.s.deckSourceIDs(input)
+	if err != nil {
+		return nil, err
+	}
+	id := r.s.nextID("deck")
+	r.s.decks[id] = newDeckRow(input)
+	r.s.deckSources[id] = sourceIDs
+	r.s.touch("deck", id)
+	return &id, nil
+}
+
+func (r decks) Update(ctx context.Context, id int64, input models.DeckInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("deck", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.decks[id]; !ok || r.s.trashed("deck", id) {
+		return sql.ErrNoRows
+	}
+	if r.s.deckNameTaken(input.Name, id) {
+		return duplicate("deck_name_unique_idx")
+	}
+	if err := checkDeckInput(input); err != nil {
+		return err
+	}
+	sourceIDs, err := r.s.deckSourceIDs(input)
+	if err != nil {
+		return err
+	}
+	// The deck does not show the sources in the trash, so their links are kept
+	for _, srcID := range r.s.deckSources[id] {
+		if r.s.trashed("source", srcID) && !slices.Contains(sourceIDs, srcID) {
+			sourceIDs = append(sourceIDs, srcID)
+		}
+	}
+
+	r.s.decks[id] = newDeckRow(input)
+	r.s.deckSources[id] = sourceIDs
+	r.s.touch("deck", id)
+	return nil
+}
+
+func (r decks) Delete(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("deck", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.decks[id]; !ok || r.s.trashed("deck", id) {
+		return sql.ErrNoRows
+	}
+	r.s.moveToTrash("deck", id)
+	return nil
+}
+
+// purgeDeck removes a deck with its cards
+func (s *Store) purgeDeck(id int64) {
+	delete(s.decks, id)
+	delete(s.deckSources, id)
+	delete(s.versions, rowKey{"deck", id})
+	delete(s.deleted, rowKey{"deck", id})
+	for cardID, card := range s.cards {
+		if card.deck == id {
+			s.deleteCard(cardID)
+		}
+	}
+}
+
+func newDeckRow(input models.DeckInput) deckRow {
+	return deckRow{name: input.Name, image: input.Image, description: input.Description,
+		majorTemplate: input.MajorImageTemplate, minorTemplate: input.MinorImageTemplate,
+		backImage: input.BackImage, imageWidth: input.ImageWidth, imageHeight: input.ImageHeight}
+}
+
+// checkDeckInput mirrors the CHECK constraints of the deck table
+func checkDeckInput(input models.DeckInput) error {
+	if input.ImageWidth < 0 {
+		return invalidInput("deck_image_width_check")
+	}
+	if input.ImageHeight < 0 {
+		return invalidInput("deck_image_height_check")
+	}
+	return nil
+}
+
+// deckNameTaken reports whether another deck not in the trash has name
+func (s *Store) deckNameTaken(name string, exceptID int64) bool {
+	for id, d := range s.decks {
+		if d.name == name && id != exceptID && !s.trashed("deck", id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) hasMinorCards(deckID int64) bool {
+	for id, card := range s.cards {
+		if card.deck == deckID && card.arcana == "minor" && !s.trashed("card", id) {
+			return true
+		}
+	}
+	return false
+}
+
+// deckSourceIDs returns the IDs of the sources of a deck, which must exist
+func (s *Store) deckSourceIDs(input models.DeckInput) ([]int64, error) {
+	var sourceIDs []int64
+	for _, src := range input.Sources {
+		if _, ok := s.sources[src.ID]; !ok {
+			return nil, invalidReference("deck_source_source_fkey")
+		}
+		if !slices.Contains(sourceIDs, src.ID) {
+			sourceIDs = append(sourceIDs, src.ID)
+		}
+	}
+	return sourceIDs, nil
+}
+
+// deckUsesSource reports whether a deck is linked to a source
+func (s *Store) deckUsesSource(deckID, sourceID int64) bool {
+	return slices.Contains(s.deckSources[deckID], sourceID)
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+// The store has no card combinations, so none are ever reported
+
+func (r decks) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.decks[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	var deps models.Dependents
+	for _, cardID := range sortedIDs(r.s.cards) {
+		if card := r.s.cards[cardID]; card.deck == id && !r.s.trashed("card", cardID) {
+			if card.arcana == "major" {
+				deps.MajorCards = append(deps.MajorCards, cardID)
+			} else {
+				deps.MinorCards = append(deps.MinorCards, cardID)
+			}
+		}
+	}
+	for _, srcID := range slices.Sorted(slices.Values(r.s.deckSources[id])) {
+		if !r.s.trashed("source", srcID) {
+			deps.Sources = append(deps.Sources, srcID)
+		}
+	}
+	return &deps, nil
+}
+
+func (r sources) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.sources[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	var deps models.Dependents
+	for _, mid := range sortedIDs(r.s.meaningsMajor) {
+		if m := r.s.meaningsMajor[mid]; m.Source == id && r.s.majorMeaningVisible(m) {
+			deps.MajorMeanings = append(deps.MajorMeanings, mid)
+		}
+	}
+	for _, mid := range sortedIDs(r.s.meaningsMinor) {
+		if m := r.s.meaningsMinor[mid]; m.Source == id && r.s.minorMeaningVisible(m) {
+			deps.MinorMeanings = append(deps.MinorMeanings, mid)
+		}
+	}
+	for _, deckID := range sortedIDs(r.s.decks) {
+		if r.s.deckUsesSource(deckID, id) && !r.s.trashed("deck", deckID) {
+			deps.Decks = append(deps.Decks, deckID)
+		}
+	}
+	return &deps, nil
+}
+
+func (r suits) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.suits[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	return r.s.minorArcanaDependents(func(suit, rank int64) bool { return suit == id }), nil
+}
+
+func (r ranks) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.ranks[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	return r.s.minorArcanaDependents(func(suit, rank int64) bool { return rank == id }), nil
+}
+
+func (r cards) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	if _, ok := r.s.cards[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &models.Dependents{}, nil
+}
+
+// minorArcanaDependents returns the Minor Arcana cards out of the trash and
+// the meanings of the suits and ranks matched
+func (s *Store) minorArcanaDependents(match func(suit, rank int64) bool) *models.Dependents {
+	var deps models.Dependents
+	for _, cardID := range sortedIDs(s.cards) {
+		if card := s.cards[cardID]; card.arcana == "minor" && match(card.suit, card.rank) && !s.trashed("card", cardID) {
+			deps.MinorCards = append(deps.MinorCards, cardID)
+		}
+	}
+	for _, mid := range sortedIDs(s.meaningsMinor) {
+		if m := s.meaningsMinor[mid]; match(m.Suit, m.Rank) && s.minorMeaningVisible(m) {
+			deps.MinorMeanings = append(deps.MinorMeanings, mid)
+		}
+	}
+	return &deps
+}
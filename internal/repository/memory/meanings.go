@@ -0,0 +1,363 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type meanings struct{ s *Store }
+
+func (r meanings) ListMajor(ctx context.Context, filters map[string]any) ([]models.MeaningMajor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.MeaningMajor
+	for _, id := range sortedIDs(r.s.meaningsMajor) {
+		m := r.s.meaningsMajor[id]
+		if r.s.majorMeaningVisible(m) && matches(filters, map[string]any{"number": m.Number, "position": m.Position, "source": m.Source}) {
+			list = append(list, m)
+		}
+	}
+	slices.SortStableFunc(list, func(a, b models.MeaningMajor) int {
+		return cmp.Compare(positionOrder(a.Position), positionOrder(b.Position))
+	})
+	return list, nil
+}
+
+func (r meanings) GetMajor(ctx context.Context, id int64) (*models.MeaningMajor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	m, ok := r.s.meaningsMajor[id]
+	if !ok || !r.s.majorMeaningVisible(m) {
+		return nil, sql.ErrNoRows
+	}
+	m.RowVersion = r.s.rowVersion("meaning_major", id)
+	return &m, nil
+}
+
+func (r meanings) CreateMajor(ctx context.Context, input models.MeaningMajorInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	id, err := r.s.createMajorMeaning(input)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r meanings) UpdateMajor(ctx context.Context, id int64, input models.MeaningMajorInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.updateMajorMeaning(id, input, version)
+}
+
+func (r meanings) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("meaning_major", id, version); err != nil {
+		return err
+	}
+
+	if m, ok := r.s.meaningsMajor[id]; !ok || !r.s.majorMeaningVisible(m) {
+		return sql.ErrNoRows
+	}
+	r.s.deleteMeaningMajor(id)
+	return nil
+}
+
+func (r meanings) BatchMajor(ctx context.Context, items []models.MeaningMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.batch(len(items), atomic, func(i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := r.s.createMajorMeaning(item.MeaningMajorInput)
+			return id, true, err
+		}
+		return item.ID, false, r.s.updateMajorMeaning(item.ID, item.MeaningMajorInput, item.Version)
+	}), nil
+}
+
+func (r meanings) ListMinor(ctx context.Context, filters map[string]any) ([]models.MeaningMinor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.MeaningMinor
+	for _, id := range sortedIDs(r.s.meaningsMinor) {
+		m := r.s.meaningsMinor[id]
+		if r.s.minorMeaningVisible(m) && matches(filters, map[string]any{"suit": m.Suit, "rank": m.Rank, "position": m.Position, "source": m.Source}) {
+			list = append(list, m)
+		}
+	}
+	slices.SortStableFunc(list, func(a, b models.MeaningMinor) int {
+		return cmp.Compare(positionOrder(a.Position), positionOrder(b.Position))
+	})
+	return list, nil
+}
+
+func (r meanings) GetMinor(ctx context.Context, id int64) (*models.MeaningMinor, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	m, ok := r.s.meaningsMinor[id]
+	if !ok || !r.s.minorMeaningVisible(m) {
+		return nil, sql.ErrNoRows
+	}
+	m.RowVersion = r.s.rowVersion("meaning_minor", id)
+	return &m, nil
+}
+
+func (r meanings) CreateMinor(ctx context.Context, input models.MeaningMinorInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	id, err := r.s.createMinorMeaning(input)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r meanings) UpdateMinor(ctx context.Context, id int64, input models.MeaningMinorInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.updateMinorMeaning(id, input, version)
+}
+
+func (r meanings) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("meaning_minor", id, version); err != nil {
+		return err
+	}
+
+	if m, ok := r.s.meaningsMinor[id]; !ok || !r.s.minorMeaningVisible(m) {
+		return sql.ErrNoRows
+	}
+	r.s.deleteMeaningMinor(id)
+	return nil
+}
+
+func (r meanings) BatchMinor(ctx context.Context, items []models.MeaningMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.batch(len(items), atomic, func(i int) (int64, bool, error) {
+		item := items[i]
+		if item.ID == 0 {
+			id, err := r.s.createMinorMeaning(item.MeaningMinorInput)
+			return id, true, err
+		}
+		return item.ID, false, r.s.updateMinorMeaning(item.ID, item.MeaningMinorInput, item.Version)
+	}), nil
+}
+
+func (r meanings) ListMajorRevisions(ctx context.Context, id int64) ([]models.MeaningMajorRevision, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return slices.Clone(r.s.revisionsMajor[id]), nil
+}
+
+func (r meanings) GetMajorRevision(ctx context.Context, id, revision int64) (*models.MeaningMajorRevision, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, rev := range r.s.revisionsMajor[id] {
+		if rev.Revision == revision {
+			return &rev, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r meanings) ListMinorRevisions(ctx context.Context, id int64) ([]models.MeaningMinorRevision, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return slices.Clone(r.s.revisionsMinor[id]), nil
+}
+
+func (r meanings) GetMinorRevision(ctx context.Context, id, revision int64) (*models.MeaningMinorRevision, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, rev := range r.s.revisionsMinor[id] {
+		if rev.Revision == revision {
+			return &rev, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) createMajorMeaning(input models.MeaningMajorInput) (int64, error) {
+	if err := s.checkMajorMeaning(0, input); err != nil {
+		return 0, err
+	}
+	id := s.nextID("meaning_major")
+	s.meaningsMajor[id] = majorMeaningFromInput(id, input)
+	s.touch("meaning_major", id)
+	s.saveMajorRevision(id, input)
+	return id, nil
+}
+
+func (s *Store) updateMajorMeaning(id int64, input models.MeaningMajorInput, version int64) error {
+	if err := s.checkVersion("meaning_major", id, version); err != nil {
+		return err
+	}
+
+	if m, ok := s.meaningsMajor[id]; !ok || !s.majorMeaningVisible(m) {
+		return sql.ErrNoRows
+	}
+	if err := s.checkMajorMeaning(id, input); err != nil {
+		return err
+	}
+	s.meaningsMajor[id] = majorMeaningFromInput(id, input)
+	s.touch("meaning_major", id)
+	s.saveMajorRevision(id, input)
+	return nil
+}
+
+// saveMajorRevision keeps the version just written of a meaning, as its revision table does
+func (s *Store) saveMajorRevision(id int64, input models.MeaningMajorInput) {
+	row := s.rowVersion("meaning_major", id)
+	s.revisionsMajor[id] = append(s.revisionsMajor[id], models.MeaningMajorRevision{
+		Revision: row.Version, CreatedAt: row.UpdatedAt, MeaningMajorInput: input,
+	})
+}
+
+func (s *Store) createMinorMeaning(input models.MeaningMinorInput) (int64, error) {
+	if err := s.checkMinorMeaning(0, input); err != nil {
+		return 0, err
+	}
+	id := s.nextID("meaning_minor")
+	s.meaningsMinor[id] = minorMeaningFromInput(id, input)
+	s.touch("meaning_minor", id)
+	s.saveMinorRevision(id, input)
+	return id, nil
+}
+
+func (s *Store) updateMinorMeaning(id int64, input models.MeaningMinorInput, version int64) error {
+	if err := s.checkVersion("meaning_minor", id, version); err != nil {
+		return err
+	}
+
+	if m, ok := s.meaningsMinor[id]; !ok || !s.minorMeaningVisible(m) {
+		return sql.ErrNoRows
+	}
+	if err := s.checkMinorMeaning(id, input); err != nil {
+		return err
+	}
+	s.meaningsMinor[id] = minorMeaningFromInput(id, input)
+	s.touch("meaning_minor", id)
+	s.saveMinorRevision(id, input)
+	return nil
+}
+
+// saveMinorRevision keeps the version just written of a meaning, as its revision table does
+func (s *Store) saveMinorRevision(id int64, input models.MeaningMinorInput) {
+	row := s.rowVersion("meaning_minor", id)
+	s.revisionsMinor[id] = append(s.revisionsMinor[id], models.MeaningMinorRevision{
+		Revision: row.Version, CreatedAt: row.UpdatedAt, MeaningMinorInput: input,
+	})
+}
+
+func majorMeaningFromInput(id int64, input models.MeaningMajorInput) models.MeaningMajor {
+	return models.MeaningMajor{
+		ID:       id,
+		Number:   input.Number,
+		Position: input.Position,
+		Source:   input.Source,
+		Meaning:  input.Meaning,
+	}
+}
+
+func minorMeaningFromInput(id int64, input models.MeaningMinorInput) models.MeaningMinor {
+	return models.MeaningMinor{
+		ID:       id,
+		Suit:     input.Suit,
+		Rank:     input.Rank,
+		Position: input.Position,
+		Source:   input.Source,
+		Meaning:  input.Meaning,
+	}
+}
+
+func (s *Store) checkMajorMeaning(exceptID int64, input models.MeaningMajorInput) error {
+	if _, ok := s.sources[input.Source]; !ok {
+		return invalidReference("meaning_major_source_fkey")
+	}
+	for id, m := range s.meaningsMajor {
+		if id != exceptID && m.Number == input.Number && m.Position == input.Position && m.Source == input.Source {
+			return duplicate("meaning_major_uniq")
+		}
+	}
+	if s.trashed("source", input.Source) {
+		return fmt.Errorf("%w: source %d", models.ErrParentInTrash, input.Source)
+	}
+	return nil
+}
+
+func (s *Store) checkMinorMeaning(exceptID int64, input models.MeaningMinorInput) error {
+	if _, ok := s.suits[input.Suit]; !ok {
+		return invalidReference("meaning_minor_suit_fkey")
+	}
+	if _, ok := s.ranks[input.Rank]; !ok {
+		return invalidReference("meaning_minor_rank_fkey")
+	}
+	if _, ok := s.sources[input.Source]; !ok {
+		return invalidReference("meaning_minor_source_fkey")
+	}
+	for id, m := range s.meaningsMinor {
+		if id != exceptID && m.Suit == input.Suit && m.Rank == input.Rank &&
+			m.Position == input.Position && m.Source == input.Source {
+			return duplicate("meaning_minor_uniq")
+		}
+	}
+	switch {
+	case s.trashed("source", input.Source):
+		return fmt.Errorf("%w: source %d", models.ErrParentInTrash, input.Source)
+	case s.trashed("suit", input.Suit):
+		return fmt.Errorf("%w: suit %d", models.ErrParentInTrash, input.Suit)
+	case s.trashed("rank", input.Rank):
+		return fmt.Errorf("%w: rank %d", models.ErrParentInTrash, input.Rank)
+	}
+	return nil
+}
+
+// majorMeaningVisible reports whether the source of a meaning is out of the trash
+func (s *Store) majorMeaningVisible(m models.MeaningMajor) bool {
+	return !s.trashed("source", m.Source)
+}
+
+// minorMeaningVisible reports whether the source, suit and rank of a meaning
+// are out of the trash
+func (s *Store) minorMeaningVisible(m models.MeaningMinor) bool {
+	return !s.trashed("source", m.Source) && !s.trashed("suit", m.Suit) && !s.trashed("rank", m.Rank)
+}
+
+// deleteMeaningMajor removes a meaning with its revisions
+func (s *Store) deleteMeaningMajor(id int64) {
+	delete(s.meaningsMajor, id)
+	delete(s.versions, rowKey{"meaning_major", id})
+	delete(s.revisionsMajor, id)
+}
+
+// deleteMeaningMinor removes a meaning with its revisions
+func (s *Store) deleteMeaningMinor(id int64) {
+	delete(s.meaningsMinor, id)
+	delete(s.versions, rowKey{"meaning_minor", id})
+	delete(s.revisionsMinor, id)
+}
@@ -0,0 +1,205 @@
+// Package memory implements the repositories in process memory.
+// It mirrors the constraints of the PostgreSQL schema (unique names,
+// foreign keys and cascading deletes) and is meant for fast tests.
+package memory
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository"
+	"github.com/ilbagatto/tarot-api/internal/utils"
+)
+
+// Store holds all tables. The zero value is not usable; use NewStore.
+type Store struct {
+	mu  sync.RWMutex
+	seq map[string]int64
+
+	decks          map[int64]deckRow
+	deckSources    map[int64][]int64 // deck ID -> source IDs in insertion order
+	sources        map[int64]string  // source ID -> name
+	spreads        map[int64]models.Spread
+	suits          map[int64]models.Suit
+	ranks          map[int64]models.Rank
+	cards          map[int64]cardRow
+	images         map[int64]string // card ID -> image path
+	meaningsMajor  map[int64]models.MeaningMajor
+	meaningsMinor  map[int64]models.MeaningMinor
+	revisionsMajor map[int64][]models.MeaningMajorRevision // meaning ID -> revisions, oldest first
+	revisionsMinor map[int64][]models.MeaningMinorRevision
+	versions       map[rowKey]models.RowVersion // the version and updated_at columns
+	deleted        map[rowKey]time.Time         // the deleted_at column of trashed rows
+	auditLog       []models.AuditEntry
+}
+
+// rowKey identifies a row of any table
+type rowKey struct {
+	table string
+	id    int64
+}
+
+type deckRow struct {
+	name          string
+	image         string
+	description   string
+	majorTemplate string
+	minorTemplate string
+	backImage     string
+	imageWidth    int
+	imageHeight   int
+}
+
+type cardRow struct {
+	deck   int64
+	arcana string // "major" or "minor"
+
+	// major
+	number  int
+	name    string
+	orgName string
+
+	// minor
+	suit int64
+	rank int64
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	return &Store{
+		seq:            map[string]int64{},
+		decks:          map[int64]deckRow{},
+		deckSources:    map[int64][]int64{},
+		sources:        map[int64]string{},
+		spreads:        map[int64]models.Spread{},
+		suits:          map[int64]models.Suit{},
+		ranks:          map[int64]models.Rank{},
+		cards:          map[int64]cardRow{},
+		images:         map[int64]string{},
+		meaningsMajor:  map[int64]models.MeaningMajor{},
+		meaningsMinor:  map[int64]models.MeaningMinor{},
+		revisionsMajor: map[int64][]models.MeaningMajorRevision{},
+		revisionsMinor: map[int64][]models.MeaningMinorRevision{},
+		versions:       map[rowKey]models.RowVersion{},
+		deleted:        map[rowKey]time.Time{},
+	}
+}
+
+// New returns repositories backed by a new empty store
+func New() *repository.Repositories {
+	return NewStore().Repositories()
+}
+
+// Repositories returns repositories sharing this store
+func (s *Store) Repositories() *repository.Repositories {
+	return &repository.Repositories{
+		Decks:    decks{s},
+		Sources:  sources{s},
+		Spreads:  spreads{s},
+		Suits:    suits{s},
+		Ranks:    ranks{s},
+		Cards:    cards{s},
+		Meanings: meanings{s},
+		Trash:    trash{s},
+		Audit:    audit{s},
+		Tx:       transactor{},
+	}
+}
+
+// SetCardImage attaches an image path to a card, as card_image rows do
+func (s *Store) SetCardImage(cardID int64, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.images[cardID] = path
+}
+
+// nextID returns the next value of the sequence of the given table
+func (s *Store) nextID(table string) int64 {
+	s.seq[table]++
+	return s.seq[table]
+}
+
+// touch records a write of a row: an insert sets version 1, an update increments it
+func (s *Store) touch(table string, id int64) {
+	key := rowKey{table, id}
+	s.versions[key] = models.RowVersion{
+		Version:   s.versions[key].Version + 1,
+		UpdatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// checkVersion fails unless the row is at version, like a conditional write
+// does; version 0 matches any row, even a missing one
+func (s *Store) checkVersion(table string, id, version int64) error {
+	if version != 0 && s.versions[rowKey{table, id}].Version != version {
+		return models.ErrVersionMismatch
+	}
+	return nil
+}
+
+// rowVersion returns the version of a row
+func (s *Store) rowVersion(table string, id int64) models.RowVersion {
+	return s.versions[rowKey{table, id}]
+}
+
+// trashed reports whether a row is in the trash
+func (s *Store) trashed(table string, id int64) bool {
+	_, ok := s.deleted[rowKey{table, id}]
+	return ok
+}
+
+// moveToTrash soft-deletes a row, which counts as a write of it
+func (s *Store) moveToTrash(table string, id int64) {
+	s.deleted[rowKey{table, id}] = time.Now().UTC().Truncate(time.Second)
+	s.touch(table, id)
+}
+
+// sortedIDs returns the keys of a table in ascending order
+func sortedIDs[V any](table map[int64]V) []int64 {
+	return slices.Sorted(maps.Keys(table))
+}
+
+func duplicate(constraint string) error {
+	return fmt.Errorf("%w %q", repository.ErrDuplicate, constraint)
+}
+
+func invalidReference(constraint string) error {
+	return fmt.Errorf("%w %q", repository.ErrInvalidReference, constraint)
+}
+
+func invalidInput(constraint string) error {
+	return fmt.Errorf("%w %q", repository.ErrInvalidInput, constraint)
+}
+
+// positionOrder sorts positions like the card_position enum does
+func positionOrder(p models.MeaningPosition) int {
+	if p == models.PositionStraight {
+		return 0
+	}
+	return 1
+}
+
+// matches reports whether every filter equals the corresponding column value
+func matches(filters map[string]any, columns map[string]any) bool {
+	for key, want := range filters {
+		if want == nil {
+			continue
+		}
+		if fmt.Sprint(columns[key]) != fmt.Sprint(want) {
+			return false
+		}
+	}
+	return true
+}
+
+// imageURL builds an image URL, or "" when no static URL is configured
+func imageURL(path string, small bool) string {
+	if url := utils.GetImageURL(path, small); url != nil {
+		return *url
+	}
+	return ""
+}
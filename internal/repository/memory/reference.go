@@ -0,0 +1,337 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type spreads struct{ s *Store }
+
+func (r spreads) List(ctx context.Context) ([]models.Spread, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.Spread
+	for _, id := range sortedIDs(r.s.spreads) {
+		list = append(list, r.s.spreads[id])
+	}
+	return list, nil
+}
+
+func (r spreads) Get(ctx context.Context, id int64) (*models.Spread, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	spread, ok := r.s.spreads[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	spread.RowVersion = r.s.rowVersion("spread", id)
+	return &spread, nil
+}
+
+func (r spreads) Create(ctx context.Context, input models.SpreadInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.s.spreadNameTaken(input.Name, 0) {
+		return nil, duplicate("spread_name_unique_idx")
+	}
+	id := r.s.nextID("spread")
+	r.s.spreads[id] = spreadFromInput(id, input)
+	r.s.touch("spread", id)
+	return &id, nil
+}
+
+func (r spreads) Update(ctx context.Context, id int64, input models.SpreadInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("spread", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.spreads[id]; !ok {
+		return sql.ErrNoRows
+	}
+	if r.s.spreadNameTaken(input.Name, id) {
+		return duplicate("spread_name_unique_idx")
+	}
+	r.s.spreads[id] = spreadFromInput(id, input)
+	r.s.touch("spread", id)
+	return nil
+}
+
+func (r spreads) Delete(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("spread", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.spreads[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(r.s.spreads, id)
+	delete(r.s.versions, rowKey{"spread", id})
+	return nil
+}
+
+func spreadFromInput(id int64, input models.SpreadInput) models.Spread {
+	return models.Spread{
+		ID:          id,
+		Name:        input.Name,
+		MajorArcana: input.MajorArcana,
+		MinorArcana: input.MinorArcana,
+		UpsideDown:  input.UpsideDown,
+		NumCards:    input.NumCards,
+		Description: input.Description,
+	}
+}
+
+func (s *Store) spreadNameTaken(name string, exceptID int64) bool {
+	for id, spread := range s.spreads {
+		if spread.Name == name && id != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+type suits struct{ s *Store }
+
+func (r suits) List(ctx context.Context) ([]models.Suit, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.Suit
+	for _, id := range sortedIDs(r.s.suits) {
+		if r.s.trashed("suit", id) {
+			continue
+		}
+		list = append(list, r.s.suits[id])
+	}
+	return list, nil
+}
+
+func (r suits) Get(ctx context.Context, id int64) (*models.Suit, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	suit, ok := r.s.suits[id]
+	if !ok || r.s.trashed("suit", id) {
+		return nil, sql.ErrNoRows
+	}
+	suit.RowVersion = r.s.rowVersion("suit", id)
+	return &suit, nil
+}
+
+func (r suits) Create(ctx context.Context, input models.SuitInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.s.suitNameTaken(input.Name, 0) {
+		return nil, duplicate("suit_name_unique_idx")
+	}
+	id := r.s.nextID("suit")
+	r.s.suits[id] = models.Suit{ID: id, Name: input.Name, Genitive: input.Genitive, Description: input.Description, Slug: input.Slug}
+	r.s.touch("suit", id)
+	return &id, nil
+}
+
+func (r suits) Update(ctx context.Context, id int64, input models.SuitInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("suit", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.suits[id]; !ok || r.s.trashed("suit", id) {
+		return sql.ErrNoRows
+	}
+	if r.s.suitNameTaken(input.Name, id) {
+		return duplicate("suit_name_unique_idx")
+	}
+	r.s.suits[id] = models.Suit{ID: id, Name: input.Name, Genitive: input.Genitive, Description: input.Description, Slug: input.Slug}
+	r.s.touch("suit", id)
+	return nil
+}
+
+func (r suits) Delete(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("suit", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.suits[id]; !ok || r.s.trashed("suit", id) {
+		return sql.ErrNoRows
+	}
+	r.s.trashWithMinorCards("suit", id, func(card cardRow) bool { return card.suit == id })
+	return nil
+}
+
+func (s *Store) suitNameTaken(name string, exceptID int64) bool {
+	for id, suit := range s.suits {
+		if suit.Name == name && id != exceptID && !s.trashed("suit", id) {
+			return true
+		}
+	}
+	return false
+}
+
+type ranks struct{ s *Store }
+
+func (r ranks) List(ctx context.Context) ([]models.Rank, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.Rank
+	for _, id := range sortedIDs(r.s.ranks) {
+		if r.s.trashed("rank", id) {
+			continue
+		}
+		list = append(list, r.s.ranks[id])
+	}
+	return list, nil
+}
+
+func (r ranks) Get(ctx context.Context, id int64) (*models.Rank, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	rank, ok := r.s.ranks[id]
+	if !ok || r.s.trashed("rank", id) {
+		return nil, sql.ErrNoRows
+	}
+	rank.RowVersion = r.s.rowVersion("rank", id)
+	return &rank, nil
+}
+
+func (r ranks) Create(ctx context.Context, input models.RankInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.s.rankNameTaken(input.Name, 0) {
+		return nil, duplicate("rank_name_unique_idx")
+	}
+	id := r.s.nextID("rank")
+	r.s.ranks[id] = models.Rank{ID: id, Name: input.Name, Slug: input.Slug}
+	r.s.touch("rank", id)
+	return &id, nil
+}
+
+func (r ranks) Update(ctx context.Context, id int64, input models.RankInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("rank", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.ranks[id]; !ok || r.s.trashed("rank", id) {
+		return sql.ErrNoRows
+	}
+	if r.s.rankNameTaken(input.Name, id) {
+		return duplicate("rank_name_unique_idx")
+	}
+	r.s.ranks[id] = models.Rank{ID: id, Name: input.Name, Slug: input.Slug}
+	r.s.touch("rank", id)
+	return nil
+}
+
+func (r ranks) Delete(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("rank", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.ranks[id]; !ok || r.s.trashed("rank", id) {
+		return sql.ErrNoRows
+	}
+	r.s.trashWithMinorCards("rank", id, func(card cardRow) bool { return card.rank == id })
+	return nil
+}
+
+func (s *Store) rankNameTaken(name string, exceptID int64) bool {
+	for id, rank := range s.ranks {
+		if rank.Name == name && id != exceptID && !s.trashed("rank", id) {
+			return true
+		}
+	}
+	return false
+}
+
+// trashWithMinorCards moves a suit or rank to the trash along with the Minor
+// Arcana cards matched that are not there yet, all deleted at the same time
+func (s *Store) trashWithMinorCards(table string, id int64, match func(cardRow) bool) {
+	s.moveToTrash(table, id)
+	at := s.deleted[rowKey{table, id}]
+	for cardID, card := range s.cards {
+		if card.arcana == "minor" && match(card) && !s.trashed("card", cardID) {
+			s.deleted[rowKey{"card", cardID}] = at
+			s.touch("card", cardID)
+		}
+	}
+}
+
+// restoreWithMinorCards takes a suit or rank out of the trash along with the
+// Minor Arcana cards matched that were trashed with it. A card whose other
+// parent is in the trash as well stays there, now along with that parent.
+func (s *Store) restoreWithMinorCards(table string, id int64, match func(cardRow) bool) {
+	at := s.deleted[rowKey{table, id}]
+	delete(s.deleted, rowKey{table, id})
+	s.touch(table, id)
+	for cardID, card := range s.cards {
+		key := rowKey{"card", cardID}
+		trashedAt, ok := s.deleted[key]
+		if card.arcana != "minor" || !match(card) || !ok || !trashedAt.Equal(at) {
+			continue
+		}
+		delete(s.deleted, key)
+		if other, ok := s.deleted[rowKey{"suit", card.suit}]; ok {
+			s.deleted[key] = other
+		} else if other, ok := s.deleted[rowKey{"rank", card.rank}]; ok {
+			s.deleted[key] = other
+		}
+		s.touch("card", cardID)
+	}
+}
+
+// purgeMinorArcana deletes the Minor Arcana cards and meanings of the suits
+// and ranks matched
+func (s *Store) purgeMinorArcana(match func(suit, rank int64) bool) {
+	for cardID, card := range s.cards {
+		if card.arcana == "minor" && match(card.suit, card.rank) {
+			s.deleteCard(cardID)
+		}
+	}
+	for mid, m := range s.meaningsMinor {
+		if match(m.Suit, m.Rank) {
+			s.deleteMeaningMinor(mid)
+		}
+	}
+}
+
+// purgeSuit deletes a suit for good, with its Minor Arcana cards and meanings
+func (s *Store) purgeSuit(id int64) {
+	s.purgeMinorArcana(func(suit, rank int64) bool { return suit == id })
+	delete(s.suits, id)
+	delete(s.versions, rowKey{"suit", id})
+	delete(s.deleted, rowKey{"suit", id})
+}
+
+// purgeRank deletes a rank for good, with its Minor Arcana cards and meanings
+func (s *Store) purgeRank(id int64) {
+	s.purgeMinorArcana(func(suit, rank int64) bool { return rank == id })
+	delete(s.ranks, id)
+	delete(s.versions, rowKey{"rank", id})
+	delete(s.deleted, rowKey{"rank", id})
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type sources struct{ s *Store }
+
+func (r sources) List(ctx context.Context) ([]models.SourceListItem, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []models.SourceListItem
+	for _, id := range sortedIDs(r.s.sources) {
+		if r.s.trashed("source", id) {
+			continue
+		}
+		list = append(list, models.SourceListItem{ID: id, Name: r.s.sources[id]})
+	}
+	return list, nil
+}
+
+func (r sources) Get(ctx context.Context, id int64) (*models.Source, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	name, ok := r.s.sources[id]
+	if !ok || r.s.trashed("source", id) {
+		return nil, sql.ErrNoRows
+	}
+	src := &models.Source{ID: id, Name: name, RowVersion: r.s.rowVersion("source", id)}
+	for _, deckID := range sortedIDs(r.s.decks) {
+		if r.s.deckUsesSource(deckID, id) && !r.s.trashed("deck", deckID) {
+			d := r.s.decks[deckID]
+			src.Decks = append(src.Decks, models.DeckRef{ID: deckID, Name: d.name, Description: d.description})
+		}
+	}
+	return src, nil
+}
+
+func (r sources) Create(ctx context.Context, input models.SourceInput) (*int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.s.sourceNameTaken(input.Name, 0) {
+		return nil, duplicate("source_name_unique_idx")
+	}
+	id := r.s.nextID("source")
+	r.s.sources[id] = input.Name
+	r.s.touch("source", id)
+	return &id, nil
+}
+
+func (r sources) Update(ctx context.Context, id int64, input models.SourceInput, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("source", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.sources[id]; !ok || r.s.trashed("source", id) {
+		return sql.ErrNoRows
+	}
+	if r.s.sourceNameTaken(input.Name, id) {
+		return duplicate("source_name_unique_idx")
+	}
+	r.s.sources[id] = input.Name
+	r.s.touch("source", id)
+	return nil
+}
+
+func (r sources) Delete(ctx context.Context, id int64, version int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkVersion("source", id, version); err != nil {
+		return err
+	}
+
+	if _, ok := r.s.sources[id]; !ok || r.s.trashed("source", id) {
+		return sql.ErrNoRows
+	}
+	r.s.moveToTrash("source", id)
+	return nil
+}
+
+// purgeSource removes a source with its links to decks and its meanings
+func (s *Store) purgeSource(id int64) {
+	delete(s.sources, id)
+	delete(s.versions, rowKey{"source", id})
+	delete(s.deleted, rowKey{"source", id})
+	for deckID, sourceIDs := range s.deckSources {
+		s.deckSources[deckID] = slices.DeleteFunc(sourceIDs, func(src int64) bool { return src == id })
+	}
+	for mid, m := range s.meaningsMajor {
+		if m.Source == id {
+			s.deleteMeaningMajor(mid)
+		}
+	}
+	for mid, m := range s.meaningsMinor {
+		if m.Source == id {
+			s.deleteMeaningMinor(mid)
+		}
+	}
+}
+
+// sourceNameTaken reports whether another source not in the trash has name
+func (s *Store) sourceNameTaken(name string, exceptID int64) bool {
+	for id, n := range s.sources {
+		if n == name && id != exceptID && !s.trashed("source", id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+type trash struct{ s *Store }
+
+func (r trash) List(ctx context.Context, entity string) ([]models.TrashItem, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.trashItems(func(item models.TrashItem) bool {
+		return entity == "" || item.Entity == entity
+	}), nil
+}
+
+func (r trash) Get(ctx context.Context, entity string, id int64) (*models.TrashItem, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.trashItem(entity, id)
+}
+
+func (r trash) Restore(ctx context.Context, entity string, id int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, err := r.s.trashItem(entity, id); err != nil {
+		return err
+	}
+	switch {
+	case entity == models.TrashDeck && r.s.deckNameTaken(r.s.decks[id].name, id):
+		return duplicate("deck_name_unique_idx")
+	case entity == models.TrashSource && r.s.sourceNameTaken(r.s.sources[id], id):
+		return duplicate("source_name_unique_idx")
+	case entity == models.TrashSuit && r.s.suitNameTaken(r.s.suits[id].Name, id):
+		return duplicate("suit_name_unique_idx")
+	case entity == models.TrashRank && r.s.rankNameTaken(r.s.ranks[id].Name, id):
+		return duplicate("rank_name_unique_idx")
+	case entity == models.TrashSuit:
+		r.s.restoreWithMinorCards("suit", id, func(card cardRow) bool { return card.suit == id })
+		return nil
+	case entity == models.TrashRank:
+		r.s.restoreWithMinorCards("rank", id, func(card cardRow) bool { return card.rank == id })
+		return nil
+	case trashTable(entity) == "card":
+		if err := r.s.checkCardParents(r.s.cards[id]); err != nil {
+			return err
+		}
+	}
+	table := trashTable(entity)
+	delete(r.s.deleted, rowKey{table, id})
+	r.s.touch(table, id)
+	return nil
+}
+
+func (r trash) Purge(ctx context.Context, entity string, id int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, err := r.s.trashItem(entity, id); err != nil {
+		return err
+	}
+	r.s.purge(entity, id)
+	return nil
+}
+
+func (r trash) PurgeBefore(ctx context.Context, before time.Time) ([]models.TrashItem, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	items := r.s.trashItems(func(item models.TrashItem) bool {
+		return item.DeletedAt.Before(before)
+	})
+	for _, item := range items {
+		r.s.purge(item.Entity, item.ID)
+	}
+	return items, nil
+}
+
+// trashTable returns the table holding the rows of a trash entity
+func trashTable(entity string) string {
+	switch entity {
+	case models.TrashCardMajor, models.TrashCardMinor:
+		return "card"
+	}
+	return entity
+}
+
+// trashItems returns the trashed rows selected by keep, in the order of models.ListTrash
+func (s *Store) trashItems(keep func(models.TrashItem) bool) []models.TrashItem {
+	items := []models.TrashItem{}
+	for key, at := range s.deleted {
+		item := models.TrashItem{Entity: key.table, ID: key.id, DeletedAt: at}
+		switch key.table {
+		case "deck":
+			item.Name = s.decks[key.id].name
+		case "source":
+			item.Name = s.sources[key.id]
+		case "suit":
+			item.Name = s.suits[key.id].Name
+		case "rank":
+			item.Name = s.ranks[key.id].Name
+		case "card":
+			if s.cards[key.id].arcana == "major" {
+				item.Entity, item.Name = models.TrashCardMajor, s.majorCard(key.id).Name
+			} else {
+				item.Entity, item.Name = models.TrashCardMinor, s.minorCard(key.id).Name
+			}
+		}
+		if keep(item) {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b models.TrashItem) int {
+		return cmp.Or(b.DeletedAt.Compare(a.DeletedAt), cmp.Compare(a.Entity, b.Entity), cmp.Compare(a.ID, b.ID))
+	})
+	return items
+}
+
+// trashItem returns the trashed row id of entity
+func (s *Store) trashItem(entity string, id int64) (*models.TrashItem, error) {
+	items := s.trashItems(func(item models.TrashItem) bool {
+		return item.Entity == entity && item.ID == id
+	})
+	if len(items) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &items[0], nil
+}
+
+// purge deletes a row of entity for good, with everything that cascades from it
+func (s *Store) purge(entity string, id int64) {
+	switch entity {
+	case models.TrashDeck:
+		s.purgeDeck(id)
+	case models.TrashSource:
+		s.purgeSource(id)
+	case models.TrashSuit:
+		s.purgeSuit(id)
+	case models.TrashRank:
+		s.purgeRank(id)
+	default:
+		s.deleteCard(id)
+	}
+}
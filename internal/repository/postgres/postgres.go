@@ -0,0 +1,319 @@
+// Package postgres implements the repositories on top of the SQL in package models.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/repository"
+)
+
+// New returns repositories backed by the given PostgreSQL connection pool
+func New(db *sql.DB) *repository.Repositories {
+	return &repository.Repositories{
+		Decks:    decks{db},
+		Sources:  sources{db},
+		Spreads:  spreads{db},
+		Suits:    suits{db},
+		Ranks:    ranks{db},
+		Cards:    cards{db},
+		Meanings: meanings{db},
+		Trash:    trash{db},
+		Audit:    audit{db},
+		Tx:       transactor{db},
+	}
+}
+
+type decks struct{ db *sql.DB }
+
+func (r decks) List(ctx context.Context) ([]models.DeckListItem, error) {
+	return models.ListDecks(ctx, r.db)
+}
+
+func (r decks) Get(ctx context.Context, id int64) (*models.Deck, error) {
+	return models.GetDeckByID(ctx, r.db, id)
+}
+
+func (r decks) Create(ctx context.Context, input models.DeckInput) (*int64, error) {
+	return models.CreateDeck(ctx, r.db, input)
+}
+
+func (r decks) Update(ctx context.Context, id int64, input models.DeckInput, version int64) error {
+	return models.UpdateDeck(ctx, r.db, id, input, version)
+}
+
+func (r decks) Delete(ctx context.Context, id int64, version int64) error {
+	return models.DeleteDeck(ctx, r.db, id, version)
+}
+
+func (r decks) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	return models.GetDeckDependents(ctx, r.db, id)
+}
+
+type sources struct{ db *sql.DB }
+
+func (r sources) List(ctx context.Context) ([]models.SourceListItem, error) {
+	return models.ListSources(ctx, r.db)
+}
+
+func (r sources) Get(ctx context.Context, id int64) (*models.Source, error) {
+	return models.GetSourceByID(ctx, r.db, id)
+}
+
+func (r sources) Create(ctx context.Context, input models.SourceInput) (*int64, error) {
+	return models.CreateSource(ctx, r.db, input)
+}
+
+func (r sources) Update(ctx context.Context, id int64, input models.SourceInput, version int64) error {
+	return models.UpdateSource(ctx, r.db, id, input, version)
+}
+
+func (r sources) Delete(ctx context.Context, id int64, version int64) error {
+	return models.DeleteSource(ctx, r.db, id, version)
+}
+
+func (r sources) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	return models.GetSourceDependents(ctx, r.db, id)
+}
+
+type spreads struct{ db *sql.DB }
+
+func (r spreads) List(ctx context.Context) ([]models.Spread, error) {
+	return models.ListSpreads(ctx, r.db)
+}
+
+func (r spreads) Get(ctx context.Context, id int64) (*models.Spread, error) {
+	return models.GetSpreadByID(ctx, r.db, id)
+}
+
+func (r spreads) Create(ctx context.Context, input models.SpreadInput) (*int64, error) {
+	return models.CreateSpread(ctx, r.db, input)
+}
+
+func (r spreads) Update(ctx context.Context, id int64, input models.SpreadInput, version int64) error {
+	return models.UpdateSpread(ctx, r.db, id, input, version)
+}
+
+func (r spreads) Delete(ctx context.Context, id int64, version int64) error {
+	return models.DeleteSpread(ctx, r.db, id, version)
+}
+
+type suits struct{ db *sql.DB }
+
+func (r suits) List(ctx context.Context) ([]models.Suit, error) {
+	return models.ListSuits(ctx, r.db)
+}
+
+func (r suits) Get(ctx context.Context, id int64) (*models.Suit, error) {
+	return models.GetSuitByID(ctx, r.db, id)
+}
+
+func (r suits) Create(ctx context.Context, input models.SuitInput) (*int64, error) {
+	return models.CreateSuit(ctx, r.db, input)
+}
+
+func (r suits) Update(ctx context.Context, id int64, input models.SuitInput, version int64) error {
+	return models.UpdateSuit(ctx, r.db, id, input, version)
+}
+
+func (r suits) Delete(ctx context.Context, id int64, version int64) error {
+	return models.DeleteSuit(ctx, r.db, id, version)
+}
+
+func (r suits) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	return models.GetSuitDependents(ctx, r.db, id)
+}
+
+type ranks struct{ db *sql.DB }
+
+func (r ranks) List(ctx context.Context) ([]models.Rank, error) {
+	return models.ListRanks(ctx, r.db)
+}
+
+func (r ranks) Get(ctx context.Context, id int64) (*models.Rank, error) {
+	return models.GetRankByID(ctx, r.db, id)
+}
+
+func (r ranks) Create(ctx context.Context, input models.RankInput) (*int64, error) {
+	return models.CreateRank(ctx, r.db, input)
+}
+
+func (r ranks) Update(ctx context.Context, id int64, input models.RankInput, version int64) error {
+	return models.UpdateRank(ctx, r.db, id, input, version)
+}
+
+func (r ranks) Delete(ctx context.Context, id int64, version int64) error {
+	return models.DeleteRank(ctx, r.db, id, version)
+}
+
+func (r ranks) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	return models.GetRankDependents(ctx, r.db, id)
+}
+
+type cards struct{ db *sql.DB }
+
+func (r cards) ListMajor(ctx context.Context, deckID int64) ([]models.CardMajor, error) {
+	return models.ListMajorCards(ctx, r.db, deckID)
+}
+
+func (r cards) GetMajor(ctx context.Context, id int64) (*models.CardMajor, error) {
+	return models.GetMajorCardByID(ctx, r.db, id)
+}
+
+func (r cards) CreateMajor(ctx context.Context, input models.CardMajorInput) (*int64, error) {
+	return models.CreateMajorCard(ctx, r.db, input)
+}
+
+func (r cards) UpdateMajor(ctx context.Context, id int64, input models.CardMajorInput, version int64) error {
+	return models.UpdateMajorCard(ctx, r.db, id, input, version)
+}
+
+func (r cards) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	return models.DeleteMajorCard(ctx, r.db, id, version)
+}
+
+func (r cards) BatchMajor(ctx context.Context, items []models.CardMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	return models.BatchMajorCards(ctx, r.db, items, atomic)
+}
+
+func (r cards) ListMinor(ctx context.Context, deckID int64) ([]models.CardMinor, error) {
+	return models.ListMinorCards(ctx, r.db, deckID)
+}
+
+func (r cards) GetMinor(ctx context.Context, id int64) (*models.CardMinor, error) {
+	return models.GetMinorCardByID(ctx, r.db, id)
+}
+
+func (r cards) CreateMinor(ctx context.Context, input models.CardMinorInput) (*int64, error) {
+	return models.CreateMinorCard(ctx, r.db, input)
+}
+
+func (r cards) UpdateMinor(ctx context.Context, id int64, input models.CardMinorInput, version int64) error {
+	return models.UpdateMinorCard(ctx, r.db, id, input, version)
+}
+
+func (r cards) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	return models.DeleteMinorCard(ctx, r.db, id, version)
+}
+
+func (r cards) BatchMinor(ctx context.Context, items []models.CardMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	return models.BatchMinorCards(ctx, r.db, items, atomic)
+}
+
+func (r cards) ListImages(ctx context.Context, deckID int64) ([]models.CardImagePath, error) {
+	return models.ListCardImages(ctx, r.db, deckID)
+}
+
+func (r cards) Scaffold(ctx context.Context, deckID int64, input models.ScaffoldInput) (*models.ScaffoldResult, error) {
+	return models.ScaffoldDeck(ctx, r.db, deckID, input)
+}
+
+func (r cards) Dependents(ctx context.Context, id int64) (*models.Dependents, error) {
+	return models.GetCardDependents(ctx, r.db, id)
+}
+
+type meanings struct{ db *sql.DB }
+
+func (r meanings) ListMajor(ctx context.Context, filters map[string]any) ([]models.MeaningMajor, error) {
+	return models.ListMajorMeanings(ctx, r.db, filters)
+}
+
+func (r meanings) GetMajor(ctx context.Context, id int64) (*models.MeaningMajor, error) {
+	return models.GetMajorMeaningByID(ctx, r.db, id)
+}
+
+func (r meanings) CreateMajor(ctx context.Context, input models.MeaningMajorInput) (*int64, error) {
+	return models.CreateMeaningMajor(ctx, r.db, input)
+}
+
+func (r meanings) UpdateMajor(ctx context.Context, id int64, input models.MeaningMajorInput, version int64) error {
+	return models.UpdateMajorMeaning(ctx, r.db, id, input, version)
+}
+
+func (r meanings) DeleteMajor(ctx context.Context, id int64, version int64) error {
+	return models.DeleteMajorMeaning(ctx, r.db, id, version)
+}
+
+func (r meanings) BatchMajor(ctx context.Context, items []models.MeaningMajorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	return models.BatchMajorMeanings(ctx, r.db, items, atomic)
+}
+
+func (r meanings) ListMajorRevisions(ctx context.Context, id int64) ([]models.MeaningMajorRevision, error) {
+	return models.ListMajorMeaningRevisions(ctx, r.db, id)
+}
+
+func (r meanings) GetMajorRevision(ctx context.Context, id, revision int64) (*models.MeaningMajorRevision, error) {
+	return models.GetMajorMeaningRevision(ctx, r.db, id, revision)
+}
+
+func (r meanings) ListMinor(ctx context.Context, filters map[string]any) ([]models.MeaningMinor, error) {
+	return models.ListMinorMeanings(ctx, r.db, filters)
+}
+
+func (r meanings) GetMinor(ctx context.Context, id int64) (*models.MeaningMinor, error) {
+	return models.GetMinorMeaningByID(ctx, r.db, id)
+}
+
+func (r meanings) CreateMinor(ctx context.Context, input models.MeaningMinorInput) (*int64, error) {
+	return models.CreateMinorMeaning(ctx, r.db, input)
+}
+
+func (r meanings) UpdateMinor(ctx context.Context, id int64, input models.MeaningMinorInput, version int64) error {
+	return models.UpdateMinorMeaning(ctx, r.db, id, input, version)
+}
+
+func (r meanings) DeleteMinor(ctx context.Context, id int64, version int64) error {
+	return models.DeleteMinorMeaning(ctx, r.db, id, version)
+}
+
+func (r meanings) BatchMinor(ctx context.Context, items []models.MeaningMinorBatchItem, atomic bool) ([]models.BatchResult, error) {
+	return models.BatchMinorMeanings(ctx, r.db, items, atomic)
+}
+
+func (r meanings) ListMinorRevisions(ctx context.Context, id int64) ([]models.MeaningMinorRevision, error) {
+	return models.ListMinorMeaningRevisions(ctx, r.db, id)
+}
+
+func (r meanings) GetMinorRevision(ctx context.Context, id, revision int64) (*models.MeaningMinorRevision, error) {
+	return models.GetMinorMeaningRevision(ctx, r.db, id, revision)
+}
+
+type trash struct{ db *sql.DB }
+
+func (r trash) List(ctx context.Context, entity string) ([]models.TrashItem, error) {
+	return models.ListTrash(ctx, r.db, entity)
+}
+
+func (r trash) Get(ctx context.Context, entity string, id int64) (*models.TrashItem, error) {
+	return models.GetTrashItem(ctx, r.db, entity, id)
+}
+
+func (r trash) Restore(ctx context.Context, entity string, id int64) error {
+	return models.RestoreTrashItem(ctx, r.db, entity, id)
+}
+
+func (r trash) Purge(ctx context.Context, entity string, id int64) error {
+	return models.PurgeTrashItem(ctx, r.db, entity, id)
+}
+
+func (r trash) PurgeBefore(ctx context.Context, before time.Time) ([]models.TrashItem, error) {
+	return models.PurgeTrash(ctx, r.db, before)
+}
+
+type audit struct{ db *sql.DB }
+
+func (r audit) Record(ctx context.Context, entry models.AuditEntry) error {
+	return models.CreateAuditEntry(ctx, r.db, entry)
+}
+
+func (r audit) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	return models.ListAuditEntries(ctx, r.db, filter)
+}
+
+type transactor struct{ db *sql.DB }
+
+func (r transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return models.InTx(ctx, r.db, fn)
+}
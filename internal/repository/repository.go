@@ -0,0 +1,174 @@
+// Package repository defines storage interfaces for each aggregate of the API.
+// Handlers depend on these interfaces only; the PostgreSQL implementation lives
+// in the postgres subpackage and an in-memory one, used by tests, in memory.
+//
+// Implementations report a missing entity as sql.ErrNoRows, like database/sql does.
+//
+// Updates and deletes take the version the entity is expected to be at and
+// fail with models.ErrVersionMismatch if it has changed since; version 0 makes
+// them unconditional. Every update increments the version. Deletes remove the
+// entity with everything that depends on it, as reported by Dependents.
+//
+// Batch methods create and update many entities in one transaction and report
+// the outcome of each item (see models.BatchResult). A failing item changes
+// nothing; with atomic set, it fails the whole batch.
+//
+// Deleting a deck, source or card moves it to the trash (see TrashRepository):
+// it is hidden from lists and gets, as are the cards of a trashed deck.
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+)
+
+// Errors returned by implementations not backed by PostgreSQL.
+// Their messages use the PostgreSQL wording, so they are translated
+// to HTTP statuses the same way as database errors.
+var (
+	ErrDuplicate        = errors.New("duplicate key value violates unique constraint")
+	ErrInvalidReference = errors.New("insert or update violates foreign key constraint")
+	ErrInvalidInput     = errors.New("new row violates check constraint")
+)
+
+// DeckRepository stores decks and their links to sources
+type DeckRepository interface {
+	List(ctx context.Context) ([]models.DeckListItem, error)
+	Get(ctx context.Context, id int64) (*models.Deck, error)
+	Create(ctx context.Context, input models.DeckInput) (*int64, error)
+	Update(ctx context.Context, id int64, input models.DeckInput, version int64) error
+	Delete(ctx context.Context, id int64, version int64) error
+	Dependents(ctx context.Context, id int64) (*models.Dependents, error)
+}
+
+// SourceRepository stores sources of interpretations
+type SourceRepository interface {
+	List(ctx context.Context) ([]models.SourceListItem, error)
+	Get(ctx context.Context, id int64) (*models.Source, error)
+	Create(ctx context.Context, input models.SourceInput) (*int64, error)
+	Update(ctx context.Context, id int64, input models.SourceInput, version int64) error
+	Delete(ctx context.Context, id int64, version int64) error
+	Dependents(ctx context.Context, id int64) (*models.Dependents, error)
+}
+
+// SpreadRepository stores spreads
+type SpreadRepository interface {
+	List(ctx context.Context) ([]models.Spread, error)
+	Get(ctx context.Context, id int64) (*models.Spread, error)
+	Create(ctx context.Context, input models.SpreadInput) (*int64, error)
+	Update(ctx context.Context, id int64, input models.SpreadInput, version int64) error
+	Delete(ctx context.Context, id int64, version int64) error
+}
+
+// SuitRepository stores Minor Arcana suits
+type SuitRepository interface {
+	List(ctx context.Context) ([]models.Suit, error)
+	Get(ctx context.Context, id int64) (*models.Suit, error)
+	Create(ctx context.Context, input models.SuitInput) (*int64, error)
+	Update(ctx context.Context, id int64, input models.SuitInput, version int64) error
+	Delete(ctx context.Context, id int64, version int64) error
+	Dependents(ctx context.Context, id int64) (*models.Dependents, error)
+}
+
+// RankRepository stores Minor Arcana ranks
+type RankRepository interface {
+	List(ctx context.Context) ([]models.Rank, error)
+	Get(ctx context.Context, id int64) (*models.Rank, error)
+	Create(ctx context.Context, input models.RankInput) (*int64, error)
+	Update(ctx context.Context, id int64, input models.RankInput, version int64) error
+	Delete(ctx context.Context, id int64, version int64) error
+	Dependents(ctx context.Context, id int64) (*models.Dependents, error)
+}
+
+// CardRepository stores Major and Minor Arcana cards of all decks
+type CardRepository interface {
+	ListMajor(ctx context.Context, deckID int64) ([]models.CardMajor, error)
+	GetMajor(ctx context.Context, id int64) (*models.CardMajor, error)
+	CreateMajor(ctx context.Context, input models.CardMajorInput) (*int64, error)
+	UpdateMajor(ctx context.Context, id int64, input models.CardMajorInput, version int64) error
+	DeleteMajor(ctx context.Context, id int64, version int64) error
+	BatchMajor(ctx context.Context, items []models.CardMajorBatchItem, atomic bool) ([]models.BatchResult, error)
+
+	ListMinor(ctx context.Context, deckID int64) ([]models.CardMinor, error)
+	GetMinor(ctx context.Context, id int64) (*models.CardMinor, error)
+	CreateMinor(ctx context.Context, input models.CardMinorInput) (*int64, error)
+	UpdateMinor(ctx context.Context, id int64, input models.CardMinorInput, version int64) error
+	DeleteMinor(ctx context.Context, id int64, version int64) error
+	BatchMinor(ctx context.Context, items []models.CardMinorBatchItem, atomic bool) ([]models.BatchResult, error)
+
+	// ListImages returns the image paths of the cards of a deck, see models.ListCardImages
+	ListImages(ctx context.Context, deckID int64) ([]models.CardImagePath, error)
+
+	// Scaffold creates the standard cards of an empty deck in one transaction,
+	// see models.ScaffoldDeck
+	Scaffold(ctx context.Context, deckID int64, input models.ScaffoldInput) (*models.ScaffoldResult, error)
+
+	// Dependents reports what depends on a card of either arcana
+	Dependents(ctx context.Context, id int64) (*models.Dependents, error)
+}
+
+// MeaningRepository stores card interpretations.
+// List filters map column names to required values.
+// Every version of a meaning is kept as a revision, numbered by the version.
+type MeaningRepository interface {
+	ListMajor(ctx context.Context, filters map[string]any) ([]models.MeaningMajor, error)
+	GetMajor(ctx context.Context, id int64) (*models.MeaningMajor, error)
+	CreateMajor(ctx context.Context, input models.MeaningMajorInput) (*int64, error)
+	UpdateMajor(ctx context.Context, id int64, input models.MeaningMajorInput, version int64) error
+	DeleteMajor(ctx context.Context, id int64, version int64) error
+	BatchMajor(ctx context.Context, items []models.MeaningMajorBatchItem, atomic bool) ([]models.BatchResult, error)
+	ListMajorRevisions(ctx context.Context, id int64) ([]models.MeaningMajorRevision, error)
+	GetMajorRevision(ctx context.Context, id, revision int64) (*models.MeaningMajorRevision, error)
+
+	ListMinor(ctx context.Context, filters map[string]any) ([]models.MeaningMinor, error)
+	GetMinor(ctx context.Context, id int64) (*models.MeaningMinor, error)
+	CreateMinor(ctx context.Context, input models.MeaningMinorInput) (*int64, error)
+	UpdateMinor(ctx context.Context, id int64, input models.MeaningMinorInput, version int64) error
+	DeleteMinor(ctx context.Context, id int64, version int64) error
+	BatchMinor(ctx context.Context, items []models.MeaningMinorBatchItem, atomic bool) ([]models.BatchResult, error)
+	ListMinorRevisions(ctx context.Context, id int64) ([]models.MeaningMinorRevision, error)
+	GetMinorRevision(ctx context.Context, id, revision int64) (*models.MeaningMinorRevision, error)
+}
+
+// AuditRepository stores the audit log, which is append-only
+type AuditRepository interface {
+	Record(ctx context.Context, entry models.AuditEntry) error
+	List(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+}
+
+// TrashRepository restores and purges soft-deleted entities,
+// named by models.TrashEntities
+type TrashRepository interface {
+	// List returns the trashed entities, most recently deleted first;
+	// an empty entity lists all of them
+	List(ctx context.Context, entity string) ([]models.TrashItem, error)
+	Get(ctx context.Context, entity string, id int64) (*models.TrashItem, error)
+	Restore(ctx context.Context, entity string, id int64) error
+	Purge(ctx context.Context, entity string, id int64) error
+	// PurgeBefore purges the entities trashed before the given time and returns them
+	PurgeBefore(ctx context.Context, before time.Time) ([]models.TrashItem, error)
+}
+
+// Transactor runs writes of several repositories in one transaction
+type Transactor interface {
+	// InTx runs fn, whose writes with the context it gets are committed
+	// together if fn succeeds and rolled back otherwise
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Repositories bundles the repositories of all aggregates
+type Repositories struct {
+	Decks    DeckRepository
+	Sources  SourceRepository
+	Spreads  SpreadRepository
+	Suits    SuitRepository
+	Ranks    RankRepository
+	Cards    CardRepository
+	Meanings MeaningRepository
+	Trash    TrashRepository
+	Audit    AuditRepository
+	Tx       Transactor
+}
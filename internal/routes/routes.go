@@ -1,89 +1,157 @@
 package routes
 
 import (
-	"os"
+	"slices"
 
 	_ "github.com/ilbagatto/tarot-api/docs" // Import generated Swagger docs
 	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/config"
 	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/middleware"
+	"github.com/ilbagatto/tarot-api/internal/tracing"
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
+// probeRoutes are polled by orchestrators and scrapers: they are not traced
+// and only logged when they fail.
+var probeRoutes = []string{"/healthz", "/readyz", "/metrics"}
+
 // InitRoutes initializes all application routes
 func InitRoutes(a *app.App) {
 	e := a.Echo // Using Echo instance from App struct
 
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
+	// Validated by config.Load
+	trustedProxies, _ := config.ParseTrustedProxies(a.Config.Server.TrustedProxies)
+	e.IPExtractor = middleware.IPExtractor(trustedProxies)
+
+	e.Use(otelecho.Middleware(tracing.ServiceName, otelecho.WithSkipper(func(c echo.Context) bool {
+		return slices.Contains(probeRoutes, c.Path())
+	})))
+	e.Use(middleware.RequestID())
+	// Outside the logger, which renders handler errors: the metrics see their
+	// status, and the logger still sees the errors themselves
+	e.Use(a.Metrics.Middleware())
+	e.Use(middleware.RequestLogger(a.Logger, probeRoutes...))
+	// Before the rate limiter, so that browsers can read 429 responses
+	e.Use(middleware.CORSMiddleware(middleware.CORSConfig(a.Config.CORS), a.Config.IsDev()))
+	e.Use(middleware.RateLimiter(middleware.RateLimitConfig{
+		Read:       middleware.RateLimit(a.Config.RateLimit.Read),
+		Write:      middleware.RateLimit(a.Config.RateLimit.Write),
+		APIKeys:    a.Config.RateLimit.APIKeys,
+		SkipRoutes: probeRoutes,
+	}))
+	e.Use(middleware.Actor(a.Config.RateLimit.APIKeys))
+
+	// HTTP caching (ETag + Cache-Control) per route group
+	referenceCache := middleware.HTTPCache(config.CacheControl(a.Config.HTTPCache.Reference))
+	cardsCache := middleware.HTTPCache(config.CacheControl(a.Config.HTTPCache.Cards))
+	meaningsCache := middleware.HTTPCache(config.CacheControl(a.Config.HTTPCache.Meanings))
+
 	// Define API routes
 	// Decks routes
-	e.GET("/decks", handlers.ListDecksHandler(a))
-	e.GET("/decks/:id", handlers.GetDeckByIDHandler(a))
+	e.GET("/decks", handlers.ListDecksHandler(a), referenceCache)
+	e.GET("/decks/:id", handlers.GetDeckByIDHandler(a), referenceCache)
 	e.POST("/decks", handlers.CreateDeckHandler(a))
 	e.PUT("/decks/:id", handlers.UpdateDeckHandler(a))
+	e.PATCH("/decks/:id", handlers.PatchDeckHandler(a))
 	e.DELETE("/decks/:id", handlers.DeleteDeckHandler(a))
+	e.POST("/decks/:id/scaffold", handlers.ScaffoldDeckHandler(a))
+	e.GET("/decks/:id/images/check", handlers.CheckDeckImagesHandler(a))
 	// Source routes
-	e.GET("/sources", handlers.ListSourcesHandler(a))
-	e.GET("/sources/:id", handlers.GetSourceByIDHandler(a))
+	e.GET("/sources", handlers.ListSourcesHandler(a), referenceCache)
+	e.GET("/sources/:id", handlers.GetSourceByIDHandler(a), referenceCache)
 	e.POST("/sources", handlers.CreateSourceHandler(a))
 	e.PUT("/sources/:id", handlers.UpdateSourceHandler(a))
+	e.PATCH("/sources/:id", handlers.PatchSourceHandler(a))
 	e.DELETE("/sources/:id", handlers.DeleteSourceHandler(a))
 	// Spreads
-	e.GET("/spreads", handlers.ListSpreadsHandler(a))
-	e.GET("/spreads/:id", handlers.GetSpreadByIDHandler(a))
+	e.GET("/spreads", handlers.ListSpreadsHandler(a), referenceCache)
+	e.GET("/spreads/:id", handlers.GetSpreadByIDHandler(a), referenceCache)
 	e.POST("/spreads", handlers.CreateSpreadHandler(a))
 	e.PUT("/spreads/:id", handlers.UpdateSpreadHandler(a))
+	e.PATCH("/spreads/:id", handlers.PatchSpreadHandler(a))
 	e.DELETE("/spreads/:id", handlers.DeleteSpreadHandler(a))
 
 	// Suits
-	e.GET("/suits", handlers.ListSuitsHandler(a))
-	e.GET("/suits/:id", handlers.GetSuitByIDHandler(a))
+	e.GET("/suits", handlers.ListSuitsHandler(a), referenceCache)
+	e.GET("/suits/:id", handlers.GetSuitByIDHandler(a), referenceCache)
 	e.POST("/suits", handlers.CreateSuitHandler(a))
 	e.PUT("/suits/:id", handlers.UpdateSuitHandler(a))
+	e.PATCH("/suits/:id", handlers.PatchSuitHandler(a))
 	e.DELETE("/suits/:id", handlers.DeleteSuitHandler(a))
 
 	// Ranks
-	e.GET("/ranks", handlers.ListRanksHandler(a))
-	e.GET("/ranks/:id", handlers.GetRankByIDHandler(a))
+	e.GET("/ranks", handlers.ListRanksHandler(a), referenceCache)
+	e.GET("/ranks/:id", handlers.GetRankByIDHandler(a), referenceCache)
 	e.POST("/ranks", handlers.CreateRankHandler(a))
 	e.PUT("/ranks/:id", handlers.UpdateRankHandler(a))
+	e.PATCH("/ranks/:id", handlers.PatchRankHandler(a))
 	e.DELETE("/ranks/:id", handlers.DeleteRankHandler(a))
 
 	// Major Arcana Cards
-	e.GET("/cards/major", handlers.ListMajorCardsHandler(a))
-	e.GET("/cards/major/:id", handlers.GetMajorCardByIDHandler(a))
+	e.GET("/cards/major", handlers.ListMajorCardsHandler(a), cardsCache)
+	e.GET("/cards/major/:id", handlers.GetMajorCardByIDHandler(a), cardsCache)
 	e.POST("/cards/major", handlers.CreateMajorCardHandler(a))
+	e.POST("/cards/major/batch", handlers.BatchMajorCardsHandler(a))
 	e.PUT("/cards/major/:id", handlers.UpdateMajorCardHandler(a))
+	e.PATCH("/cards/major/:id", handlers.PatchMajorCardHandler(a))
 	e.DELETE("/cards/major/:id", handlers.DeleteMajorCardHandler(a))
 
 	// Minor Arcana Cards
-	e.GET("/cards/minor", handlers.ListMinorCardsHandler(a))
-	e.GET("/cards/minor/:id", handlers.GetMinorCardByIDHandler(a))
+	e.GET("/cards/minor", handlers.ListMinorCardsHandler(a), cardsCache)
+	e.GET("/cards/minor/:id", handlers.GetMinorCardByIDHandler(a), cardsCache)
 	e.POST("/cards/minor", handlers.CreateMinorCardHandler(a))
+	e.POST("/cards/minor/batch", handlers.BatchMinorCardsHandler(a))
 	e.PUT("/cards/minor/:id", handlers.UpdateMinorCardHandler(a))
+	e.PATCH("/cards/minor/:id", handlers.PatchMinorCardHandler(a))
 	e.DELETE("/cards/minor/:id", handlers.DeleteMinorCardHandler(a))
 
 	// Major cards meanings
-	e.GET("/meanings/major", handlers.ListMajorMeaningsHandler(a))
-	e.GET("/meanings/major/:id", handlers.GetMajorMeaningByIDHandler(a))
+	e.GET("/meanings/major", handlers.ListMajorMeaningsHandler(a), meaningsCache)
+	e.GET("/meanings/major/:id", handlers.GetMajorMeaningByIDHandler(a), meaningsCache)
 	e.POST("/meanings/major", handlers.CreateMajorMeaningHandler(a))
+	e.POST("/meanings/major/batch", handlers.BatchMajorMeaningsHandler(a))
 	e.PUT("/meanings/major/:id", handlers.UpdateMajorMeaningHandler(a))
+	e.PATCH("/meanings/major/:id", handlers.PatchMajorMeaningHandler(a))
 	e.DELETE("/meanings/major/:id", handlers.DeleteMajorMeaningHandler(a))
+	e.GET("/meanings/major/:id/revisions", handlers.ListMajorMeaningRevisionsHandler(a), meaningsCache)
+	e.POST("/meanings/major/:id/revisions/:revision/restore", handlers.RestoreMajorMeaningRevisionHandler(a))
 
 	// Minor cards meanings
-	e.GET("/meanings/minor", handlers.ListMinorMeaningsHandler(a))
-	e.GET("/meanings/minor/:id", handlers.GetMinorMeaningByIDHandler(a))
+	e.GET("/meanings/minor", handlers.ListMinorMeaningsHandler(a), meaningsCache)
+	e.GET("/meanings/minor/:id", handlers.GetMinorMeaningByIDHandler(a), meaningsCache)
 	e.POST("/meanings/minor", handlers.CreateMinorMeaningHandler(a))
+	e.POST("/meanings/minor/batch", handlers.BatchMinorMeaningsHandler(a))
 	e.PUT("/meanings/minor/:id", handlers.UpdateMinorMeaningHandler(a))
+	e.PATCH("/meanings/minor/:id", handlers.PatchMinorMeaningHandler(a))
 	e.DELETE("/meanings/minor/:id", handlers.DeleteMinorMeaningHandler(a))
+	e.GET("/meanings/minor/:id/revisions", handlers.ListMinorMeaningRevisionsHandler(a), meaningsCache)
+	e.POST("/meanings/minor/:id/revisions/:revision/restore", handlers.RestoreMinorMeaningRevisionHandler(a))
+
+	// Trash of deleted decks, sources and cards
+	e.GET("/trash", handlers.ListTrashHandler(a))
+	e.POST("/trash/:entity/:id/restore", handlers.RestoreTrashHandler(a))
+	e.DELETE("/trash/:entity/:id", handlers.PurgeTrashHandler(a))
+
+	// Audit log
+	e.GET("/audit", handlers.ListAuditHandler(a))
+
+	// Service
+	e.GET("/healthz", handlers.HealthzHandler(a))
+	e.GET("/readyz", handlers.ReadyzHandler(a))
+	e.GET("/cache/stats", handlers.CacheStatsHandler(a))
+	e.GET("/metrics", echo.WrapHandler(a.Metrics.Handler()))
 
 	// Swagger documentation route
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
-	if os.Getenv("APP_ENV") == "dev" {
-		e.GET("/*", func(c echo.Context) error {
-			c.Response().Header().Del(echo.HeaderContentType)
-			return c.File("static/images" + c.Request().URL.Path)
-		})
-	}
-
+	// Images: originals of the static image directory, their thumbnails, and
+	// card images and backs scaled on request
+	e.GET("/images/*", handlers.StaticImageHandler(a))
+	e.GET("/thumbnails/*", handlers.ThumbnailHandler(a))
+	e.GET("/img/:deck/back", handlers.ServeBackImageHandler(a))
+	e.GET("/img/:deck/:card", handlers.ServeCardImageHandler(a))
 }
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/config"
+	"github.com/ilbagatto/tarot-api/internal/repository/memory"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestInitRoutes_LogsHandlerErrors(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	cfg := config.Default()
+	a := app.NewApp(cfg, nil, memory.NewStore().Repositories(), cache.NewNoop(), zap.New(core))
+	InitRoutes(a)
+	a.Echo.GET("/boom", func(c echo.Context) error { return errors.New("disk on fire") })
+
+	rec := httptest.NewRecorder()
+	a.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	entries := logs.FilterMessage("request").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "disk on fire", entries[0].ContextMap()["error"])
+	assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+	assert.Equal(t, 1, testutil.CollectAndCount(a.Metrics.Registry, "tarot_http_requests_total"))
+}
@@ -1,6 +1,9 @@
 package testutils
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -8,13 +11,23 @@ import (
 	"runtime"
 
 	"github.com/ilbagatto/tarot-api/internal/app"
+	"github.com/ilbagatto/tarot-api/internal/cache"
+	"github.com/ilbagatto/tarot-api/internal/config"
 	"github.com/ilbagatto/tarot-api/internal/db"
+	"github.com/ilbagatto/tarot-api/internal/logging"
+	"github.com/ilbagatto/tarot-api/internal/repository/memory"
+	"github.com/ilbagatto/tarot-api/internal/repository/postgres"
 	"github.com/ilbagatto/tarot-api/internal/routes"
+	"github.com/ilbagatto/tarot-api/internal/utils"
 	"github.com/joho/godotenv"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
 type TestApp struct {
 	App *app.App
+	// Store holds the data of an in-memory app; nil for a database-backed one
+	Store *memory.Store
 }
 
 func loadEnvFromProjectRoot() {
@@ -34,15 +47,35 @@ func loadEnvFromProjectRoot() {
 // SetupTestApp initializes the application for integration tests
 func SetupTestApp() *TestApp {
 	loadEnvFromProjectRoot()
-	database, err := db.InitDB()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("invalid test configuration: %v", err)
+	}
+	utils.SetStaticURL(cfg.Static.URL)
+	utils.SetImageVariants(cfg.Static.Sizes, cfg.Static.Formats)
+
+	database, err := db.InitDB(cfg.Database)
 	if err != nil {
 		log.Fatalf("failed to connect to test database: %v", err)
 	}
-	a := app.NewApp(database)
+	if _, err := db.Migrate(context.Background(), database, db.DialectOf(cfg.Database.DSN)); err != nil {
+		log.Fatalf("failed to migrate test database: %v", err)
+	}
+	a := app.NewApp(cfg, database, postgres.New(database), cache.New(cfg.Cache.TTL, cfg.Cache.MaxEntries), logging.NewLogger(cfg.Log.Format))
 	routes.InitRoutes(a)
 	return &TestApp{App: a}
 }
 
+// SetupMemoryApp initializes the application on in-memory repositories.
+// It needs no database and is meant for fast handler tests.
+func SetupMemoryApp() *TestApp {
+	cfg := config.Default()
+	store := memory.NewStore()
+	a := app.NewApp(cfg, nil, store.Repositories(), cache.New(cfg.Cache.TTL, cfg.Cache.MaxEntries), zap.NewNop())
+	routes.InitRoutes(a)
+	return &TestApp{App: a, Store: store}
+}
+
 // Close shuts down the test app and closes the database
 func (ta *TestApp) Close() {
 	if ta.App.DB != nil {
@@ -57,3 +90,16 @@ func (ta *TestApp) Request(method, path string, body http.Handler) *httptest.Res
 	ta.App.Echo.ServeHTTP(rec, req)
 	return rec
 }
+
+// RequestJSON makes a test HTTP request with a JSON-encoded body
+func (ta *TestApp) RequestJSON(method, path string, body any) *httptest.ResponseRecorder {
+	data, err := json.Marshal(body)
+	if err != nil {
+		log.Fatalf("failed to encode request body: %v", err)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ta.App.Echo.ServeHTTP(rec, req)
+	return rec
+}
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName is the instrumentation name of the HTTP server spans
+const ServiceName = "tarot-api"
+
+// Setup installs the global tracer provider for the given exporter kind:
+//
+//   - "otlp":   OTLP over HTTP, configured by the standard OTEL_EXPORTER_OTLP_* variables
+//   - "stdout": pretty-printed spans on stdout, useful for local debugging
+//   - "none" or empty: tracing disabled (spans are created but not exported)
+//
+// The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, kind, serviceName string) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch kind {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return Install(exporter, serviceName).Shutdown, nil
+}
+
+// Install registers a tracer provider exporting to the given exporter as the global one.
+// Tests can pass an in-memory exporter (tracetest.NewInMemoryExporter).
+func Install(exporter sdktrace.SpanExporter, serviceName string) *sdktrace.TracerProvider {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+	return provider
+}
@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), "none", ServiceName)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), "zipkin", ServiceName)
+	assert.ErrorContains(t, err, "zipkin")
+}
+
+func TestInstall_RecordsRequestSpans(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := Install(exporter, ServiceName)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	e := echo.New()
+	e.Use(otelecho.Middleware(ServiceName))
+	e.GET("/decks/:id", func(c echo.Context) error {
+		_, span := otel.Tracer("test").Start(c.Request().Context(), "models.GetDeckByID")
+		span.End()
+		return c.NoContent(http.StatusOK)
+	})
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/decks/1", nil))
+
+	require.NoError(t, provider.ForceFlush(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	child, parent := spans[0], spans[1]
+	assert.Equal(t, "GET /decks/:id", parent.Name)
+	assert.Equal(t, parent.SpanContext.SpanID(), child.Parent.SpanID())
+	assert.Equal(t, parent.SpanContext.TraceID(), child.SpanContext.TraceID())
+}
@@ -1,14 +1,115 @@
 package utils
 
 import (
-	"os"
+	"path"
+	"strconv"
 	"strings"
+	"sync/atomic"
+
+	"github.com/ilbagatto/tarot-api/internal/config"
 )
 
+var staticURL atomic.Pointer[string]
+
+// imageVariants holds the renditions set by SetImageVariants
+var imageVariants atomic.Pointer[variantConfig]
+
+type variantConfig struct {
+	sizes   []config.ImageSize
+	formats []string
+}
+
+// ImageVariant is one file an image is available as
+type ImageVariant struct {
+	Size   string `json:"size" example:"medium"` // "original" or the name of a configured size
+	Format string `json:"format" example:"jpeg"`
+	URL    string `json:"url"`
+	// Width and Height are in pixels, if the size of the original is known
+	Width  int `json:"width,omitempty" example:"600"`
+	Height int `json:"height,omitempty" example:"1050"`
+}
+
+// SetStaticURL sets the public base URL images are served from.
+// It is called once at startup from the application configuration.
+func SetStaticURL(url string) {
+	staticURL.Store(&url)
+}
+
+// SetImageVariants sets the sizes and formats images are rendered in.
+// It is called once at startup from the application configuration.
+func SetImageVariants(sizes []config.ImageSize, formats []string) {
+	imageVariants.Store(&variantConfig{sizes: sizes, formats: formats})
+}
+
+// CardImageRenderPath returns the path under the static URL where the image
+// server renders the image of a card
+func CardImageRenderPath(deckID, cardID int64) string {
+	return "/img/" + strconv.FormatInt(deckID, 10) + "/" + strconv.FormatInt(cardID, 10)
+}
+
+// DeckBackImageRenderPath returns the path under the static URL where the
+// image server renders the card back of a deck
+func DeckBackImageRenderPath(deckID int64) string {
+	return "/img/" + strconv.FormatInt(deckID, 10) + "/back"
+}
+
+// GetImageVariants lists the variants of an image: the original, served like
+// GetImageURL does, and each configured size in each format, rendered by the
+// image server at renderPath (see CardImageRenderPath and DeckBackImageRenderPath). Without a renderPath,
+// only the original is listed. width and height are the size of the original,
+// 0 if unknown; a size is not scaled beyond it. It returns nil if no static
+// URL is configured.
+func GetImageVariants(imgPath, renderPath string, width, height int) []ImageVariant {
+	original := GetImageURL(imgPath, false)
+	if original == nil {
+		return nil
+	}
+	variants := []ImageVariant{{
+		Size: "original", Format: formatOf(path.Ext(imgPath)), URL: *original, Width: width, Height: height,
+	}}
+
+	cfg := imageVariants.Load()
+	if cfg == nil || renderPath == "" {
+		return variants
+	}
+	base := *staticURL.Load()
+	for _, size := range cfg.sizes {
+		w, h := size.Width, 0
+		if width > 0 && width < w {
+			w = width
+		}
+		if width > 0 && height > 0 {
+			h = (height*w + width/2) / width
+		}
+		for _, format := range cfg.formats {
+			variants = append(variants, ImageVariant{
+				Size:   size.Name,
+				Format: format,
+				URL:    base + renderPath + "?w=" + strconv.Itoa(size.Width) + "&fmt=" + format,
+				Width:  w,
+				Height: h,
+			})
+		}
+	}
+	return variants
+}
+
+// formatOf returns the format of an image file by its extension
+func formatOf(ext string) string {
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+	if ext == "jpg" {
+		return "jpeg"
+	}
+	return ext
+}
+
 // GetImageURL constructs a full URL to n image.
-// It returns nil if STATIC_URL is not set.
+// It returns nil if no static URL is configured.
 func GetImageURL(path string, small bool) *string {
-	base := os.Getenv("STATIC_URL")
+	var base string
+	if p := staticURL.Load(); p != nil {
+		base = *p
+	}
 	if base == "" {
 		return nil
 	}
@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL schema migrations applied by `tarot-api migrate up`.
+//
+// Files are named NNNNNN_description.up.sql and applied in version order.
+// Each dialect has its own directory (postgres/, sqlite/) holding the same versions.
+package migrations
+
+import "embed"
+
+//go:embed postgres/*.sql sqlite/*.sql
+var FS embed.FS
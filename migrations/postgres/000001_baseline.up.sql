@@ -0,0 +1,3 @@
+-- Baseline: the initial schema is created by setup-db/init.sql.
+-- Later migrations build on top of it.
+SELECT 1;
@@ -0,0 +1,15 @@
+-- Audit log: one row per create, update or delete made through the API.
+-- changes holds the fields that differ, as {"field": {"before": ..., "after": ...}}.
+CREATE TABLE audit_log (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    at TIMESTAMPTZ NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    entity VARCHAR(30) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    changes JSONB NOT NULL
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity, entity_id);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor);
+CREATE INDEX audit_log_at_idx ON audit_log (at);
@@ -0,0 +1,31 @@
+-- Revisions of meanings: a copy of every version of a meaning, written along
+-- with each insert and update. revision equals the version it copies.
+CREATE TABLE meaning_major_revision (
+    meaning_id INTEGER NOT NULL REFERENCES meaning_major(id) ON DELETE CASCADE,
+    revision BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    number SMALLINT NOT NULL,
+    "position" public.card_position NOT NULL,
+    source INTEGER NOT NULL,
+    meaning TEXT NOT NULL,
+    PRIMARY KEY (meaning_id, revision)
+);
+
+CREATE TABLE meaning_minor_revision (
+    meaning_id INTEGER NOT NULL REFERENCES meaning_minor(id) ON DELETE CASCADE,
+    revision BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    suit INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    "position" public.card_position NOT NULL,
+    source INTEGER NOT NULL,
+    meaning TEXT NOT NULL,
+    PRIMARY KEY (meaning_id, revision)
+);
+
+-- The current versions of existing meanings are their first known revisions
+INSERT INTO meaning_major_revision (meaning_id, revision, created_at, number, "position", source, meaning)
+SELECT id, version, updated_at, number, "position", source, COALESCE(meaning, '') FROM meaning_major;
+
+INSERT INTO meaning_minor_revision (meaning_id, revision, created_at, suit, rank, "position", source, meaning)
+SELECT id, version, updated_at, suit, rank, "position", source, COALESCE(meaning, '') FROM meaning_minor;
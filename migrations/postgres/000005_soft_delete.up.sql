@@ -0,0 +1,28 @@
+-- Soft deletion of decks, sources and cards: a delete sets deleted_at, which
+-- hides the row (and the cards of a deleted deck) until it is restored from
+-- the trash or purged. Restores and purges are recorded in the audit log.
+ALTER TABLE deck ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE source ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE card ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX deck_deleted_at_idx ON deck (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX source_deleted_at_idx ON source (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX card_deleted_at_idx ON card (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE OR REPLACE VIEW deck_with_stats AS
+SELECT d.id,
+    d.name,
+    d.description,
+    d.image,
+    EXISTS (
+        SELECT 1
+        FROM card_minor cm
+        JOIN card c ON c.id = cm.card
+        WHERE c.deck = d.id AND c.deleted_at IS NULL
+    ) AS has_minor_cards
+FROM deck d
+WHERE d.deleted_at IS NULL;
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge'));
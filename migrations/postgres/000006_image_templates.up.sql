@@ -0,0 +1,27 @@
+-- Image path templates: a card without a card_image row gets its image path
+-- from the template of its deck for its arcana, whose {suit} and {rank}
+-- placeholders stand for the slugs of the suit and rank of the card.
+ALTER TABLE deck ADD COLUMN major_image_template VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE deck ADD COLUMN minor_image_template VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE suit ADD COLUMN slug VARCHAR(30) NOT NULL DEFAULT '';
+ALTER TABLE rank ADD COLUMN slug VARCHAR(30) NOT NULL DEFAULT '';
+
+-- Slugs of the standard suits and ranks, as used in the image paths of the seed data
+UPDATE suit SET slug = 'wands' WHERE name = 'Жезлы';
+UPDATE suit SET slug = 'swords' WHERE name = 'Мечи';
+UPDATE suit SET slug = 'cups' WHERE name = 'Кубки';
+UPDATE suit SET slug = 'pentacles' WHERE name = 'Денарии';
+UPDATE rank SET slug = 'ace' WHERE name = 'Туз';
+UPDATE rank SET slug = '2' WHERE name = 'Двойка';
+UPDATE rank SET slug = '3' WHERE name = 'Тройка';
+UPDATE rank SET slug = '4' WHERE name = 'Четверка';
+UPDATE rank SET slug = '5' WHERE name = 'Пятерка';
+UPDATE rank SET slug = '6' WHERE name = 'Шестерка';
+UPDATE rank SET slug = '7' WHERE name = 'Семерка';
+UPDATE rank SET slug = '8' WHERE name = 'Восьмерка';
+UPDATE rank SET slug = '9' WHERE name = 'Девятка';
+UPDATE rank SET slug = '10' WHERE name = 'Десятка';
+UPDATE rank SET slug = 'page' WHERE name = 'Паж';
+UPDATE rank SET slug = 'knight' WHERE name = 'Рыцарь';
+UPDATE rank SET slug = 'queen' WHERE name = 'Королева';
+UPDATE rank SET slug = 'king' WHERE name = 'Король';
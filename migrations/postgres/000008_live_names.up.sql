@@ -0,0 +1,7 @@
+-- Names of decks and sources are unique among the ones not in the trash only,
+-- so that the name of a deleted one can be reused. Restoring a deck or source
+-- whose name has been taken meanwhile fails on the index.
+DROP INDEX deck_name_unique_idx;
+CREATE UNIQUE INDEX deck_name_unique_idx ON deck (name) WHERE deleted_at IS NULL;
+DROP INDEX source_name_unique_idx;
+CREATE UNIQUE INDEX source_name_unique_idx ON source (name) WHERE deleted_at IS NULL;
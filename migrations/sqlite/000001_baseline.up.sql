@@ -0,0 +1,1464 @@
+-- Baseline for the embedded SQLite backend: the schema of setup-db/init.sql
+-- and its seed data. PostgreSQL enums become CHECK constraints, identity
+-- columns become AUTOINCREMENT keys (ids are never reused, like sequences).
+
+CREATE TABLE deck (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    image VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE source (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE deck_source (
+    deck INTEGER NOT NULL REFERENCES deck(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    source INTEGER NOT NULL REFERENCES source(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (deck, source)
+);
+
+CREATE TABLE suit (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    genitive VARCHAR(100) NOT NULL,
+    description TEXT
+);
+
+CREATE TABLE rank (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE spread (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    major_arcana BOOLEAN NOT NULL DEFAULT TRUE,
+    minor_arcana BOOLEAN NOT NULL DEFAULT TRUE,
+    upside_down BOOLEAN NOT NULL DEFAULT TRUE,
+    num_cards SMALLINT NOT NULL DEFAULT 0,
+    description TEXT
+);
+
+CREATE TABLE card (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    deck INTEGER NOT NULL REFERENCES deck(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    arcana TEXT NOT NULL CHECK (arcana IN ('minor', 'major'))
+);
+
+CREATE TABLE card_major (
+    card INTEGER PRIMARY KEY REFERENCES card(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    number SMALLINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    orgname VARCHAR(50)
+);
+
+CREATE TABLE card_minor (
+    card INTEGER PRIMARY KEY REFERENCES card(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    suit INTEGER NOT NULL REFERENCES suit(id),
+    rank INTEGER NOT NULL REFERENCES rank(id)
+);
+
+CREATE TABLE card_image (
+    card INTEGER PRIMARY KEY REFERENCES card(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    path VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE card_combination (
+    card_one INTEGER NOT NULL REFERENCES card(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    card_two INTEGER NOT NULL REFERENCES card(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    source INTEGER NOT NULL REFERENCES source(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    meaning TEXT NOT NULL,
+    PRIMARY KEY (card_one, card_two, source),
+    CHECK (card_one <> card_two)
+);
+
+CREATE TABLE meaning_major (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    number SMALLINT NOT NULL,
+    position TEXT NOT NULL CHECK (position IN ('straight', 'reverted')),
+    source INTEGER NOT NULL REFERENCES source(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    meaning TEXT
+);
+
+CREATE TABLE meaning_minor (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    suit INTEGER NOT NULL REFERENCES suit(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    rank INTEGER NOT NULL REFERENCES rank(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    position TEXT NOT NULL CHECK (position IN ('straight', 'reverted')),
+    source INTEGER NOT NULL REFERENCES source(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    meaning TEXT
+);
+
+CREATE UNIQUE INDEX deck_name_unique_idx ON deck (name);
+CREATE UNIQUE INDEX source_name_unique_idx ON source (name);
+CREATE UNIQUE INDEX suit_name_unique_idx ON suit (name);
+CREATE UNIQUE INDEX rank_name_unique_idx ON rank (name);
+CREATE UNIQUE INDEX spread_name_unique_idx ON spread (name);
+CREATE UNIQUE INDEX meaning_major_uniq ON meaning_major (number, position, source);
+CREATE UNIQUE INDEX meaning_minor_uniq ON meaning_minor (suit, rank, position, source);
+CREATE INDEX card_deck_idx ON card (deck);
+CREATE INDEX card_major_name_idx ON card_major (name);
+CREATE INDEX card_major_numb_idx ON card_major (number);
+
+CREATE VIEW deck_with_stats AS
+SELECT d.id,
+    d.name,
+    d.description,
+    d.image,
+    EXISTS (
+        SELECT 1
+        FROM card_minor cm
+        JOIN card c ON c.id = cm.card
+        WHERE c.deck = d.id
+    ) AS has_minor_cards
+FROM deck d;
+
+-- Seed data
+
+INSERT INTO deck VALUES (1, 'Итальянское Таро', 'Классический дизайн Марсельских колод, обновленный безымянными художниками, пример итальянского искусства 19-го века: подробный, символический и богато окрашенный в энергичные зеленые тона, приглушенные красные и яркий золотой.
+
+
+
+', 'italian/cover.png');
+INSERT INTO deck VALUES (2, 'Марсельское Таро', 'Старинная французская система. Происходит от старинной итальянской колоды Висконти — Сфорца.', 'marseille/cover.png');
+INSERT INTO deck VALUES (3, 'Райдера — Уэйта', 'Стандартная современная система. Состоит из Старших и Младших Арканов; присутствует Белая Карта. Используется популярные изображения Памелы Колман-Смит.', 'rider/cover.png');
+INSERT INTO deck VALUES (4, 'Таро Висконти', 'самая старая из известных полных колод, создана в XV веке, называется по имени заказчиков. Изображения старших арканов выполнены в стиле итальянского Ренессанса и нарисованы вручную с использованием позолоты.', 'visconti/cover.png');
+
+INSERT INTO source VALUES (1, 'Het Monster, "Азбука Таро"');
+INSERT INTO source VALUES (2, 'Мишель Моран, "Марсельское Таро"');
+INSERT INTO source VALUES (3, 'Хайо Банцхаф, "Настольная книга Таро"');
+
+INSERT INTO deck_source VALUES (1, 1);
+INSERT INTO deck_source VALUES (1, 2);
+INSERT INTO deck_source VALUES (1, 3);
+INSERT INTO deck_source VALUES (2, 1);
+INSERT INTO deck_source VALUES (2, 2);
+INSERT INTO deck_source VALUES (2, 3);
+INSERT INTO deck_source VALUES (3, 1);
+INSERT INTO deck_source VALUES (3, 3);
+INSERT INTO deck_source VALUES (4, 1);
+INSERT INTO deck_source VALUES (4, 2);
+INSERT INTO deck_source VALUES (4, 3);
+
+INSERT INTO suit VALUES (1, 'Жезлы', 'Жезлов', NULL);
+INSERT INTO suit VALUES (2, 'Мечи', 'Мечей', NULL);
+INSERT INTO suit VALUES (3, 'Кубки', 'Кубков', NULL);
+INSERT INTO suit VALUES (4, 'Денарии', 'Денариев', NULL);
+
+INSERT INTO rank VALUES (8, 'Восьмерка');
+INSERT INTO rank VALUES (2, 'Двойка');
+INSERT INTO rank VALUES (9, 'Девятка');
+INSERT INTO rank VALUES (10, 'Десятка');
+INSERT INTO rank VALUES (13, 'Королева');
+INSERT INTO rank VALUES (14, 'Король');
+INSERT INTO rank VALUES (11, 'Паж');
+INSERT INTO rank VALUES (5, 'Пятерка');
+INSERT INTO rank VALUES (12, 'Рыцарь');
+INSERT INTO rank VALUES (7, 'Семерка');
+INSERT INTO rank VALUES (3, 'Тройка');
+INSERT INTO rank VALUES (1, 'Туз');
+INSERT INTO rank VALUES (4, 'Четверка');
+INSERT INTO rank VALUES (6, 'Шестерка');
+
+INSERT INTO spread VALUES (1, 'Одна карта — вся колода', true, true, true, 1, 'Традиционный способ гадания. Используются все карты.');
+INSERT INTO spread VALUES (2, 'Одна карта — Старшие Арканы', true, false, true, 1, 'Традиционный способ гадания. Используются только Старшие Арканы.');
+INSERT INTO spread VALUES (3, 'Гороскоп', true, true, false, 12, 'Астрологический расклад, каждая позиция которого интерпретируется по аналогии с домом гороскопа. Перевернутые позиции не допускаются. Используются все карты, включая Белую.');
+INSERT INTO spread VALUES (4, 'Три карты', true, true, true, 3, 'Традиционный расклад: прошлое — настоящее — будущее. Встречаются и другие интерпретации');
+INSERT INTO spread VALUES (5, 'Три карты по Этейлле — мужской', true, true, true, 3, 'Традиционный расклад для мужчины.');
+INSERT INTO spread VALUES (6, 'Три карты по Этейлле — женский', true, true, true, 3, 'Традиционный расклад для женщины.');
+INSERT INTO spread VALUES (7, 'Три карты — Вуаль', true, true, true, 3, 'Первая карта говорит о том, как вопрошающий видит ситуацию, вторая — что ему мешает увидеть правду, третья — что происходит на самом деле.');
+INSERT INTO spread VALUES (8, 'Десять карт', true, false, true, 10, 'Этот способ применяется для ознакомления с личностью человека. Участвуют Старшие Арканы и Белая Карта.');
+INSERT INTO spread VALUES (9, 'Крест из пяти карт', true, false, true, 5, 'Вариант старинного расклада. Используются только 22 Старших Аркана.');
+INSERT INTO spread VALUES (10, 'Путь', true, true, false, 7, 'Стандартный расклад Х.Банцхафа.');
+INSERT INTO spread VALUES (11, 'Крест из 4 карт', true, true, false, 4, 'Вариант старинного расклада, без перевернутых позиций.');
+
+INSERT INTO card VALUES (1, 3, 'minor');
+INSERT INTO card VALUES (2, 3, 'minor');
+INSERT INTO card VALUES (3, 3, 'minor');
+INSERT INTO card VALUES (4, 3, 'minor');
+INSERT INTO card VALUES (5, 3, 'minor');
+INSERT INTO card VALUES (6, 3, 'minor');
+INSERT INTO card VALUES (7, 3, 'minor');
+INSERT INTO card VALUES (8, 3, 'minor');
+INSERT INTO card VALUES (9, 3, 'minor');
+INSERT INTO card VALUES (10, 3, 'minor');
+INSERT INTO card VALUES (11, 3, 'minor');
+INSERT INTO card VALUES (12, 3, 'minor');
+INSERT INTO card VALUES (13, 3, 'minor');
+INSERT INTO card VALUES (14, 3, 'minor');
+INSERT INTO card VALUES (15, 3, 'minor');
+INSERT INTO card VALUES (16, 3, 'minor');
+INSERT INTO card VALUES (17, 3, 'minor');
+INSERT INTO card VALUES (18, 3, 'minor');
+INSERT INTO card VALUES (19, 3, 'minor');
+INSERT INTO card VALUES (20, 3, 'minor');
+INSERT INTO card VALUES (21, 3, 'minor');
+INSERT INTO card VALUES (22, 3, 'minor');
+INSERT INTO card VALUES (23, 3, 'minor');
+INSERT INTO card VALUES (24, 3, 'minor');
+INSERT INTO card VALUES (25, 3, 'minor');
+INSERT INTO card VALUES (26, 3, 'minor');
+INSERT INTO card VALUES (27, 3, 'minor');
+INSERT INTO card VALUES (28, 3, 'minor');
+INSERT INTO card VALUES (29, 3, 'minor');
+INSERT INTO card VALUES (30, 3, 'minor');
+INSERT INTO card VALUES (31, 3, 'minor');
+INSERT INTO card VALUES (32, 3, 'minor');
+INSERT INTO card VALUES (33, 3, 'minor');
+INSERT INTO card VALUES (34, 3, 'minor');
+INSERT INTO card VALUES (35, 3, 'minor');
+INSERT INTO card VALUES (36, 3, 'minor');
+INSERT INTO card VALUES (37, 3, 'minor');
+INSERT INTO card VALUES (38, 3, 'minor');
+INSERT INTO card VALUES (39, 3, 'minor');
+INSERT INTO card VALUES (40, 3, 'minor');
+INSERT INTO card VALUES (41, 3, 'minor');
+INSERT INTO card VALUES (42, 3, 'minor');
+INSERT INTO card VALUES (43, 3, 'minor');
+INSERT INTO card VALUES (44, 3, 'minor');
+INSERT INTO card VALUES (45, 3, 'minor');
+INSERT INTO card VALUES (46, 3, 'minor');
+INSERT INTO card VALUES (47, 3, 'minor');
+INSERT INTO card VALUES (48, 3, 'minor');
+INSERT INTO card VALUES (49, 3, 'minor');
+INSERT INTO card VALUES (50, 3, 'minor');
+INSERT INTO card VALUES (51, 3, 'minor');
+INSERT INTO card VALUES (52, 3, 'minor');
+INSERT INTO card VALUES (53, 3, 'minor');
+INSERT INTO card VALUES (54, 3, 'minor');
+INSERT INTO card VALUES (55, 3, 'minor');
+INSERT INTO card VALUES (56, 3, 'minor');
+INSERT INTO card VALUES (57, 3, 'major');
+INSERT INTO card VALUES (58, 3, 'major');
+INSERT INTO card VALUES (59, 3, 'major');
+INSERT INTO card VALUES (60, 3, 'major');
+INSERT INTO card VALUES (61, 3, 'major');
+INSERT INTO card VALUES (62, 3, 'major');
+INSERT INTO card VALUES (63, 3, 'major');
+INSERT INTO card VALUES (64, 3, 'major');
+INSERT INTO card VALUES (65, 3, 'major');
+INSERT INTO card VALUES (66, 3, 'major');
+INSERT INTO card VALUES (67, 3, 'major');
+INSERT INTO card VALUES (68, 3, 'major');
+INSERT INTO card VALUES (69, 3, 'major');
+INSERT INTO card VALUES (70, 3, 'major');
+INSERT INTO card VALUES (71, 3, 'major');
+INSERT INTO card VALUES (72, 3, 'major');
+INSERT INTO card VALUES (73, 3, 'major');
+INSERT INTO card VALUES (74, 3, 'major');
+INSERT INTO card VALUES (75, 3, 'major');
+INSERT INTO card VALUES (76, 3, 'major');
+INSERT INTO card VALUES (77, 3, 'major');
+INSERT INTO card VALUES (78, 3, 'major');
+INSERT INTO card VALUES (79, 3, 'major');
+INSERT INTO card VALUES (80, 2, 'minor');
+INSERT INTO card VALUES (81, 2, 'minor');
+INSERT INTO card VALUES (82, 2, 'minor');
+INSERT INTO card VALUES (83, 2, 'minor');
+INSERT INTO card VALUES (84, 2, 'minor');
+INSERT INTO card VALUES (85, 2, 'minor');
+INSERT INTO card VALUES (86, 2, 'minor');
+INSERT INTO card VALUES (87, 2, 'minor');
+INSERT INTO card VALUES (88, 2, 'minor');
+INSERT INTO card VALUES (89, 2, 'minor');
+INSERT INTO card VALUES (90, 2, 'minor');
+INSERT INTO card VALUES (91, 2, 'minor');
+INSERT INTO card VALUES (92, 2, 'minor');
+INSERT INTO card VALUES (93, 2, 'minor');
+INSERT INTO card VALUES (94, 2, 'minor');
+INSERT INTO card VALUES (95, 2, 'minor');
+INSERT INTO card VALUES (96, 2, 'minor');
+INSERT INTO card VALUES (97, 2, 'minor');
+INSERT INTO card VALUES (98, 2, 'minor');
+INSERT INTO card VALUES (99, 2, 'minor');
+INSERT INTO card VALUES (100, 2, 'minor');
+INSERT INTO card VALUES (101, 2, 'minor');
+INSERT INTO card VALUES (102, 2, 'minor');
+INSERT INTO card VALUES (103, 2, 'minor');
+INSERT INTO card VALUES (104, 2, 'minor');
+INSERT INTO card VALUES (105, 2, 'minor');
+INSERT INTO card VALUES (106, 2, 'minor');
+INSERT INTO card VALUES (107, 2, 'minor');
+INSERT INTO card VALUES (108, 2, 'minor');
+INSERT INTO card VALUES (109, 2, 'minor');
+INSERT INTO card VALUES (110, 2, 'minor');
+INSERT INTO card VALUES (111, 2, 'minor');
+INSERT INTO card VALUES (112, 2, 'minor');
+INSERT INTO card VALUES (113, 2, 'minor');
+INSERT INTO card VALUES (114, 2, 'minor');
+INSERT INTO card VALUES (115, 2, 'minor');
+INSERT INTO card VALUES (116, 2, 'minor');
+INSERT INTO card VALUES (117, 2, 'minor');
+INSERT INTO card VALUES (118, 2, 'minor');
+INSERT INTO card VALUES (119, 2, 'minor');
+INSERT INTO card VALUES (120, 2, 'minor');
+INSERT INTO card VALUES (121, 2, 'minor');
+INSERT INTO card VALUES (122, 2, 'minor');
+INSERT INTO card VALUES (123, 2, 'minor');
+INSERT INTO card VALUES (124, 2, 'minor');
+INSERT INTO card VALUES (125, 2, 'minor');
+INSERT INTO card VALUES (126, 2, 'minor');
+INSERT INTO card VALUES (127, 2, 'minor');
+INSERT INTO card VALUES (128, 2, 'minor');
+INSERT INTO card VALUES (129, 2, 'minor');
+INSERT INTO card VALUES (130, 2, 'minor');
+INSERT INTO card VALUES (131, 2, 'minor');
+INSERT INTO card VALUES (132, 2, 'minor');
+INSERT INTO card VALUES (133, 2, 'minor');
+INSERT INTO card VALUES (134, 2, 'minor');
+INSERT INTO card VALUES (135, 2, 'minor');
+INSERT INTO card VALUES (136, 2, 'major');
+INSERT INTO card VALUES (137, 2, 'major');
+INSERT INTO card VALUES (138, 2, 'major');
+INSERT INTO card VALUES (139, 2, 'major');
+INSERT INTO card VALUES (140, 2, 'major');
+INSERT INTO card VALUES (141, 2, 'major');
+INSERT INTO card VALUES (142, 2, 'major');
+INSERT INTO card VALUES (143, 2, 'major');
+INSERT INTO card VALUES (144, 2, 'major');
+INSERT INTO card VALUES (145, 2, 'major');
+INSERT INTO card VALUES (146, 2, 'major');
+INSERT INTO card VALUES (147, 2, 'major');
+INSERT INTO card VALUES (148, 2, 'major');
+INSERT INTO card VALUES (149, 2, 'major');
+INSERT INTO card VALUES (150, 2, 'major');
+INSERT INTO card VALUES (151, 2, 'major');
+INSERT INTO card VALUES (152, 2, 'major');
+INSERT INTO card VALUES (153, 2, 'major');
+INSERT INTO card VALUES (154, 2, 'major');
+INSERT INTO card VALUES (155, 2, 'major');
+INSERT INTO card VALUES (156, 2, 'major');
+INSERT INTO card VALUES (157, 2, 'major');
+INSERT INTO card VALUES (158, 1, 'minor');
+INSERT INTO card VALUES (159, 1, 'minor');
+INSERT INTO card VALUES (160, 1, 'minor');
+INSERT INTO card VALUES (161, 1, 'minor');
+INSERT INTO card VALUES (162, 1, 'minor');
+INSERT INTO card VALUES (163, 1, 'minor');
+INSERT INTO card VALUES (164, 1, 'minor');
+INSERT INTO card VALUES (165, 1, 'minor');
+INSERT INTO card VALUES (166, 1, 'minor');
+INSERT INTO card VALUES (167, 1, 'minor');
+INSERT INTO card VALUES (168, 1, 'minor');
+INSERT INTO card VALUES (169, 1, 'minor');
+INSERT INTO card VALUES (170, 1, 'minor');
+INSERT INTO card VALUES (171, 1, 'minor');
+INSERT INTO card VALUES (172, 1, 'minor');
+INSERT INTO card VALUES (173, 1, 'minor');
+INSERT INTO card VALUES (174, 1, 'minor');
+INSERT INTO card VALUES (175, 1, 'minor');
+INSERT INTO card VALUES (176, 1, 'minor');
+INSERT INTO card VALUES (177, 1, 'minor');
+INSERT INTO card VALUES (178, 1, 'minor');
+INSERT INTO card VALUES (179, 1, 'minor');
+INSERT INTO card VALUES (180, 1, 'minor');
+INSERT INTO card VALUES (181, 1, 'minor');
+INSERT INTO card VALUES (182, 1, 'minor');
+INSERT INTO card VALUES (183, 1, 'minor');
+INSERT INTO card VALUES (184, 1, 'minor');
+INSERT INTO card VALUES (185, 1, 'minor');
+INSERT INTO card VALUES (186, 1, 'minor');
+INSERT INTO card VALUES (187, 1, 'minor');
+INSERT INTO card VALUES (188, 1, 'minor');
+INSERT INTO card VALUES (189, 1, 'minor');
+INSERT INTO card VALUES (190, 1, 'minor');
+INSERT INTO card VALUES (191, 1, 'minor');
+INSERT INTO card VALUES (192, 1, 'minor');
+INSERT INTO card VALUES (193, 1, 'minor');
+INSERT INTO card VALUES (194, 1, 'minor');
+INSERT INTO card VALUES (195, 1, 'minor');
+INSERT INTO card VALUES (196, 1, 'minor');
+INSERT INTO card VALUES (197, 1, 'minor');
+INSERT INTO card VALUES (198, 1, 'minor');
+INSERT INTO card VALUES (199, 1, 'minor');
+INSERT INTO card VALUES (200, 1, 'minor');
+INSERT INTO card VALUES (201, 1, 'minor');
+INSERT INTO card VALUES (202, 1, 'minor');
+INSERT INTO card VALUES (203, 1, 'minor');
+INSERT INTO card VALUES (204, 1, 'minor');
+INSERT INTO card VALUES (205, 1, 'minor');
+INSERT INTO card VALUES (206, 1, 'minor');
+INSERT INTO card VALUES (207, 1, 'minor');
+INSERT INTO card VALUES (208, 1, 'minor');
+INSERT INTO card VALUES (209, 1, 'minor');
+INSERT INTO card VALUES (210, 1, 'minor');
+INSERT INTO card VALUES (211, 1, 'minor');
+INSERT INTO card VALUES (212, 1, 'minor');
+INSERT INTO card VALUES (213, 1, 'minor');
+INSERT INTO card VALUES (214, 1, 'major');
+INSERT INTO card VALUES (215, 1, 'major');
+INSERT INTO card VALUES (216, 1, 'major');
+INSERT INTO card VALUES (217, 1, 'major');
+INSERT INTO card VALUES (218, 1, 'major');
+INSERT INTO card VALUES (219, 1, 'major');
+INSERT INTO card VALUES (220, 1, 'major');
+INSERT INTO card VALUES (221, 1, 'major');
+INSERT INTO card VALUES (222, 1, 'major');
+INSERT INTO card VALUES (223, 1, 'major');
+INSERT INTO card VALUES (224, 1, 'major');
+INSERT INTO card VALUES (225, 1, 'major');
+INSERT INTO card VALUES (226, 1, 'major');
+INSERT INTO card VALUES (227, 1, 'major');
+INSERT INTO card VALUES (228, 1, 'major');
+INSERT INTO card VALUES (229, 1, 'major');
+INSERT INTO card VALUES (230, 1, 'major');
+INSERT INTO card VALUES (231, 1, 'major');
+INSERT INTO card VALUES (232, 1, 'major');
+INSERT INTO card VALUES (233, 1, 'major');
+INSERT INTO card VALUES (234, 1, 'major');
+INSERT INTO card VALUES (235, 1, 'major');
+INSERT INTO card VALUES (236, 4, 'major');
+INSERT INTO card VALUES (237, 4, 'major');
+INSERT INTO card VALUES (238, 4, 'major');
+INSERT INTO card VALUES (239, 4, 'major');
+INSERT INTO card VALUES (240, 4, 'major');
+INSERT INTO card VALUES (241, 4, 'major');
+INSERT INTO card VALUES (242, 4, 'major');
+INSERT INTO card VALUES (243, 4, 'major');
+INSERT INTO card VALUES (244, 4, 'major');
+INSERT INTO card VALUES (245, 4, 'major');
+INSERT INTO card VALUES (246, 4, 'major');
+INSERT INTO card VALUES (247, 4, 'major');
+INSERT INTO card VALUES (248, 4, 'major');
+INSERT INTO card VALUES (249, 4, 'major');
+INSERT INTO card VALUES (250, 4, 'major');
+INSERT INTO card VALUES (251, 4, 'major');
+INSERT INTO card VALUES (252, 4, 'major');
+INSERT INTO card VALUES (253, 4, 'major');
+INSERT INTO card VALUES (254, 4, 'major');
+INSERT INTO card VALUES (255, 4, 'major');
+INSERT INTO card VALUES (256, 4, 'major');
+INSERT INTO card VALUES (257, 4, 'major');
+
+INSERT INTO card_major VALUES (57, 0, 'Шут', 'The Fool');
+INSERT INTO card_major VALUES (58, 1, 'Маг', 'The Magician');
+INSERT INTO card_major VALUES (59, 2, 'Жрица', 'The High Priestess');
+INSERT INTO card_major VALUES (60, 3, 'Императрица', 'The Empress');
+INSERT INTO card_major VALUES (61, 4, 'Император', 'The Emperor');
+INSERT INTO card_major VALUES (62, 5, 'Первосвященник', 'The Hierophant');
+INSERT INTO card_major VALUES (63, 6, 'Влюбленные', 'The Lovers');
+INSERT INTO card_major VALUES (64, 7, 'Повозка', 'The Chariot');
+INSERT INTO card_major VALUES (65, 8, 'Сила', 'Strength');
+INSERT INTO card_major VALUES (66, 9, 'Отшельник', 'The Hermit');
+INSERT INTO card_major VALUES (67, 10, 'Колесо Фортуны', 'Wheel Of Fortune');
+INSERT INTO card_major VALUES (68, 11, 'Справедливость', 'Justice');
+INSERT INTO card_major VALUES (69, 12, 'Повешенный', 'The Hanged Man');
+INSERT INTO card_major VALUES (70, 13, 'Смерть', 'Death');
+INSERT INTO card_major VALUES (71, 14, 'Умеренность', 'Temperance');
+INSERT INTO card_major VALUES (72, 15, 'Диавол', 'The Devil');
+INSERT INTO card_major VALUES (73, 16, 'Башня', 'The Tower');
+INSERT INTO card_major VALUES (74, 17, 'Звезда', 'The Star');
+INSERT INTO card_major VALUES (75, 18, 'Луна', 'The Moon');
+INSERT INTO card_major VALUES (76, 19, 'Солнце', 'The Sun');
+INSERT INTO card_major VALUES (77, 20, 'Суд', 'Judgement');
+INSERT INTO card_major VALUES (78, 21, 'Мир', 'The World');
+INSERT INTO card_major VALUES (79, 22, 'Белая карта', 'Blank Card');
+INSERT INTO card_major VALUES (136, 0, 'Шут', 'Le Mat');
+INSERT INTO card_major VALUES (137, 1, 'Фокусник', 'Le Bateleur');
+INSERT INTO card_major VALUES (138, 2, 'Жрица', 'La Papesse');
+INSERT INTO card_major VALUES (139, 3, 'Императрица', 'L''Imperatrice');
+INSERT INTO card_major VALUES (140, 4, 'Император', 'Le Empereur');
+INSERT INTO card_major VALUES (141, 5, 'Первосвященник', 'Le Pape');
+INSERT INTO card_major VALUES (142, 6, 'Влюбленные', 'L''Amoureux');
+INSERT INTO card_major VALUES (143, 7, 'Колесница', 'Le Chariot');
+INSERT INTO card_major VALUES (144, 8, 'Справедливость', 'La Justice');
+INSERT INTO card_major VALUES (145, 9, 'Отшельник', 'L''Hermite');
+INSERT INTO card_major VALUES (146, 10, 'Колесо Фортуны', 'La Roue De Fortune');
+INSERT INTO card_major VALUES (147, 11, 'Сила', 'La Force');
+INSERT INTO card_major VALUES (148, 12, 'Повешенный', 'Le Pendu');
+INSERT INTO card_major VALUES (149, 13, 'Смерть', 'La Mort');
+INSERT INTO card_major VALUES (150, 14, 'Умеренность', 'Temperance');
+INSERT INTO card_major VALUES (151, 15, 'Дьявол', 'Le Diable');
+INSERT INTO card_major VALUES (152, 16, 'Разрушаемая Башня', 'La Maison Dieu');
+INSERT INTO card_major VALUES (153, 17, 'Звезда', 'L''Etoile');
+INSERT INTO card_major VALUES (154, 18, 'Луна', 'La Lune');
+INSERT INTO card_major VALUES (155, 19, 'Солнце', 'Le Soleil');
+INSERT INTO card_major VALUES (156, 20, 'Суд', 'Le Jugement');
+INSERT INTO card_major VALUES (157, 21, 'Мир', 'Le Monde');
+INSERT INTO card_major VALUES (214, 0, 'Шут', 'Il Matto');
+INSERT INTO card_major VALUES (215, 1, 'Фокусник', 'Il Bagatto');
+INSERT INTO card_major VALUES (216, 2, 'Жрица', 'La Papessa');
+INSERT INTO card_major VALUES (217, 3, 'Императрица', 'L''Imperatrice');
+INSERT INTO card_major VALUES (218, 4, 'Император', 'L''Imperatore');
+INSERT INTO card_major VALUES (219, 5, 'Первосвященник', 'Il Papa');
+INSERT INTO card_major VALUES (220, 6, 'Влюбленные', 'Gli Amanti');
+INSERT INTO card_major VALUES (221, 7, 'Колесница', 'Il Carro');
+INSERT INTO card_major VALUES (222, 8, 'Справедливость', 'La Giustizia');
+INSERT INTO card_major VALUES (223, 9, 'Отшельник', 'L''Eremita');
+INSERT INTO card_major VALUES (224, 10, 'Колесо Фортуны', 'La Ruota');
+INSERT INTO card_major VALUES (225, 11, 'Сила', 'La Forza');
+INSERT INTO card_major VALUES (226, 12, 'Повешенный', 'L''Appeso');
+INSERT INTO card_major VALUES (227, 13, 'Тринадцать', 'Il Tredici');
+INSERT INTO card_major VALUES (228, 14, 'Умеренность', 'La Temperanza');
+INSERT INTO card_major VALUES (229, 15, 'Дьявол', 'Il Diavolo');
+INSERT INTO card_major VALUES (230, 16, 'Башня', 'La Torre');
+INSERT INTO card_major VALUES (231, 17, 'Звезда', 'Le Stelle');
+INSERT INTO card_major VALUES (232, 18, 'Луна', 'La Luna');
+INSERT INTO card_major VALUES (233, 19, 'Солнце', 'Il Sole');
+INSERT INTO card_major VALUES (234, 20, 'Суд', 'Il Giudizio');
+INSERT INTO card_major VALUES (235, 21, 'Мир', 'Il Mondo');
+INSERT INTO card_major VALUES (236, 0, 'Дурак', 'Il Matto');
+INSERT INTO card_major VALUES (237, 1, 'Маг', 'Il Bagatto');
+INSERT INTO card_major VALUES (238, 2, 'Верховная Жрица', 'La Papessa');
+INSERT INTO card_major VALUES (239, 3, 'Императрица', 'L''Imperatrice');
+INSERT INTO card_major VALUES (240, 4, 'Император', 'L''Imperatore');
+INSERT INTO card_major VALUES (241, 5, 'Иерофант', 'Il Papa');
+INSERT INTO card_major VALUES (242, 6, 'Влюбленные', 'Gli Amanti');
+INSERT INTO card_major VALUES (243, 7, 'Колесница', 'Il Carro');
+INSERT INTO card_major VALUES (244, 8, 'Правосудие', 'La Giustizia');
+INSERT INTO card_major VALUES (245, 9, 'Отшельник', 'L''Eremita');
+INSERT INTO card_major VALUES (246, 10, 'Колесо Фортуны', 'La Ruota');
+INSERT INTO card_major VALUES (247, 11, 'Сила', 'La Forza');
+INSERT INTO card_major VALUES (248, 12, 'Повешеный', 'L''Appeso');
+INSERT INTO card_major VALUES (249, 13, 'Смерть', 'La Morte');
+INSERT INTO card_major VALUES (250, 14, 'Умеренность', 'La Temperanza');
+INSERT INTO card_major VALUES (251, 15, 'Дьявол', 'Il Diavolo');
+INSERT INTO card_major VALUES (252, 16, 'Башня', 'La Torre');
+INSERT INTO card_major VALUES (253, 17, 'Звезда', 'Le Stelle');
+INSERT INTO card_major VALUES (254, 18, 'Луна', 'La Luna');
+INSERT INTO card_major VALUES (255, 19, 'Солнце', 'Il Sole');
+INSERT INTO card_major VALUES (256, 20, 'Страшный Суд', 'Il Giudizio');
+INSERT INTO card_major VALUES (257, 21, 'Мир', 'Il Mondo');
+
+INSERT INTO card_minor VALUES (1, 1, 1);
+INSERT INTO card_minor VALUES (2, 1, 2);
+INSERT INTO card_minor VALUES (3, 1, 3);
+INSERT INTO card_minor VALUES (4, 1, 4);
+INSERT INTO card_minor VALUES (5, 1, 5);
+INSERT INTO card_minor VALUES (6, 1, 6);
+INSERT INTO card_minor VALUES (7, 1, 7);
+INSERT INTO card_minor VALUES (8, 1, 8);
+INSERT INTO card_minor VALUES (9, 1, 9);
+INSERT INTO card_minor VALUES (10, 1, 10);
+INSERT INTO card_minor VALUES (11, 1, 11);
+INSERT INTO card_minor VALUES (12, 1, 12);
+INSERT INTO card_minor VALUES (13, 1, 13);
+INSERT INTO card_minor VALUES (14, 1, 14);
+INSERT INTO card_minor VALUES (15, 2, 1);
+INSERT INTO card_minor VALUES (16, 2, 2);
+INSERT INTO card_minor VALUES (17, 2, 3);
+INSERT INTO card_minor VALUES (18, 2, 4);
+INSERT INTO card_minor VALUES (19, 2, 5);
+INSERT INTO card_minor VALUES (20, 2, 6);
+INSERT INTO card_minor VALUES (21, 2, 7);
+INSERT INTO card_minor VALUES (22, 2, 8);
+INSERT INTO card_minor VALUES (23, 2, 9);
+INSERT INTO card_minor VALUES (24, 2, 10);
+INSERT INTO card_minor VALUES (25, 2, 11);
+INSERT INTO card_minor VALUES (26, 2, 12);
+INSERT INTO card_minor VALUES (27, 2, 13);
+INSERT INTO card_minor VALUES (28, 2, 14);
+INSERT INTO card_minor VALUES (29, 3, 1);
+INSERT INTO card_minor VALUES (30, 3, 2);
+INSERT INTO card_minor VALUES (31, 3, 3);
+INSERT INTO card_minor VALUES (32, 3, 4);
+INSERT INTO card_minor VALUES (33, 3, 5);
+INSERT INTO card_minor VALUES (34, 3, 6);
+INSERT INTO card_minor VALUES (35, 3, 7);
+INSERT INTO card_minor VALUES (36, 3, 8);
+INSERT INTO card_minor VALUES (37, 3, 9);
+INSERT INTO card_minor VALUES (38, 3, 10);
+INSERT INTO card_minor VALUES (39, 3, 11);
+INSERT INTO card_minor VALUES (40, 3, 12);
+INSERT INTO card_minor VALUES (41, 3, 13);
+INSERT INTO card_minor VALUES (42, 3, 14);
+INSERT INTO card_minor VALUES (43, 4, 1);
+INSERT INTO card_minor VALUES (44, 4, 2);
+INSERT INTO card_minor VALUES (45, 4, 3);
+INSERT INTO card_minor VALUES (46, 4, 4);
+INSERT INTO card_minor VALUES (47, 4, 5);
+INSERT INTO card_minor VALUES (48, 4, 6);
+INSERT INTO card_minor VALUES (49, 4, 7);
+INSERT INTO card_minor VALUES (50, 4, 8);
+INSERT INTO card_minor VALUES (51, 4, 9);
+INSERT INTO card_minor VALUES (52, 4, 10);
+INSERT INTO card_minor VALUES (53, 4, 11);
+INSERT INTO card_minor VALUES (54, 4, 12);
+INSERT INTO card_minor VALUES (55, 4, 13);
+INSERT INTO card_minor VALUES (56, 4, 14);
+INSERT INTO card_minor VALUES (80, 1, 1);
+INSERT INTO card_minor VALUES (81, 1, 2);
+INSERT INTO card_minor VALUES (82, 1, 3);
+INSERT INTO card_minor VALUES (83, 1, 4);
+INSERT INTO card_minor VALUES (84, 1, 5);
+INSERT INTO card_minor VALUES (85, 1, 6);
+INSERT INTO card_minor VALUES (86, 1, 7);
+INSERT INTO card_minor VALUES (87, 1, 8);
+INSERT INTO card_minor VALUES (88, 1, 9);
+INSERT INTO card_minor VALUES (89, 1, 10);
+INSERT INTO card_minor VALUES (90, 1, 11);
+INSERT INTO card_minor VALUES (91, 1, 12);
+INSERT INTO card_minor VALUES (92, 1, 13);
+INSERT INTO card_minor VALUES (93, 1, 14);
+INSERT INTO card_minor VALUES (94, 2, 1);
+INSERT INTO card_minor VALUES (95, 2, 2);
+INSERT INTO card_minor VALUES (96, 2, 3);
+INSERT INTO card_minor VALUES (97, 2, 4);
+INSERT INTO card_minor VALUES (98, 2, 5);
+INSERT INTO card_minor VALUES (99, 2, 6);
+INSERT INTO card_minor VALUES (100, 2, 7);
+INSERT INTO card_minor VALUES (101, 2, 8);
+INSERT INTO card_minor VALUES (102, 2, 9);
+INSERT INTO card_minor VALUES (103, 2, 10);
+INSERT INTO card_minor VALUES (104, 2, 11);
+INSERT INTO card_minor VALUES (105, 2, 12);
+INSERT INTO card_minor VALUES (106, 2, 13);
+INSERT INTO card_minor VALUES (107, 2, 14);
+INSERT INTO card_minor VALUES (108, 3, 1);
+INSERT INTO card_minor VALUES (109, 3, 2);
+INSERT INTO card_minor VALUES (110, 3, 3);
+INSERT INTO card_minor VALUES (111, 3, 4);
+INSERT INTO card_minor VALUES (112, 3, 5);
+INSERT INTO card_minor VALUES (113, 3, 6);
+INSERT INTO card_minor VALUES (114, 3, 7);
+INSERT INTO card_minor VALUES (115, 3, 8);
+INSERT INTO card_minor VALUES (116, 3, 9);
+INSERT INTO card_minor VALUES (117, 3, 10);
+INSERT INTO card_minor VALUES (118, 3, 11);
+INSERT INTO card_minor VALUES (119, 3, 12);
+INSERT INTO card_minor VALUES (120, 3, 13);
+INSERT INTO card_minor VALUES (121, 3, 14);
+INSERT INTO card_minor VALUES (122, 4, 1);
+INSERT INTO card_minor VALUES (123, 4, 2);
+INSERT INTO card_minor VALUES (124, 4, 3);
+INSERT INTO card_minor VALUES (125, 4, 4);
+INSERT INTO card_minor VALUES (126, 4, 5);
+INSERT INTO card_minor VALUES (127, 4, 6);
+INSERT INTO card_minor VALUES (128, 4, 7);
+INSERT INTO card_minor VALUES (129, 4, 8);
+INSERT INTO card_minor VALUES (130, 4, 9);
+INSERT INTO card_minor VALUES (131, 4, 10);
+INSERT INTO card_minor VALUES (132, 4, 11);
+INSERT INTO card_minor VALUES (133, 4, 12);
+INSERT INTO card_minor VALUES (134, 4, 13);
+INSERT INTO card_minor VALUES (135, 4, 14);
+INSERT INTO card_minor VALUES (158, 1, 1);
+INSERT INTO card_minor VALUES (159, 1, 2);
+INSERT INTO card_minor VALUES (160, 1, 3);
+INSERT INTO card_minor VALUES (161, 1, 4);
+INSERT INTO card_minor VALUES (162, 1, 5);
+INSERT INTO card_minor VALUES (163, 1, 6);
+INSERT INTO card_minor VALUES (164, 1, 7);
+INSERT INTO card_minor VALUES (165, 1, 8);
+INSERT INTO card_minor VALUES (166, 1, 9);
+INSERT INTO card_minor VALUES (167, 1, 10);
+INSERT INTO card_minor VALUES (168, 1, 11);
+INSERT INTO card_minor VALUES (169, 1, 12);
+INSERT INTO card_minor VALUES (170, 1, 13);
+INSERT INTO card_minor VALUES (171, 1, 14);
+INSERT INTO card_minor VALUES (172, 2, 1);
+INSERT INTO card_minor VALUES (173, 2, 2);
+INSERT INTO card_minor VALUES (174, 2, 3);
+INSERT INTO card_minor VALUES (175, 2, 4);
+INSERT INTO card_minor VALUES (176, 2, 5);
+INSERT INTO card_minor VALUES (177, 2, 6);
+INSERT INTO card_minor VALUES (178, 2, 7);
+INSERT INTO card_minor VALUES (179, 2, 8);
+INSERT INTO card_minor VALUES (180, 2, 9);
+INSERT INTO card_minor VALUES (181, 2, 10);
+INSERT INTO card_minor VALUES (182, 2, 11);
+INSERT INTO card_minor VALUES (183, 2, 12);
+INSERT INTO card_minor VALUES (184, 2, 13);
+INSERT INTO card_minor VALUES (185, 2, 14);
+INSERT INTO card_minor VALUES (186, 3, 1);
+INSERT INTO card_minor VALUES (187, 3, 2);
+INSERT INTO card_minor VALUES (188, 3, 3);
+INSERT INTO card_minor VALUES (189, 3, 4);
+INSERT INTO card_minor VALUES (190, 3, 5);
+INSERT INTO card_minor VALUES (191, 3, 6);
+INSERT INTO card_minor VALUES (192, 3, 7);
+INSERT INTO card_minor VALUES (193, 3, 8);
+INSERT INTO card_minor VALUES (194, 3, 9);
+INSERT INTO card_minor VALUES (195, 3, 10);
+INSERT INTO card_minor VALUES (196, 3, 11);
+INSERT INTO card_minor VALUES (197, 3, 12);
+INSERT INTO card_minor VALUES (198, 3, 13);
+INSERT INTO card_minor VALUES (199, 3, 14);
+INSERT INTO card_minor VALUES (200, 4, 1);
+INSERT INTO card_minor VALUES (201, 4, 2);
+INSERT INTO card_minor VALUES (202, 4, 3);
+INSERT INTO card_minor VALUES (203, 4, 4);
+INSERT INTO card_minor VALUES (204, 4, 5);
+INSERT INTO card_minor VALUES (205, 4, 6);
+INSERT INTO card_minor VALUES (206, 4, 7);
+INSERT INTO card_minor VALUES (207, 4, 8);
+INSERT INTO card_minor VALUES (208, 4, 9);
+INSERT INTO card_minor VALUES (209, 4, 10);
+INSERT INTO card_minor VALUES (210, 4, 11);
+INSERT INTO card_minor VALUES (211, 4, 12);
+INSERT INTO card_minor VALUES (212, 4, 13);
+INSERT INTO card_minor VALUES (213, 4, 14);
+
+INSERT INTO card_image VALUES (1, 'rider/minor/wands/ace.png');
+INSERT INTO card_image VALUES (2, 'rider/minor/wands/2.png');
+INSERT INTO card_image VALUES (3, 'rider/minor/wands/3.png');
+INSERT INTO card_image VALUES (4, 'rider/minor/wands/4.png');
+INSERT INTO card_image VALUES (5, 'rider/minor/wands/5.png');
+INSERT INTO card_image VALUES (6, 'rider/minor/wands/6.png');
+INSERT INTO card_image VALUES (7, 'rider/minor/wands/7.png');
+INSERT INTO card_image VALUES (8, 'rider/minor/wands/8.png');
+INSERT INTO card_image VALUES (9, 'rider/minor/wands/9.png');
+INSERT INTO card_image VALUES (10, 'rider/minor/wands/10.png');
+INSERT INTO card_image VALUES (11, 'rider/minor/wands/page.png');
+INSERT INTO card_image VALUES (12, 'rider/minor/wands/knight.png');
+INSERT INTO card_image VALUES (13, 'rider/minor/wands/queen.png');
+INSERT INTO card_image VALUES (14, 'rider/minor/wands/king.png');
+INSERT INTO card_image VALUES (15, 'rider/minor/swords/ace.png');
+INSERT INTO card_image VALUES (16, 'rider/minor/swords/2.png');
+INSERT INTO card_image VALUES (17, 'rider/minor/swords/3.png');
+INSERT INTO card_image VALUES (18, 'rider/minor/swords/4.png');
+INSERT INTO card_image VALUES (19, 'rider/minor/swords/5.png');
+INSERT INTO card_image VALUES (20, 'rider/minor/swords/6.png');
+INSERT INTO card_image VALUES (21, 'rider/minor/swords/7.png');
+INSERT INTO card_image VALUES (22, 'rider/minor/swords/8.png');
+INSERT INTO card_image VALUES (23, 'rider/minor/swords/9.png');
+INSERT INTO card_image VALUES (24, 'rider/minor/swords/10.png');
+INSERT INTO card_image VALUES (25, 'rider/minor/swords/page.png');
+INSERT INTO card_image VALUES (26, 'rider/minor/swords/knight.png');
+INSERT INTO card_image VALUES (27, 'rider/minor/swords/queen.png');
+INSERT INTO card_image VALUES (28, 'rider/minor/swords/king.png');
+INSERT INTO card_image VALUES (29, 'rider/minor/cups/ace.png');
+INSERT INTO card_image VALUES (30, 'rider/minor/cups/2.png');
+INSERT INTO card_image VALUES (31, 'rider/minor/cups/3.png');
+INSERT INTO card_image VALUES (32, 'rider/minor/cups/4.png');
+INSERT INTO card_image VALUES (33, 'rider/minor/cups/5.png');
+INSERT INTO card_image VALUES (34, 'rider/minor/cups/6.png');
+INSERT INTO card_image VALUES (35, 'rider/minor/cups/7.png');
+INSERT INTO card_image VALUES (36, 'rider/minor/cups/8.png');
+INSERT INTO card_image VALUES (37, 'rider/minor/cups/9.png');
+INSERT INTO card_image VALUES (38, 'rider/minor/cups/10.png');
+INSERT INTO card_image VALUES (39, 'rider/minor/cups/page.png');
+INSERT INTO card_image VALUES (40, 'rider/minor/cups/knight.png');
+INSERT INTO card_image VALUES (41, 'rider/minor/cups/queen.png');
+INSERT INTO card_image VALUES (42, 'rider/minor/cups/king.png');
+INSERT INTO card_image VALUES (43, 'rider/minor/pentacles/ace.png');
+INSERT INTO card_image VALUES (44, 'rider/minor/pentacles/2.png');
+INSERT INTO card_image VALUES (45, 'rider/minor/pentacles/3.png');
+INSERT INTO card_image VALUES (46, 'rider/minor/pentacles/4.png');
+INSERT INTO card_image VALUES (47, 'rider/minor/pentacles/5.png');
+INSERT INTO card_image VALUES (48, 'rider/minor/pentacles/6.png');
+INSERT INTO card_image VALUES (49, 'rider/minor/pentacles/7.png');
+INSERT INTO card_image VALUES (50, 'rider/minor/pentacles/8.png');
+INSERT INTO card_image VALUES (51, 'rider/minor/pentacles/9.png');
+INSERT INTO card_image VALUES (52, 'rider/minor/pentacles/10.png');
+INSERT INTO card_image VALUES (53, 'rider/minor/pentacles/page.png');
+INSERT INTO card_image VALUES (54, 'rider/minor/pentacles/knight.png');
+INSERT INTO card_image VALUES (55, 'rider/minor/pentacles/queen.png');
+INSERT INTO card_image VALUES (56, 'rider/minor/pentacles/king.png');
+INSERT INTO card_image VALUES (57, 'rider/major/fool.png');
+INSERT INTO card_image VALUES (58, 'rider/major/magician.png');
+INSERT INTO card_image VALUES (59, 'rider/major/priestess.png');
+INSERT INTO card_image VALUES (60, 'rider/major/empress.png');
+INSERT INTO card_image VALUES (61, 'rider/major/emperor.png');
+INSERT INTO card_image VALUES (62, 'rider/major/hierophant.png');
+INSERT INTO card_image VALUES (63, 'rider/major/lovers.png');
+INSERT INTO card_image VALUES (64, 'rider/major/chariot.png');
+INSERT INTO card_image VALUES (65, 'rider/major/strength.png');
+INSERT INTO card_image VALUES (66, 'rider/major/hermit.png');
+INSERT INTO card_image VALUES (67, 'rider/major/fortune.png');
+INSERT INTO card_image VALUES (68, 'rider/major/justice.png');
+INSERT INTO card_image VALUES (69, 'rider/major/hanged.png');
+INSERT INTO card_image VALUES (70, 'rider/major/death.png');
+INSERT INTO card_image VALUES (71, 'rider/major/temperance.png');
+INSERT INTO card_image VALUES (72, 'rider/major/devil.png');
+INSERT INTO card_image VALUES (73, 'rider/major/tower.png');
+INSERT INTO card_image VALUES (74, 'rider/major/star.png');
+INSERT INTO card_image VALUES (75, 'rider/major/moon.png');
+INSERT INTO card_image VALUES (76, 'rider/major/sun.png');
+INSERT INTO card_image VALUES (77, 'rider/major/judgement.png');
+INSERT INTO card_image VALUES (78, 'rider/major/world.png');
+INSERT INTO card_image VALUES (79, 'rider/major/blank.png');
+INSERT INTO card_image VALUES (80, 'marseille/minor/wands/01.png');
+INSERT INTO card_image VALUES (81, 'marseille/minor/wands/02.png');
+INSERT INTO card_image VALUES (82, 'marseille/minor/wands/03.png');
+INSERT INTO card_image VALUES (83, 'marseille/minor/wands/04.png');
+INSERT INTO card_image VALUES (84, 'marseille/minor/wands/05.png');
+INSERT INTO card_image VALUES (85, 'marseille/minor/wands/06.png');
+INSERT INTO card_image VALUES (86, 'marseille/minor/wands/07.png');
+INSERT INTO card_image VALUES (87, 'marseille/minor/wands/08.png');
+INSERT INTO card_image VALUES (88, 'marseille/minor/wands/09.png');
+INSERT INTO card_image VALUES (89, 'marseille/minor/wands/10.png');
+INSERT INTO card_image VALUES (90, 'marseille/minor/wands/valet de baton.png');
+INSERT INTO card_image VALUES (91, 'marseille/minor/wands/cavalier de baton.png');
+INSERT INTO card_image VALUES (92, 'marseille/minor/wands/reyne de baton.png');
+INSERT INTO card_image VALUES (93, 'marseille/minor/wands/roy de baton.png');
+INSERT INTO card_image VALUES (94, 'marseille/minor/swords/01.png');
+INSERT INTO card_image VALUES (95, 'marseille/minor/swords/02.png');
+INSERT INTO card_image VALUES (96, 'marseille/minor/swords/03.png');
+INSERT INTO card_image VALUES (97, 'marseille/minor/swords/04.png');
+INSERT INTO card_image VALUES (98, 'marseille/minor/swords/05.png');
+INSERT INTO card_image VALUES (99, 'marseille/minor/swords/06.png');
+INSERT INTO card_image VALUES (100, 'marseille/minor/swords/07.png');
+INSERT INTO card_image VALUES (101, 'marseille/minor/swords/08.png');
+INSERT INTO card_image VALUES (102, 'marseille/minor/swords/09.png');
+INSERT INTO card_image VALUES (103, 'marseille/minor/swords/10.png');
+INSERT INTO card_image VALUES (104, 'marseille/minor/swords/valet d''epee.png');
+INSERT INTO card_image VALUES (105, 'marseille/minor/swords/cavalier d''epee.png');
+INSERT INTO card_image VALUES (106, 'marseille/minor/swords/reyne d''epee.png');
+INSERT INTO card_image VALUES (107, 'marseille/minor/swords/roy d''epee.png');
+INSERT INTO card_image VALUES (108, 'marseille/minor/chalices/01.png');
+INSERT INTO card_image VALUES (109, 'marseille/minor/chalices/02.png');
+INSERT INTO card_image VALUES (110, 'marseille/minor/chalices/03.png');
+INSERT INTO card_image VALUES (111, 'marseille/minor/chalices/04.png');
+INSERT INTO card_image VALUES (112, 'marseille/minor/chalices/05.png');
+INSERT INTO card_image VALUES (113, 'marseille/minor/chalices/06.png');
+INSERT INTO card_image VALUES (114, 'marseille/minor/chalices/07.png');
+INSERT INTO card_image VALUES (115, 'marseille/minor/chalices/08.png');
+INSERT INTO card_image VALUES (116, 'marseille/minor/chalices/09.png');
+INSERT INTO card_image VALUES (117, 'marseille/minor/chalices/10.png');
+INSERT INTO card_image VALUES (118, 'marseille/minor/chalices/valet de coupe.png');
+INSERT INTO card_image VALUES (119, 'marseille/minor/chalices/cavalier de coupe.png');
+INSERT INTO card_image VALUES (120, 'marseille/minor/chalices/reyne de coupe.png');
+INSERT INTO card_image VALUES (121, 'marseille/minor/chalices/roy de coupe.png');
+INSERT INTO card_image VALUES (122, 'marseille/minor/chalices/01.png');
+INSERT INTO card_image VALUES (123, 'marseille/minor/chalices/02.png');
+INSERT INTO card_image VALUES (124, 'marseille/minor/pentacles/03.png');
+INSERT INTO card_image VALUES (125, 'marseille/minor/pentacles/04.png');
+INSERT INTO card_image VALUES (126, 'marseille/minor/pentacles/05.png');
+INSERT INTO card_image VALUES (127, 'marseille/minor/pentacles/06.png');
+INSERT INTO card_image VALUES (128, 'marseille/minor/pentacles/07.png');
+INSERT INTO card_image VALUES (129, 'marseille/minor/pentacles/08.png');
+INSERT INTO card_image VALUES (130, 'marseille/minor/pentacles/09.png');
+INSERT INTO card_image VALUES (131, 'marseille/minor/pentacles/10.png');
+INSERT INTO card_image VALUES (132, 'marseille/minor/pentacles/valet de deniers.png');
+INSERT INTO card_image VALUES (133, 'marseille/minor/pentacles/cavalier de deniers.png');
+INSERT INTO card_image VALUES (134, 'marseille/minor/pentacles/reyne de deniers.png');
+INSERT INTO card_image VALUES (135, 'marseille/minor/pentacles/roy de deniers.png');
+INSERT INTO card_image VALUES (136, 'marseille/major/Le Mat.png');
+INSERT INTO card_image VALUES (137, 'marseille/major/Le Bateleur.png');
+INSERT INTO card_image VALUES (138, 'marseille/major/Le Papesse.png');
+INSERT INTO card_image VALUES (139, 'marseille/major/L''Imperatrice.png');
+INSERT INTO card_image VALUES (140, 'marseille/major/Le Empereur.png');
+INSERT INTO card_image VALUES (141, 'marseille/major/Le Pape.png');
+INSERT INTO card_image VALUES (142, 'marseille/major/L''Amoureux.png');
+INSERT INTO card_image VALUES (143, 'marseille/major/Le Chariot.png');
+INSERT INTO card_image VALUES (144, 'marseille/major/La Justice.png');
+INSERT INTO card_image VALUES (145, 'marseille/major/L''Hermite.png');
+INSERT INTO card_image VALUES (146, 'marseille/major/La Rue De Fortune.png');
+INSERT INTO card_image VALUES (147, 'marseille/major/La Force.png');
+INSERT INTO card_image VALUES (148, 'marseille/major/Le Pendu.png');
+INSERT INTO card_image VALUES (149, 'marseille/major/La Mort.png');
+INSERT INTO card_image VALUES (150, 'marseille/major/Temperance.png');
+INSERT INTO card_image VALUES (151, 'marseille/major/Le Diable.png');
+INSERT INTO card_image VALUES (152, 'marseille/major/La Maison Dieu.png');
+INSERT INTO card_image VALUES (153, 'marseille/major/L''Etoile.png');
+INSERT INTO card_image VALUES (154, 'marseille/major/La Lune.png');
+INSERT INTO card_image VALUES (155, 'marseille/major/Le Soleil.png');
+INSERT INTO card_image VALUES (156, 'marseille/major/Le Jugement.png');
+INSERT INTO card_image VALUES (157, 'marseille/major/Le Monde.png');
+INSERT INTO card_image VALUES (158, 'italian/minor/wands/01.png');
+INSERT INTO card_image VALUES (159, 'italian/minor/wands/02.png');
+INSERT INTO card_image VALUES (160, 'italian/minor/wands/03.png');
+INSERT INTO card_image VALUES (161, 'italian/minor/wands/04.png');
+INSERT INTO card_image VALUES (162, 'italian/minor/wands/05.png');
+INSERT INTO card_image VALUES (163, 'italian/minor/wands/06.png');
+INSERT INTO card_image VALUES (164, 'italian/minor/wands/07.png');
+INSERT INTO card_image VALUES (165, 'italian/minor/wands/08.png');
+INSERT INTO card_image VALUES (166, 'italian/minor/wands/09.png');
+INSERT INTO card_image VALUES (167, 'italian/minor/wands/10.png');
+INSERT INTO card_image VALUES (168, 'italian/minor/wands/fante di bastoni.png');
+INSERT INTO card_image VALUES (169, 'italian/minor/wands/cavaliere di bastoni.png');
+INSERT INTO card_image VALUES (171, 'italian/minor/wands/re di bastoni.png');
+INSERT INTO card_image VALUES (172, 'italian/minor/swords/01.png');
+INSERT INTO card_image VALUES (173, 'italian/minor/swords/02.png');
+INSERT INTO card_image VALUES (174, 'italian/minor/swords/03.png');
+INSERT INTO card_image VALUES (175, 'italian/minor/swords/04.png');
+INSERT INTO card_image VALUES (176, 'italian/minor/swords/05.png');
+INSERT INTO card_image VALUES (177, 'italian/minor/swords/06.png');
+INSERT INTO card_image VALUES (178, 'italian/minor/swords/07.png');
+INSERT INTO card_image VALUES (179, 'italian/minor/swords/08.png');
+INSERT INTO card_image VALUES (180, 'italian/minor/swords/09.png');
+INSERT INTO card_image VALUES (181, 'italian/minor/swords/10.png');
+INSERT INTO card_image VALUES (182, 'italian/minor/swords/fante di spade.png');
+INSERT INTO card_image VALUES (183, 'italian/minor/swords/cavaliere di spade.png');
+INSERT INTO card_image VALUES (184, 'italian/minor/swords/regina di spade.png');
+INSERT INTO card_image VALUES (185, 'italian/minor/swords/re di spade.png');
+INSERT INTO card_image VALUES (186, 'italian/minor/chalices/01.png');
+INSERT INTO card_image VALUES (187, 'italian/minor/chalices/02.png');
+INSERT INTO card_image VALUES (188, 'italian/minor/chalices/03.png');
+INSERT INTO card_image VALUES (189, 'italian/minor/chalices/04.png');
+INSERT INTO card_image VALUES (190, 'italian/minor/chalices/05.png');
+INSERT INTO card_image VALUES (191, 'italian/minor/chalices/06.png');
+INSERT INTO card_image VALUES (192, 'italian/minor/chalices/07.png');
+INSERT INTO card_image VALUES (193, 'italian/minor/chalices/08.png');
+INSERT INTO card_image VALUES (194, 'italian/minor/chalices/09.png');
+INSERT INTO card_image VALUES (195, 'italian/minor/chalices/10.png');
+INSERT INTO card_image VALUES (196, 'italian/minor/chalices/fante di coppe.png');
+INSERT INTO card_image VALUES (197, 'italian/minor/chalices/cavaliere di coppe.png');
+INSERT INTO card_image VALUES (198, 'italian/minor/chalices/regina di coppe.png');
+INSERT INTO card_image VALUES (199, 'italian/minor/chalices/re di coppe.png');
+INSERT INTO card_image VALUES (200, 'italian/minor/pentacles/01.png');
+INSERT INTO card_image VALUES (201, 'italian/minor/pentacles/02.png');
+INSERT INTO card_image VALUES (202, 'italian/minor/pentacles/03.png');
+INSERT INTO card_image VALUES (203, 'italian/minor/pentacles/04.png');
+INSERT INTO card_image VALUES (204, 'italian/minor/pentacles/05.png');
+INSERT INTO card_image VALUES (205, 'italian/minor/pentacles/06.png');
+INSERT INTO card_image VALUES (206, 'italian/minor/pentacles/07.png');
+INSERT INTO card_image VALUES (207, 'italian/minor/pentacles/08.png');
+INSERT INTO card_image VALUES (208, 'italian/minor/pentacles/09.png');
+INSERT INTO card_image VALUES (209, 'italian/minor/pentacles/10.png');
+INSERT INTO card_image VALUES (211, 'italian/minor/pentacles/cavaliere di danari.png');
+INSERT INTO card_image VALUES (212, 'italian/minor/pentacles/regina di danari.png');
+INSERT INTO card_image VALUES (213, 'italian/minor/pentacles/re di danari.png');
+INSERT INTO card_image VALUES (214, 'italian/major/00-il matto.png');
+INSERT INTO card_image VALUES (215, 'italian/major/01-il bagatto.png');
+INSERT INTO card_image VALUES (216, 'italian/major/02-la papessa.png');
+INSERT INTO card_image VALUES (217, 'italian/major/03-l''imperatrice.png');
+INSERT INTO card_image VALUES (218, 'italian/major/04-l''imperatore.png');
+INSERT INTO card_image VALUES (219, 'italian/major/05-il papa.png');
+INSERT INTO card_image VALUES (220, 'italian/major/06-gli amanti.png');
+INSERT INTO card_image VALUES (221, 'italian/major/07-il carro.png');
+INSERT INTO card_image VALUES (222, 'italian/major/08-la giustizia.png');
+INSERT INTO card_image VALUES (223, 'italian/major/09-l''eremita.png');
+INSERT INTO card_image VALUES (224, 'italian/major/10-la ruota.png');
+INSERT INTO card_image VALUES (225, 'italian/major/11-la forza.png');
+INSERT INTO card_image VALUES (226, 'italian/major/12-l''appeso.png');
+INSERT INTO card_image VALUES (227, 'italian/major/13-il tredici.png');
+INSERT INTO card_image VALUES (228, 'italian/major/14-la temperanza.png');
+INSERT INTO card_image VALUES (229, 'italian/major/15-il diavolo.png');
+INSERT INTO card_image VALUES (230, 'italian/major/16-la torre.png');
+INSERT INTO card_image VALUES (231, 'italian/major/17-le stelle.png');
+INSERT INTO card_image VALUES (232, 'italian/major/18-la luna.png');
+INSERT INTO card_image VALUES (233, 'italian/major/19-il sole.png');
+INSERT INTO card_image VALUES (234, 'italian/major/20-il giudizio.png');
+INSERT INTO card_image VALUES (235, 'italian/major/21-il mondo.png');
+INSERT INTO card_image VALUES (170, 'italian/minor/wands/regina di bastoni.png');
+INSERT INTO card_image VALUES (210, 'italian/minor/pentacles/fante di danari.png');
+INSERT INTO card_image VALUES (236, 'visconti/major/00.png');
+INSERT INTO card_image VALUES (237, 'visconti/major/01.png');
+INSERT INTO card_image VALUES (238, 'visconti/major/02.png');
+INSERT INTO card_image VALUES (239, 'visconti/major/03.png');
+INSERT INTO card_image VALUES (240, 'visconti/major/04.png');
+INSERT INTO card_image VALUES (241, 'visconti/major/05.png');
+INSERT INTO card_image VALUES (242, 'visconti/major/06.png');
+INSERT INTO card_image VALUES (243, 'visconti/major/07.png');
+INSERT INTO card_image VALUES (244, 'visconti/major/08.png');
+INSERT INTO card_image VALUES (245, 'visconti/major/09.png');
+INSERT INTO card_image VALUES (246, 'visconti/major/10.png');
+INSERT INTO card_image VALUES (247, 'visconti/major/11.png');
+INSERT INTO card_image VALUES (248, 'visconti/major/12.png');
+INSERT INTO card_image VALUES (249, 'visconti/major/13.png');
+INSERT INTO card_image VALUES (250, 'visconti/major/14.png');
+INSERT INTO card_image VALUES (251, 'visconti/major/15.png');
+INSERT INTO card_image VALUES (252, 'visconti/major/16.png');
+INSERT INTO card_image VALUES (253, 'visconti/major/17.png');
+INSERT INTO card_image VALUES (254, 'visconti/major/18.png');
+INSERT INTO card_image VALUES (255, 'visconti/major/19.png');
+INSERT INTO card_image VALUES (256, 'visconti/major/20.png');
+INSERT INTO card_image VALUES (257, 'visconti/major/21.png');
+
+INSERT INTO card_combination VALUES (137, 136, 2, 'Уход. Опасность в воде или от воды. Шут возвращает нас в повседневность. Рассчитывать, кроме как на самого себя, больше не на кого. Мы остались нагими и уязвимыми перед лицом природы, которая ничего не дает даром. И лишь внутренний слух, внутреннее око способны укрепить наши силы.');
+INSERT INTO card_combination VALUES (137, 138, 2, 'Продуктивность. Знакомство с женщиной. Спрашивающему предстоит найти для себя некий новый путь, чтобы достичь высших миров и по-настоящему развить свои медиумитические и психические способности.');
+INSERT INTO card_combination VALUES (137, 139, 2, 'Известие, ожидаемое в скором времени. Действие, обусловленное мыслью. Спрашивающий увлечется какой-то идеей. С этим будет связано изменение рода деятельности или ее сферы.');
+INSERT INTO card_combination VALUES (137, 140, 2, 'Стабильность. Приобретение материальных благ. Спрашивающему придется взаимодействовать с некоей организованной силой, властями. Он получит поддержку, если его замысел будет соответствовать их системе ценностей. Сотрудничество принесет много пользы.');
+INSERT INTO card_combination VALUES (137, 141, 2, 'Защищенность. Спрашивающему помогут. Действие развивается главным образом в сфере духа. Не следует спешить и раздражаться. Карта Папы означает, что спрашивающий не только должен, но и вполне способен сохранить силы и обрести душевный покой.');
+INSERT INTO card_combination VALUES (137, 142, 2, 'Беспокойство. Предстоящий выбор. Спрашивающему придется сделать какой-то важный выбор, перед ним открываются два пути. Выбирать при этом нужно не более легкий путь, а более верный. Кроме того, ситуация выбора позволит спрашивающему выяснить, какую роль на самом деле играет в ней любовь.');
+INSERT INTO card_combination VALUES (137, 143, 2, 'Гармония. Предстоит приятное путешествие. Замысел спрашивающего осуществляется в результате упорных усилий; ему удалось преодолеть объективные (внешние) препятствия. Убедившись в их преодолимости, Маг продолжает путь к цели. Субъективных препятствий (сомнений) у него нет.');
+INSERT INTO card_combination VALUES (137, 144, 2, 'Cтрогость. Начало трудного этапа. Итог предыдущих деяний Мага, и плохих, и хороших. Ему придется согласиться с этим приговором, иначе цели своей он достичь не сможет.');
+INSERT INTO card_combination VALUES (137, 145, 2, 'Мудрость. Время размышлений и одиночества. Отшельник здесь — символ паузы, периода сосредоточения перед действием. Действие должно созреть. Так и Маг должен быть осторожен и мудр, чтобы успешно выполнить свое дело.');
+INSERT INTO card_combination VALUES (137, 146, 2, 'Легкие перемены. Смена обстановки. Действие привело к изменению, задало ритм новому созидательному движению в цикле удач и неудач жизни. Колесо Фортуны вертится, и нужно суметь сохранить равновесие, ибо только оно позволяет овладеть всеми тремя мирами — физическим, психическим и духовным.');
+INSERT INTO card_combination VALUES (137, 147, 2, 'в основе ее интуиции лежит жизненный опыт. Все препятствия будут преодолены, и силы при этом не убудет.');
+INSERT INTO card_combination VALUES (137, 148, 2, 'Измена. Предательство со стороны мужчины. Привычные ценности рушатся. Новый источник веры следует искать в невидимых силах, окружающих нас. Только упорный труд, каждодневная борьба помогут преодолеть горечь и обиду. И тогда ваши жизненные силы восстановятся.');
+INSERT INTO card_combination VALUES (137, 149, 2, 'Трудные перемены. Меры предосторожности не помогают. К новой жизни воскресает лишь тот, кто готов умереть за свое дело, говорит эта карта. Такое перевоплощение непростое дело, но велика и награда. Карта Смерти может означать и физическую смерть, траур. Вообще же пройти это — необходимая трансформация, через которую нужно пройти, откинув страхи. Нужно идти вперед, ибо остановка, промедление действительно смерти подобны.');
+INSERT INTO card_combination VALUES (137, 150, 2, 'Быстрота. Возвращение близкого человека (мужчины). Всякое действие требует гармонии. Только гармония позволит вам услышать сигналы невидимых миров. Эта карта символизирует обмен энергии с Космосом, без чего познание самого себя невозможно. Это и есть необходимая "искра", сигнал.');
+INSERT INTO card_combination VALUES (137, 151, 2, 'Помехи. Препятствия внутри вас. Присутствие карты Дьявола означает, что действие в большой мере определяется инстинктами, что индивид зависит от них очень сильно. Его поведением управляют внутреннее раздражение и гордыня.');
+INSERT INTO card_combination VALUES (137, 152, 2, 'Удар. Опасная авария. Это — крушение дела, которому вы отдали много сил. Эта карта учит нас признавать свои ошибки и делать из них выводы.');
+INSERT INTO card_combination VALUES (139, 147, 2, 'Здоровье. Непрерывное действие. Для осуществления ваших планов требуется чисто физическая возможность, хорошая форма. Условия внешнего мира направляют вашу мысль в сферу физического, пробуждая мощные инстинкты и желания.');
+INSERT INTO card_combination VALUES (137, 153, 2, 'Миссия. Приятное известие. Звезда — это надежда, укрепляющая дух. Эта карта показывает, что действие интересующего нас лица совершаются по воле Провидения и находятся под покровительством высших сил. Она может означать и потребность совершить что-то по внушению свыше (вдохновение). Есть надежда, что на вашу любовь или иные положительные чувства ответят взаимностью.');
+INSERT INTO card_combination VALUES (137, 154, 2, 'Изменчивость. Крупное событие или пожар. Состояние души постоянно изменяется, что порождает эмоции, охватывающие все существо. Единственное средство преодолеть этот дискомфорт — дать эмоциям выход через творчество, вообще через действие. Иногда карта Луны означает рождение нового существа или произведения.');
+INSERT INTO card_combination VALUES (137, 155, 2, 'Тепло. Труд, который будет щедро вознагражден. Солнце означает мягкое тепло, покой после труда, начатого Магом; это тепло восстанавливает силы и позволяет продолжать движение вперед. Это также победа мужчины и весеннее возрождение.');
+INSERT INTO card_combination VALUES (137, 156, 2, 'мы не в силах на нее повлиять. Настало время подвести итоги. "Приговор" карты Суда — это итог наших дел и поступков, как злых, так и добрых. Только глубокий анализ, искренний разговор с самим собой могут помочь нам вынести этот приговор.');
+INSERT INTO card_combination VALUES (137, 157, 2, 'все проходит, поэтому почивать на лаврах не следует.');
+INSERT INTO card_combination VALUES (138, 136, 2, 'иллюзии были слишком сильны, воображение подвело нас. Мы напрасно доверились чувствам.');
+INSERT INTO card_combination VALUES (138, 139, 2, 'Движение. Поездка в деревню. Движение — это всегда творческий импульс, который в данном случае распространяется и на наш психический мир, помогая нам заново открыть свое собственно "я"  Открывается источник, в котором мы так нуждались. В организме возникают новые токи. Мечта достигает своей конечной фазы и становится явью.');
+INSERT INTO card_combination VALUES (138, 140, 2, 'Стабильность. Удачный брак, богатство. Мечта о благосостоянии, овладевшая человеком, заставляет его подниматься все выше, туда, где эта мечта способна осуществиться. Естественное вдохновение приведет его к желаемым благам. Он достигнет своей вершины.');
+INSERT INTO card_combination VALUES (138, 141, 2, 'Защищенность. Поддержка со стороны женщины. От ложных выводов вас может предохранять только интуиция. Цель достижима, но далека; понять это помогут "путешествия в прошлое"  Анализ пережитого поможет найти лучший путь к цели.');
+INSERT INTO card_combination VALUES (138, 142, 2, 'Беспокойство. Для женщины — любовная связь. Ваше желание бежать от опостылевшей повседневности вполне объяснимо, однако это не поможет вам обрести душевное равновесие. Чтобы избавится от неуверенности в завтрашнем дне, чтобы наконец "бросить якорь", вам придется еще долго искать, обращаясь к самым разным людям.');
+INSERT INTO card_combination VALUES (138, 143, 2, 'Гармония. Благоприятная перемена места. Пора, пора ехать. Вас влекут новые горизонты. Близятся новые дни, полные солнца. Это — освобождение, путешествие, особенно для женщины (или вместе с женщиной), разделенная радость.');
+INSERT INTO card_combination VALUES (138, 144, 2, 'Строгость. Хлопоты из-за женщины. Грезы окончены. Пора проснуться и вернуться к действительности. Вам следует занять наконец определенную позицию. Обстоятельства принимают слишком серьезный оборот, осуществление планов откладывается, возможно, их придется пересмотреть.');
+INSERT INTO card_combination VALUES (138, 145, 2, 'пора вновь обрести почву под ногами. Обдумав все хорошенько, вы придадите своим желаниям иное направление. Отправляясь в поездку, будьте особенно осторожны. Возможны также материальные затруднения.');
+INSERT INTO card_combination VALUES (138, 146, 2, 'она не обманет. Перед вами откроется новое поле деятельности. Какая-то организация или служба окажет вам помощь.');
+INSERT INTO card_combination VALUES (138, 147, 2, 'Здоровье. Противоборство с женщиной. Первоначальные грезы сменились жаждой битвы. Вы горите желанием победить. Но победа будет достигнута только после того, как вы сами избавитесь от агрессивных намерений по отношению к другим. Сила поможет и преодолеть страхи, возникающие в вашем воображении.');
+INSERT INTO card_combination VALUES (138, 148, 2, 'Измена. Предательство со стороны женщины. Вы находитесь во власти иллюзий, а они только мешают делу. Вам кажется, что вас предали, бросили на произвол судьбы, и вы вините в этом женщину; именно это в данный момент заставляет вас отступать. Друзьям и близким состояние ваших нервов внушает серьезные опасения.');
+INSERT INTO card_combination VALUES (141, 146, 2, 'Легкие перемены. Выгодная перемена места. Учение и размышление позволят нам выйти за пределы круга привычных представлений. Эта карта открывает нам путь к другим людям, к сотрудничеству и взаимопониманию с ними.');
+INSERT INTO card_combination VALUES (138, 149, 2, 'Трудные перемены. Изменение финансовой ситуации. В вашем сознании назревают изменения, оно готовится к переходу в новое состояние — отступать больше нельзя, нужно идти вперед, навстречу судьбе. И орудием Провидения на этом пути может стать женщина. Верный инстинкт велит нам продолжать путь, если мы не хотим сорваться в пропасть, над которой остановились.');
+INSERT INTO card_combination VALUES (138, 150, 2, 'Быстрота. Увеличение расходов. ""Чемоданное настроение" перед поездкой. Воздух точно наполнен электричеством, психологическое напряжение возрастает, требуя разрядки. Все помыслы сосредоточены на текущем моменте.');
+INSERT INTO card_combination VALUES (138, 151, 2, 'Помехи. Власть инстинктов. Атмосфера вокруг нас, характеризуемая таким сочетанием карт, взрывоопасна. Сознание замутняется темными мыслями, его необходимо очистить. Выйдите во внешний круг (карта Жрицы), обратитесь за помощью к другим людям.');
+INSERT INTO card_combination VALUES (138, 152, 2, 'жизнь-то идет, а значит, есть и надежда.');
+INSERT INTO card_combination VALUES (138, 153, 2, 'Миссия. Возмещение потерь. Вы найдете верную дорогу, интуиция не подведет вас. Судьба в лице какой-то организации или службы возместит вам убытки. Карта Звезды также предвещает большое путешествие, которое будет весьма успешным.');
+INSERT INTO card_combination VALUES (138, 154, 2, 'слишком много отвлекающих факторов, поэтому мечты остаются только мечтами. Луна, изменчивая по определению, не позволяет Жрице (природной интуиции) воплотиться в конкретной воле, в действии. Между возникновением замысла и его осуществлением проходит слишком много времени.');
+INSERT INTO card_combination VALUES (138, 155, 2, 'Тепло. Работа "в пользу бедных"  Влияние такого "солнечно-лунного" сочетания карт кратковременно, созданная Жрицей холодная атмосфера не успевает прогреться по-настоящему. В жизни спрашивающего наступает нейтральный период, ему ни тепло, ни холодно. Кажется, что время остановилось, то ли на несколько часов, то ли навеки. Период бездействия.');
+INSERT INTO card_combination VALUES (138, 156, 2, 'Воля. Решающая роль женщины в событиях. ""Мертвый сезон" закончился. Пора вступать в игру. Пассивным дальше оставаться нельзя, приближается развязка. Невидимый мир требует восстановления справедливости, и она совершится в самом ближайшем времени.');
+INSERT INTO card_combination VALUES (138, 157, 2, 'Успех. Женщина принесет удачу. Нас охватывает чувство глубокой радости. Душевная сила помогла нам выйти на правильный путь и осуществить мечту, которая казалась нереальной.');
+INSERT INTO card_combination VALUES (139, 136, 2, 'Уход. Движение прочь. Чтобы преодолеть пассивность, чтобы выйти из заколдованного круга иллюзий, необходимо усилие. Остерегайтесь грез ума, способных увести очень далеко, слишком далеко от повседневной реальности. Пора вернуться на землю, говорит нам это сочетание карт. Держитесь за мачту, чтобы сирены не заманили вас в море своим пением.');
+INSERT INTO card_combination VALUES (139, 140, 2, 'Стабильность. Близкая прибыль. Человек сознательно стремится к прочному положению и материальному благополучию. Мечтания уступают место четкому, конкретному плану, который и приведет к желаемому результату.');
+INSERT INTO card_combination VALUES (139, 141, 2, 'Стабильность. Близкая прибыль. Человек сознательно стремится к прочному положению и материальному благополучию. Мечтания уступают место четкому, конкретному плану, который и приведет к желаемому результату.');
+INSERT INTO card_combination VALUES (139, 142, 2, 'чувство должно созреть. Именно оно поможет вам сделать правильный выбор.');
+INSERT INTO card_combination VALUES (139, 143, 2, 'Гармония. Конкретизация идеи. Вас охватывает желание "сняться с места"  Решительное действие или поездка приведут вас к освобождению и принесут немалую пользу. Ваш путь станет удачным продвижением в новом для вас направлении.');
+INSERT INTO card_combination VALUES (139, 144, 2, 'строгость. Преодоление препятствий. Ситуация оказывается еще более напряженной, чем должна была быть, из-за упрямства участвующих в ней лиц. Карта Правосудия — это сила, стремящаяся управлять всем, поэтому она не позволяет Хозяйке в полной мере проявить свое влияние. Придется подождать, прежде чем задуманное действие сможет осуществиться.');
+INSERT INTO card_combination VALUES (139, 145, 2, 'мудрость. Отложенная поездка. Движение, действие, олицетворяемое Хозяйкой, приостановилось. Прежде чем продолжить движение, следует сосредоточиться, поразмыслить. Идеи уже есть, но для их осуществления требуется время.');
+INSERT INTO card_combination VALUES (139, 146, 2, 'Легкие перемены. Освобождение через мысль. Мысль в своем движении освобождает человека и изменяет обстоятельства его деятельности так, что его планы начинают наконец осуществляться. Наступает новый период жизни.');
+INSERT INTO card_combination VALUES (139, 148, 2, 'Измена. Предательство со стороны близких. Мысли — наши первые враги, поэтому они требуют к себе повышенного внимания. Нашим трехмерным миром правят иллюзии. Здесь нужна особая осмотрительность, ибо велико искушение забыться, бежать от реальности.');
+INSERT INTO card_combination VALUES (139, 149, 2, 'Трудные перемены. Новый уровень понимания мира. Хозяйка — символ движения, творческой мысли, в сочетании с картой смерти показывает, что сейчас нам необходимы глубокие перемены во взглядах и мыслях, чтобы вновь обрести себя, прочно встать на ноги.');
+INSERT INTO card_combination VALUES (139, 150, 2, 'Быстрота. Чрезвычайно быстрая или скорая перемена места. Очень скоро наступят перемены в сознании, и они будут быстрыми и резкими. Такое сочетание карт действует подобно отрезвляющему шоку и означает необходимость (и способность) быстро принимать верные решения, необыкновенный душевный подъем. Так что спать вам не придется.');
+INSERT INTO card_combination VALUES (139, 151, 2, 'Помехи. Грех гордыни. Это сочетание карт означает преобладание чувственных вожделений, инстинктов. Карта Дьявола отражает переполняющий человека магнетизм. И лишь связь с внешним миром, с окружающей нас материальной действительностью, осуществляемая через Хозяйку (разум), может помочь нам сбросить его цепи.');
+INSERT INTO card_combination VALUES (139, 152, 2, 'Удар. Опасность, подстерегающая в пути. Какое-то известие или новость сильно огорчат вас. Возникшие затруднения заставят вас изменить образ жизни. Лучше приспособить его для удовлетворения ваших подлинных потребностей. Забираться слишком высоко не стоит, ведь риск падения увеличивается, — предостерегает нас Хозяйка в сочетании с Разрушаемой Башней.');
+INSERT INTO card_combination VALUES (139, 153, 2, 'Миссия. Цель близка. Эти карты отражают надежду вскоре увидеть результаты наших усилий. Стремление к гармонии, к благополучию облегчит нам путь к вершине, манящей, как звезда в ночи. Кроме того, они предвещают некое счастливое событие.');
+INSERT INTO card_combination VALUES (139, 154, 2, 'она погружает нас в кратковременное оцепенение, полное образов и картин, чаще всего далеких от реальности.');
+INSERT INTO card_combination VALUES (139, 155, 2, 'Тепло. Удача. Энергия Солнца создает динамическую комбинацию, почти прямо противоположную предыдущей. Солнце — это удача, это жизнь, набирающая силы, подобно растению, а в сочетании с Хозяйкой (связующее звено) — путь к счастью и процветанию.');
+INSERT INTO card_combination VALUES (139, 156, 2, 'Воля. Стремление разрешить проблему. Мысли приведены в порядок, выводы из событий прошлого сделаны. Происходит Суд, открывающий перед нами иные времена, а иногда и иные миры, где мы займем подобающее место, но не успокоимся на достигнутом и будем продолжать путь. Суд — это и есть путь к иному.');
+INSERT INTO card_combination VALUES (139, 157, 2, 'Успех. Идеи приносят плоды. Путь к успеху — это путь общения, перемен. Предоставим мертвым хоронить своих мертвецов. Нам же нельзя останавливаться, нужно продолжать путь, чтобы достичь счастья. Промедление смерти подобно, говорит карта Мира. Только вперед!');
+INSERT INTO card_combination VALUES (140, 136, 2, 'Уход. Богатство уходит. Если вы поддадитесь иллюзиям и грезам, уводящим прочь от действительности, пробуждение может быть горьким. Будьте бдительны и помните, что не в деньгах счастье, тогда и возможные потери не покажутся вам трагедией. Хорошенько обдумайте случившееся, это поможет вам вернуться на ровную дорогу.');
+INSERT INTO card_combination VALUES (140, 141, 2, 'Защищенность. Постоянная и надежная поддержка со стороны могучих сил. Силы материального мира объединяются с силами мира духа. Все виды энергии бытия направляются на строительство здания, и это здание будет построено не на песке.');
+INSERT INTO card_combination VALUES (140, 142, 2, 'Беспокойство. Чувства мешают действию. Возникают искушение встать на путь, диктуемый чувством; тут надо быть осторожным, ибо ум, попавший под власть инстинктов, может привести к беде.');
+INSERT INTO card_combination VALUES (140, 143, 2, 'Гармония. Выигрыш благодаря поездке. Власть с материальным миром достигнута; но Колесница влечет нас дальше, к достижению полной свободы бытия. Власть над собой — вот цель, которую ставит перед нами эта карта.');
+INSERT INTO card_combination VALUES (140, 144, 2, 'и власть, и богатство. Нужно остановиться и подумать, отказавшись на время от удовлетворения привычных потребностей. Прежде чем перейти к следующему этапу, нужно подвести итог предыдущему: как мы распорядились тем, что было нам предоставлено?');
+INSERT INTO card_combination VALUES (141, 147, 2, 'Здоровье. Мощная поддержка извне. Физические силы подчиняются силам духовным, укрепляя и увеличивая их у того, кто стремится действовать в согласии с Космосом. Такой человек надежно защищен во всех своих устремлениях.');
+INSERT INTO card_combination VALUES (140, 145, 2, 'Мудрость. Размышления над приобретенным богатством. Карта Отшельника конкретизирует вывод, к которому нас подвела карта Правосудия. Исключительное могущество Хозяина настоятельно требует противовеса в виде личной скромности и смирения. Пора осознать суетность сего мира и в полном одиночестве отправиться на поиски Мудрости.');
+INSERT INTO card_combination VALUES (140, 146, 2, 'Легкие перемены. Важные изменения. Намечаются значительные перемены в вашей материальной сфере. Это не убыток, а перераспределение. Сколько можно посвящать себя делу, решите вы, пора обратиться к радостям жизни, ведь стабильное положение уже достигнуто.');
+INSERT INTO card_combination VALUES (140, 147, 2, 'Здоровье. Победа в битве. Тот, кому выпали эти карты, ощущает прилив сил. Карта Хозяина открывает перед ним широкие возможности, и он готов начать строить здание собственного благополучия. Без трудностей, конечно, не обойдется, но они будут преодолены благодаря его личной доблести.');
+INSERT INTO card_combination VALUES (140, 148, 2, 'Измена. Скандал из-за денег. Мы настолько привыкли к материальному благополучию, что поверили, будто так будет всегда; однако теперь придется признать, что мы обманулись.');
+INSERT INTO card_combination VALUES (140, 149, 2, 'Трудные перемены. Изменение образа жизни. Привычный материальный мир рушится, в нем словно наступила ночь, охватившая все наши чувства. Однако этот спуск в мир тьмы необходим для дальнейшего развития нашего "я"  Путь к себе пролегает через пустыню.');
+INSERT INTO card_combination VALUES (140, 150, 2, 'выдержат ли они? Здоровые ветви выдержат, а больные (наши отжившие привычки) улетят прочь.');
+INSERT INTO card_combination VALUES (140, 151, 2, 'Помехи. Разного рода эксцессы. Карта Дьявола означает стремление к плотским и вообщефизическим наслаждениям, слепую страсть к материальным благам. Человек перестает контролировать свои влечения. Чувства овладевают человеком, и избавиться от них нелегко.');
+INSERT INTO card_combination VALUES (140, 152, 2, 'Удар. Неуместное самомнение. Мирские блага переходящи, не следует строить свои планы на них. Все течет, все изменяется, говорит нам это сочетание карт.');
+INSERT INTO card_combination VALUES (140, 153, 2, 'найдя смысл жизни, он перестает в них нуждаться.');
+INSERT INTO card_combination VALUES (140, 154, 2, 'Изменчивость. Эфемерное богатство. Это сочетание карт предвещает скорую перемену в судьбе. Изменчивость Луны резко контрастирует со стабильностью карты Хозяина. Луна делает человека восприимчивым к чему-то более важному и значительному, и материальные блага утрачивают для него свое значение.');
+INSERT INTO card_combination VALUES (140, 155, 2, 'Тепло. Прочное благополучие. Спрашивающий верно определил направление своих усилий, и скоро ему откроется настоящая золотая жила. Пара этих карт обнимает все, что только может быть связано в материальным благополучием. Хорошее начало!');
+INSERT INTO card_combination VALUES (140, 156, 2, 'Воля. Испытание на прочность. Удача изменила спрашивающему, и гроза уже готова разразиться; впрочем, если он поймет, как мало времени осталось ему, чтобы исправить положение, ему еще удастся избежать худшего. Им недовольны, и недовольство это растет. Ему самому придется решать, как вести себя в этой опасной ситуации.');
+INSERT INTO card_combination VALUES (140, 157, 2, 'Успех. Успех любых начинаний. Счастливый случай и изобилие сами плывут в руки. Эти карты показывают, что материальные блага и удача достанутся вам с легкостью, превосходящей все ожидания. Но это — исключение, поэтому опасайтесь "головокружения от успехов" ');
+INSERT INTO card_combination VALUES (141, 136, 2, 'Уход. Бегство от ответственности. Мир чувственных восприятий обманчив для того, кто не познал множественности окружающих нас миров. Лишь познание (Папа) может помочь нам преодолеть иллюзии, до сих пор приковывающие нас, подобно невидимым цепям, к этим тяжелым якорям.');
+INSERT INTO card_combination VALUES (141, 142, 2, 'Папа поможет вам в этом.');
+INSERT INTO card_combination VALUES (141, 143, 2, 'Гармония. Отъезд за границу. Колесница привезет вас к победе. Ваша деятельность протекает в спокойной, ненапряженной обстановке. Надежность, которую обещает карта Колесницы, в сочетании с Папой свидетельствует, что вы избрали верный путь.');
+INSERT INTO card_combination VALUES (141, 144, 2, 'Строгость. Готовность к битве. Нужно извлечь урок из испытания, которое выпало на нашу долю. В сочетании с картой Правосудия Папа, иногда допускающий излишества, поможет вам держать себя в рамках.');
+INSERT INTO card_combination VALUES (141, 145, 2, 'Мудрость. Задержка в осуществлении правого дела. Эти карты предупреждают о неблагоприятных последствиях, которые могут произойти в результате завышенных требований, предъявляемых нами к жизни. Не следует шагать так широко, нужно экономить силы.');
+INSERT INTO card_combination VALUES (141, 148, 2, 'Измена, действительная или кажущаяся. Хотя спрашивающего и ожидает крушение иллюзий, следует ожидать скорой нормализации положения, так как карта Повешенного находится в сочетании с Папой. Измена будет компенсирована, хотя это, возможно, и будет заметно не сразу.');
+INSERT INTO card_combination VALUES (141, 149, 2, 'Трудные перемены. Но вас не оставят в беде. Ваша личность должна вскоре претерпеть очередную трансформацию, но она не будет болезненной благодаря тому багажу, который вы накопили, живя в согласии с законами Космоса.');
+INSERT INTO card_combination VALUES (141, 150, 2, 'Быстрота. Удача через беседу или письмо. Нам удалось наконец привести свои действия в согласие со своими принципами, благодаря чему окружающие стали относиться к нам с большим уважением. Так что теперь то, о чем мы беспокоились, получило надежную защиту.');
+INSERT INTO card_combination VALUES (141, 151, 2, 'Помехи. Успешно выдержанное испытание. Нужно понять, что есть справедливость превыше человеческой. То испытание, которое вам предстоит выдержать, будет свидетельствовать об этом. Главное — не бойтесь; остальное сделает время.');
+INSERT INTO card_combination VALUES (141, 152, 2, 'Удар. Разрыв отношений. Испытание выдержано, но его следствием стало изменение мировосприятия. Иногда обновление действительно возможно только после того, как старый мир рухнет окончательно.');
+INSERT INTO card_combination VALUES (141, 153, 2, 'Миссия. Созидательная интуиция. Благодаря поддержке и покровительству Папы, вдохновение, внушенное нам Звездой, обретает крылья, и мы не только выполняем задуманное, но и превосходим при этом самих себя.');
+INSERT INTO card_combination VALUES (141, 154, 2, 'Изменчивость. Опасайтесь излишеств. Наше воображение стало настолько плодотворным, что мы можем теперь проявить качества, которые до сих пор не могли проявиться. Правда, здесь есть риск уйти слишком далека в туманные дали. Это сочетание карт означает также активизацию внешних контактов.');
+INSERT INTO card_combination VALUES (141, 155, 2, 'Тепло. Великое богатство. Вам предоставляется исключительный шанс. Ваши жизненные принципы оказались верными, и результат не замедлит сказаться. Вы прекрасно владеете собой и контролируете ситуацию.');
+INSERT INTO card_combination VALUES (141, 156, 2, 'Воля. Хотеть — значит мочь. Перед вами — новая ступень, которую нужно преодолеть. Обучение закончено, пора двигаться вперед, не оглядываясь на то, что было в прошлом; ступени на то и даны, чтобы их перепрыгивать, не считая, сколько осталось позади. Вперед! Судьба вам поможет.');
+INSERT INTO card_combination VALUES (141, 157, 2, 'Успех. Внутреннее развитие. Это — осознание принципа синтеза, ведущее к глобальному мышлению, восприятие человека как единого целого со Вселенной. Благодатное ощущение понимания того мира, в котором мы живем, делает нас частицей Космоса, членом Ордена посвященных.');
+INSERT INTO card_combination VALUES (142, 136, 2, 'Уход. Неудавшееся предприятие. Спрашивающего все больше охватывает неуверенность, к нему возвращаются иллюзии, казавшиеся давно преодоленными. Он снова начинает видеть все в черном свете, и такая позиция не преминет принести самые разрушительные результаты, если человек и дальше будет стремиться уйти от действительности (первая реакция), а не возьмет себя в руки. Нужно начать все сначала, и не стоит откладывать. Неудачи не могут длиться вечно, и мы еще посмеемся над ними.');
+INSERT INTO card_combination VALUES (142, 143, 2, 'гармония. Раздумья перед поездкой. Глубокое беспокойство охватывает нас; что будет дальше, мы не знаем. Но карта Колесницы нас успокаивает, показывая, что первый наш враг — это наши собственные мысли, а жизнь меж тем весело идет своим чередом, побуждая и нас двигаться и творить.');
+INSERT INTO card_combination VALUES (142, 144, 2, 'Строгость. Опасная нерешительность. Сейчас нужно как можно строже подходить ко всем своим решениям, особенно к тому важному выбору, который необходимо сделать, чтобы решить назревшую проблему. Нужно трезво взглянуть на факты и взять на себя ответственность за все свои шаги.Выдержка — вот качество, о котором говорит карта Правосудия.');
+INSERT INTO card_combination VALUES (142, 145, 2, 'лучше поздно, чем никогда.');
+INSERT INTO card_combination VALUES (142, 146, 2, 'Легкие перемены. Беспокойство проходит. Тревога, о которой говорит карта Влюбленных, уменьшается благодаря Колесу Фортуны. Тучи рассеиваются, открывается чистое небо. Человек обретает новые силы, чтобы вступить наконец на путь победы, оплаченной долгими страхами и сомнениями.');
+INSERT INTO card_combination VALUES (142, 147, 2, 'оба противника медлят. Действие спрашивающего парализовано беспокойством и тревогой; чтобы их преодолеть, придется вступить с ними в битву. Карта Силы передает нам свой вкус к жизни, помогая вырваться из этого заколдованного круга.');
+INSERT INTO card_combination VALUES (142, 148, 2, 'только так мы можем вновь обрести свою свободу воли, а это сейчас самое главное.');
+INSERT INTO card_combination VALUES (142, 149, 2, 'Трудные перемены. Нужно начать все заново. Сомнения и колебания завели нас в тупик, прочь от столбовой дороги жизни. Словно ночь опустилась над нашими планами и надеждами. Чтобы вновь обрести себя, чтобы вернуться на верный путь, нам придется поступиться многими из прежних, не оправдавших себя принципов.');
+INSERT INTO card_combination VALUES (142, 150, 2, 'Быстрота. Разрыв любовных отношений. Космическая необходимость заставила нас ступить на иной путь, нежели тот, который мы избрали прежде. Чтобы все исправить, придется вернуться назад и, возможно, пройти какую-то часть пути заново. Мешает нервозность, вызванная жаждой перемен. Нужно успокоить свои желания и по-настоящему задуматься о причинах и последствиях нашего теперешнего положения.');
+INSERT INTO card_combination VALUES (142, 151, 2, 'Помехи. Физические влечения. Даже если мы "глубоко увязли", следует научиться освобождаться от вредных привычек, ведущих к утрате свободы. Именно инстинкты чаще всего заставляют человека свернуть в сторону от высокого и чистого пути развития духа.');
+INSERT INTO card_combination VALUES (142, 152, 2, 'Удар. Угрызения совести. Избранный нами путь скользок, на нем немало скрытых ловушек. Вам нужно ясно отдавать себе отчет в том, за что вы взялись и чем это может кончиться. Никогда не поздно повернуть руль и спасти свой корабль от крушения. Опасность, грозящая вам, велика.');
+INSERT INTO card_combination VALUES (142, 153, 2, 'Миссия. Обоснованные сомнения. Это сочетание карт дает надежду вскоре увидеть свет в конце туннеля. Они вселяют оптимизм и уверенность в будущем. Путь был долог, но выход уже близок, и свежий утренний ветер вновь пробуждает надежды, связанные с осуществлением ваших замыслов.');
+INSERT INTO card_combination VALUES (142, 154, 2, 'только это даст ему спокойствие и уверенность, особенно в эмоциональном плане.');
+INSERT INTO card_combination VALUES (142, 155, 2, 'Тепло. Подстраховка и выигрыш. Человеку дается неожиданный шанс, заставляющий его забыть о своих сомнениях и колебаниях. Он может приобрести богатство (не обязательно материальное). Пришло время распрямить спину!');
+INSERT INTO card_combination VALUES (142, 156, 2, 'Воля. Узнавания нового. Спрашивающий так и не смог сам принять решение, и судьба решила за него. Теперь она, возможно, будет торопить его, ибо время не ждет, давно пора действовать. Карта Суда придает человеку тот волевой импульс, которого ему не хватало, чтобы начать двигаться в новом для себя направлении.');
+INSERT INTO card_combination VALUES (142, 157, 2, 'он наконец добьется всего, чего желал так долго. Время сомнений и колебаний прошло, все готово для решающего, последнего шага — и цель будет достигнута.');
+INSERT INTO card_combination VALUES (143, 136, 2, 'Уход. Малодушный поступок. Даже если причиной разрыва ваших прежних связей было глубокое разочарование, следует прислушаться к голосу совести. Колесница потому и выпала, чтобы уменьшить трудность пути. Вам будет не хватать тех, с кум вы расстались, что ж , еще не все потеряно. Смелее в путь!');
+INSERT INTO card_combination VALUES (143, 144, 2, 'законы человеческие суровы, от них не приходится ждать ни гибкости, ни гармонии.');
+INSERT INTO card_combination VALUES (143, 145, 2, 'Перемены откладываются. Чтобы размышление было плодотворным, необходимо одиночество. Прежде чем браться за осуществление планов, надо дать им вызреть. Только мудрость поможет избежать промахов.');
+INSERT INTO card_combination VALUES (143, 146, 2, 'легкие перемены. Путешествие в приятной компании. Настает миг освобождения, которого ждали так долго. Срочно нужен свежий воздух! Эта комбинация карт благоприятна также для поездок за границу.');
+INSERT INTO card_combination VALUES (143, 147, 2, 'Здоровье. Сердечные отношения партнеров. Точно какая-то мощная сила заставляет выкладываться без остатка. Выполняемая работа требует напряжения всех физических сил; начатая битва будет вестись до победы.');
+INSERT INTO card_combination VALUES (143, 148, 2, 'Измена. Ревность. Желание пуститься в новую аферу пришло очень не вовремя. Вам захочется просто бросить все на полдороге. Чтобы остаться на избранном пути, вам придется приложить немало усилий.');
+INSERT INTO card_combination VALUES (143, 149, 2, 'Трудные перемены. Тревожные предчувствия. Назревает потребность новой трансформации личности. В своей внутренней тишине душа ищет тело, с кем могла бы разделить свои чувства. Чтобы эта трансформация прошла успешно, спрашивающий должен действовать только вместе с кем-то.');
+INSERT INTO card_combination VALUES (143, 150, 2, 'Быстрота. Краткое путешествие и возвращение. Несмотря на свою теперешнюю нервозность, спрашивающему удастся выйти на верную дорогу. Правда, прямой она не будет, и ему придется хорошенько подумать, как лучше распределить свои усилия.');
+INSERT INTO card_combination VALUES (143, 151, 2, 'Помехи. Самолюбие, мешающее развитию. Нелепая привязанность к целям, поставленным исключительно под влиянием плотских, чисто материальных инстинктов, привела к резкому ограничению свободы воли. Вы уже жаждете освобождения, но достигнуть его сможете, только осознав необходимость сбросить себя те кармические цепи, которые сковывают вашу человеческую сущность. Освобождение необходимо, но вам пока трудно признать эту необходимость.');
+INSERT INTO card_combination VALUES (143, 152, 2, 'Удар. Возможная авария. Излишнее самолюбие заставляет вас опускаться ниже вашего уровня там, где вы должны были бы стремиться ввысь. Да, ноша ваша тяжела, но нужно взять себя в руки и все-таки дойти туда, где вам положено быть. Ваша партия не проиграна, она лишь отложена, и вы понемногу достроите храм своей жизни (Разрушаемую Башню). Но теперь уж стройте как следует.');
+INSERT INTO card_combination VALUES (143, 153, 2, 'Миссия. Удача в сотрудничестве с другими. Звезда подает нам знак, что наконец мы, после всех заблуждений, вышли на верный путь. Путь этот еще долог, но больше мы не заблудимся, и в конце нас ожидают победа и свобода.');
+INSERT INTO card_combination VALUES (143, 154, 2, 'Изменчивость. Путешествие по воде. Активное воображение побуждает нас тронуться в путь. Желание сменить обстановку вдохновляет нас, заставляя стремиться к новым горизонтам. Это сочетание карт может означать также приливы вдохновения.');
+INSERT INTO card_combination VALUES (143, 155, 2, 'Тепло. Переезд вместе с детьми. Жажда человеческого тепла влечет нас к обществу других. Втайне судьба уже готовит вам новые приятные знакомства. Кроме физического перемещения, эти карты могут означать и приливы вдохновения.');
+INSERT INTO card_combination VALUES (143, 156, 2, 'Воля. Внезапные перемены. Перемены случатся вдруг, поставив под сомнение давнюю дружбу. Свой план вам придется осуществлять в абсолютной тайне от кого бы то ни было. Вам будет стоить больших усилий не отказаться от этого плана, хоть вы и чувствуете, что он внушен вам свыше. Главное — терпение.');
+INSERT INTO card_combination VALUES (143, 157, 2, 'Счастливое и плодотворное освобождение. Надежды сбылись, цель достигнута, все трудности позади. Ход событий наконец позволяет вам обратиться к высшим сферам познания, давно вас притягивающим. Вас ожидает успех.');
+INSERT INTO card_combination VALUES (144, 136, 2, 'пора принимать меры к восстановлению равновесия.');
+INSERT INTO card_combination VALUES (144, 145, 2, 'Мудрость. Замедление. Это сочетание карт призывает к строгости мышления. События, происходящие в окружающем мире, призывают нас остановиться и подумать. Растущее желание порвать с привычным окружением будет способствовать развитию нового мышления, и время здесь — фактор благоприятный.');
+INSERT INTO card_combination VALUES (144, 146, 2, 'Легкие перемены. Выход из затруднительной ситуации. Долгий "период застоя" заканчивается, и новое усилие наконец позволит вам достичь давно намеченных целей. Скоро больше не нужно будет держать себя в строгости, можно будет вырваться на свободу.');
+INSERT INTO card_combination VALUES (144, 147, 2, 'Здоровье. Охлаждение отношений с партнером. Желание как-то воздействовать на жизненные обстоятельства наталкивается на необходимость выполнения повседневных семейных обязанностей; это — трудное испытание. Впрочем, ваша борьба завершится успехом.');
+INSERT INTO card_combination VALUES (144, 148, 2, 'Измена. Скандал, наносящий ущерб. Такое сочетание карт указывает на атмосферу нестабильности, царящую веруг вас. Мелкое предательство вызвало нежелательную задержку в делах.');
+INSERT INTO card_combination VALUES (144, 149, 2, 'Трудные перемены. Мучительная развязка. К полученному удару нужно отнестись спокойно, приняв его как указание на необходимость поиска какого-то иного пути к решению ваших проблем. Чтобы выбраться на твердую почву, нужно переродиться. Тогда незаметно изменятся и окружающие вас обстоятельства.');
+INSERT INTO card_combination VALUES (144, 150, 2, 'Быстрота. Давление со стороны закона. Осуществление ваших планов натолкнулось на непредвиденную задержку, и теперь вы с новым пылом принялись перерабатывать их заново. Вам необходимо новое поле деятельности, чтобы расширить свое видение мира. Судя по этим картам, у вас хватит энтузиазма начать новое дело вместе с другими, и ваши творческие потенции благодаря этому только усилятся.');
+INSERT INTO card_combination VALUES (144, 151, 2, 'Помехи. Несправедливость. Решить проблемы, с которыми вы столкнулись, никак не удается, отсюда — психологический дискомфорт и раздражение. Не поддавайтесь этим инстинктивным порывам, у вас есть более важные цели.');
+INSERT INTO card_combination VALUES (144, 152, 2, 'он поможет осознать проблемы, вызванные преувеличенным самолюбием.');
+INSERT INTO card_combination VALUES (144, 153, 2, 'Миссия. Благоприятный исход. Времена строгостей окончились, зарождаются новые надежды. Да, Правосудие действует строго и холодно, и приговор его суров. Но, выслушав приговор, даже суровый (карта Правосудия), человек всегда испытывает облегчение (карта Звезды).');
+INSERT INTO card_combination VALUES (144, 154, 2, 'Изменчивость. Сдерживаемые эмоции. Ощущение тяжести (Луна) рассеивается со временем, и появляется желание отвлечься. Устав от трудных проблем, человек жаждет отдыха и тепла, чтобы снять напряжение.');
+INSERT INTO card_combination VALUES (144, 155, 2, 'Тепло. Появляется шанс. Перед спрашивающим открывается новый шанс, и воспользоваться им надо немедленно. Иначе, упустив время, он снова окажется в плену жестких ограничений и строгостей.');
+INSERT INTO card_combination VALUES (144, 156, 2, 'Воля. Необратимое решение. В этом сочетании сошлись целых два "приговора"  Первый (Правосудие) — это непосредственный результат наших собственных поступков. Второй (Суд) — более тонкого порядка, это приговор высших сил. Здесь уже можно говорить о законе Кармы, настоятельно требующем обновления личности.');
+INSERT INTO card_combination VALUES (144, 157, 2, 'Успех. Счастливый исход. После тяжелого "приговора", повлекшего за собой значительные материальные потери, все снова наконец налаживается. Тучи рассеиваются, и вскоре человек будет вознагражден за свои старания. Его таланты найдут признание людей и позволят ему занять среди них достойное место. Таков суд человеческий.');
+INSERT INTO card_combination VALUES (145, 136, 2, 'Уход. Уход из мира. Пора наконец оставить иллюзии, они стали слишком опасны. Нужно переходить к делу. "Желаешь — дерзай, знаешь — молчи" — вот девиз Шута. Здесь он хранит молчание, готовый отправиться в свой загадочный и увлекательный путь.');
+INSERT INTO card_combination VALUES (145, 146, 2, 'Легкие перемены. Запоздалый отъезд. Ощущение одиночества понемногу проходит. В один прекрасный день дает о себе знать жажда перемен. Пора в путь, чтобы посмотреть мир и открыть для себя новые горизонты. Община друзей — вот что нужно тому, кто желает обмена знаниями во всех трех планах бытия.');
+INSERT INTO card_combination VALUES (145, 147, 2, 'Здоровье. Воздержание. В вас пробуждаются жизненные силы, ваша личность стремится к расцвету и требует от вас действия, поиска новых вариантов своего развития. Суровая зима окончилась, почки наливаются соком.');
+INSERT INTO card_combination VALUES (145, 148, 2, 'Измена. Оставленность. Одиночество вы переносите плохо. Карты показывают, что вы остро нуждаетесь в общении. В такой ситуации ваши душевные (эмоциональные) силы оказываются как бы парализованными; чтобы ее изменить, не стесняйтесь обращаться к окружающим вас людям.');
+INSERT INTO card_combination VALUES (145, 149, 2, 'Трудные перемены. Медленная трансформация. Карта смерти в сочетании с Отшельником означает уход из мира, "ночь души", столь чтимую великими мистиками. Такое "сошествие в ад" необходимо, чтобы выжечь все шлаки, замедляющие эволюцию духа.');
+INSERT INTO card_combination VALUES (145, 150, 2, 'Быстрота. Конец одиночества. Спрашивающему удалось прорвать заколдованный круг своего одиночества благодаря возврату к своим первичным устремлениям. Жизнь снова пришла в движение. Границы его вселенной раздвинулись. Теперь у него снова есть возможность прогресса.');
+INSERT INTO card_combination VALUES (145, 151, 2, 'Помехи. Ловушки одиночества. Дьявол — это искушение и, возможно, снова искушение материальными благами. Вслед за периодом одиночества, столь опостылевшего спрашивающему, может последовать приступ самолюбия и раздражения.');
+INSERT INTO card_combination VALUES (145, 152, 2, 'Отшельник_–_Разрушаемая Башня комбинации_карты Удар. Разрыв отношений. После относительного спокойствия у спрашивающего образовался избыток энергии и его может "занести"  Тут лучше как следует взять себя в руки. Нужна крепкая рука, чтобы снова овладеть рулем, выскользнувшим из нее на время; тогда еще можно будет многое исправить.');
+INSERT INTO card_combination VALUES (145, 153, 2, 'Миссия. "Обет безбрачия"  Да, ожидание было долгим; но того, кто сумел увидеть значение, предвещающее блестящий подъем, ждет достойная награда.');
+INSERT INTO card_combination VALUES (145, 154, 2, 'Изменчивость. Запоздалые перемены. Поздний успех всегда привлекает внимание — например, женское. Тут могут возникнуть и чисть хозяйственные заботы. Правда, страсти тут ожидать не приходится, так что не обольщайтесь.');
+INSERT INTO card_combination VALUES (145, 155, 2, 'Тепло. Согревающий луч света. Возобновление активности после достаточно длительного перерыва. Удача, кажется, улыбается снова. Это — луч Солнца, оживляющий природу и вселяющий надежду после долгой зимы.');
+INSERT INTO card_combination VALUES (145, 156, 2, 'Воля. Неожиданность, заставляющая задуматься. Вы ждали приговора судьбы, чтобы решить наконец, какие требования можете предъявить к жизни, вот он и произнесен. И приговор этот, судя по всему, не так уж плох. Теперь за дело, трудности преодолимы, не стоит терять времени!');
+INSERT INTO card_combination VALUES (145, 157, 2, 'Успех. Успех, заставивший себя долго ждать. За все заплачено. Дорога открыта. Наши способности наконец реализовались, позволив получить причитающиеся нам дивиденды. Но — поздно, поздно!');
+INSERT INTO card_combination VALUES (146, 136, 2, 'Уход. Опасность на воде или от воды. Иллюзии очень сильны. Вы под властью МАЙИ, как говорят индейцы, и вам потребуются значительные усилия, чтобы вырваться из оков этого летаргического сна. Для этого прежде всего необходимо осредоточится и подумать.');
+INSERT INTO card_combination VALUES (146, 147, 2, 'Здоровье. Любовная связь. Устанавливается полная гармония, благоприятствующая ДЕЙСТВИЮ и удаче. Потребность в движении и действии очень сильна. Обладая такой энергией, можно без страха идти вперед.');
+INSERT INTO card_combination VALUES (146, 148, 2, 'Измена. Ожидаемые перемены не наступают. Нами овладевают иллюзии, напоминая о необходимости вновь взять себя в руки. Все идет правильно, лишь вначале мы немного отклонились от намеченного пути. Следует придать мыслям четкость.');
+INSERT INTO card_combination VALUES (146, 149, 2, 'она желает нам только добра.');
+INSERT INTO card_combination VALUES (146, 150, 2, 'Быстрота. Молниеносное перемещение. Стремление к свободе усиливается. Перед вами открываются новые горизонты. Но, чтобы вновь обрести независимость, вам понадобится чрезвычайно быстрая реакция.');
+INSERT INTO card_combination VALUES (146, 151, 2, 'Помехи. Излишнее самолюбие. Реакция окружающих вас людей на то затруднительное положение, в которое вы их поставили, может несколько омрачить атмосферу доверия и благополучия, окружавшую вас до сих пор.');
+INSERT INTO card_combination VALUES (146, 152, 2, 'Удар. Опасность во время переезда, поездки. Ощущение психологического комфорта нарушается какой-то резкой нотой. Неожиданный "удар" судьбы, столь долго к нам благоволившей, напоминает, что все непрочно в этом мире, поэтому расслабляться не стоит.');
+INSERT INTO card_combination VALUES (146, 153, 2, 'Миссия. Поиск призвания. Это — звезда счастья, которое откроется нам, когда мы найдем свое призвание, то есть, так сказать, выполним свою главную миссию в этом мире. Каждый из нас должен занять в мире свое единственное собственное место.');
+INSERT INTO card_combination VALUES (146, 154, 2, 'вы поймете, почему стремление к свободе изначально присуще человеческой натуре. Это — важный космический сигнал для того, кто хочет продвинуться дальше по избранному пути.');
+INSERT INTO card_combination VALUES (146, 155, 2, 'в этом мире грех сидеть без дела! Перед вами открываются новые шансы. Сумеете ли вы воспользоваться ими, зависит от вас.');
+INSERT INTO card_combination VALUES (146, 156, 2, 'Воля. Необходимость в перемене места. Пора в путь. Ваши новые качества обретают форму, заставляя вас искать новый образ жизни, более соответствующий новой ступени вашего развития.');
+INSERT INTO card_combination VALUES (146, 157, 2, 'ваши надежды сбываются.');
+INSERT INTO card_combination VALUES (147, 136, 2, 'Уход. Разлука. Прежде чем приступить к осуществлению своего плана, спрашивающему придется затратить много сил на решение совсем других проблем. Так что тешить себя иллюзиями не стоит. Нужно быть готовым к тому, что не все результаты будут соответствовать ожиданиям.');
+INSERT INTO card_combination VALUES (147, 148, 2, 'человек не может увидеть вещи в истинном свете. Окружающее представляется ему как бы перевернутым, поэтому в первую очередь он должен научиться лучше управлять своей силой, чтобы вновь ощутить под ногами твердую почву.');
+INSERT INTO card_combination VALUES (147, 149, 2, 'сила, способная ее вызвать, возникает или уже возникла на вашем горизонте. Ищите путь к новому сознанию — иному, чем то, к которому вы привыкла.');
+INSERT INTO card_combination VALUES (147, 150, 2, 'Быстрота. Разрыв с любимым человеком. Пора вернуться к истокам своей внутренней силы, говорят эти карты, чтобы высвободить ее и преодолеть зависимость от своего ближайшего окружения.');
+INSERT INTO card_combination VALUES (147, 151, 2, 'Помехи. Бурная страсть. Человек находится под мощным влиянием инстинктов, он полностью зависит от своих чисто физических влечений, и стремится только к удовлетворению своей страсти, сметая все на пути. Он может и должен остановиться, задуматься, однако для этого потребуется значительное усилие.');
+INSERT INTO card_combination VALUES (147, 152, 2, 'Удар. Разрыв прежних связей. Такое сочетание карт показывает, что человек переоценил свои силы, "надорвался"  Нечего было и думать справиться с таким количеством проблем в одиночку. Теперь ему нужно "перестроиться", перераспределить свои силы, чтобы найти правильный путь к цели и достичь ее.');
+INSERT INTO card_combination VALUES (147, 153, 2, 'Миссия. Убедительная победа. Эта комбинация карт говорит о высоком уровне мастерства и прекрасной рабочей форме. Человек не только отлично понимает свою задачу, но и вполне способен ее выполнить. Вся его энергия направлена на достижение одной благородной и мужественной цели.');
+INSERT INTO card_combination VALUES (147, 154, 2, 'Изменчивость. "Фаза сжатия"  Предчувствие нового порождает и в вашей душе новые мощные импульсы, открывая новые возможности. Вода, стихия Луны — лучший проводник этих импульсов, позволяющий накопить запас энергии для будущей их реализации.');
+INSERT INTO card_combination VALUES (147, 155, 2, 'это — самолюбие и честолюбие человека, выступившего на "арену жизни", чтобы сразиться за свое место под солнцем.');
+INSERT INTO card_combination VALUES (147, 156, 2, 'вам предстоит "последний раунд" в затеянной вами битве. Для этого вам понадобятся все ваши силы. Нужно как можно яснее определить свою цель. Решающие перемены уже близки; подготовка к ним совершается незаметно для глаз.');
+INSERT INTO card_combination VALUES (147, 157, 2, 'Успех. Вознаграждение. Направление выбрано верно; эти карты показывают, что надежды вытянувшего их человека сбудутся в самом скором времени. Он сумеет выбрать момент, сосредоточить силы — и добиться заслуженной награды.');
+INSERT INTO card_combination VALUES (148, 136, 2, 'оно обязательно наступит. Не отказывайтесь от надежды: она помогает выжить. В вашей жизни начинается новый этап; вступим же в него с надеждой.');
+INSERT INTO card_combination VALUES (148, 149, 2, 'Трудная трансформация. Период "холостого пробега"  Период сна и иллюзий заканчивается, человек учится смотреть на мир с новой точки зрения. Ему предстоит очередная трансформация личности, и он должен понять и признать это. Прежде чем пытаться изменить других, нужно измениться самому!');
+INSERT INTO card_combination VALUES (148, 150, 2, 'Быстрота. Распад старого. Человек испытывает прилив жизненных сил, ему хочется преодолеть оцепенение сна. Однако для этого ему нужно расстаться с грузом прошлого, вымести прочь представления, давно ставшие неуместными в окружающем его мире.');
+INSERT INTO card_combination VALUES (148, 151, 2, 'Помехи. Опьянение. Все бренно, все тленно в этом мире… Хочется дать себе волю, но это желание есть на самом деле (кармическое) испытание, цель которого — духовный рост испытуемого. Чтобы выдержать испытание, он должен преодолеть свои инстинкты.');
+INSERT INTO card_combination VALUES (148, 152, 2, 'Удар. Опасность от воды. Да, тленно все. Дело в том, что какая-то часть вашей энергии еще вам неподвластна и способна творить разрушения. Вам следует задуматься над происходящим и попробовать понять, почему ситуация сложилась именно так. Ее нужно оценить сознательно и трезво, иначе трудно будет вновь выйти на правильный путь.');
+INSERT INTO card_combination VALUES (148, 153, 2, 'еще немного, и вы вновь выйдете на потерянную вами дорогу. Новый день уже занимается.');
+INSERT INTO card_combination VALUES (148, 154, 2, 'нам действительно нужны новые цели, новые горизонты.');
+INSERT INTO card_combination VALUES (148, 155, 2, 'нам стало трудно оправдывать поступки других.');
+INSERT INTO card_combination VALUES (148, 156, 2, 'пробуждение оказалось горьким. Зато теперь, наконец, вы можете сами увидеть те решающие перемены, которые так давно назревали. Следует собраться с духом и взглянуть правде в глаза. Ваш дух пробуждается к новым действиям.');
+INSERT INTO card_combination VALUES (148, 157, 2, 'Успех. Небывалый подъем. После периода неудач наступает время приятных сюрпризов. "Туннель" пройден, мрак и страхи уже позади. Мы уверенно шагаем вперед.');
+INSERT INTO card_combination VALUES (149, 136, 2, 'наверх. Подъем будет медленным и трудным, однако с каждым шажком вы будете подниматься все выше. Главное — не торопиться.');
+INSERT INTO card_combination VALUES (149, 150, 2, 'оно может лишь задержать вас в развитии.');
+INSERT INTO card_combination VALUES (149, 151, 2, 'Помехи. Сильные мучения. Человек не может не меняться. Он должен уметь менять свои планы, оставаясь в то же время самим собой. А для этого ему нужно сначала преодолеть влечения, сковывающие его, будто цепи. Но и затворником тоже становиться не стоит.');
+INSERT INTO card_combination VALUES (149, 152, 2, 'Удар. Смертельная опасность. Физическая смерть может и не грозить вам, но возникшая опасность заставит вас реально оценить тот разрыв, который уже давно существует между вашей (эгоистичной) оценкой происходящего и действительностью. Это необходимо для переоценки ценностей, для нахождения новых ориентиров в изменившемся мире.');
+INSERT INTO card_combination VALUES (149, 153, 2, 'надежда не должна покидать вас, новый день будет лучше старого. Этот "туннель" — испытание, данное вам, испытание страхом и сомнением. Ваша вера в жизнь, земную и вечную, должна его выдержать.');
+INSERT INTO card_combination VALUES (149, 154, 2, 'Изменчивость. Конец очередного этапа. Это сочетание карт говорит о том, что вам открылись невидимые миры. Чтобы перейти на новую ступень познания, чтобы стать "посвященным", вам понадобится полная ясность мысли.');
+INSERT INTO card_combination VALUES (149, 155, 2, 'Тепло. Новый этап. Жизнь построена на овеществлении невещественного, на обнаружении изменений сознания, накапливавшихся скрыто. Эта комбинация карт может означать для вас неординарную возможность духовного обогащения.');
+INSERT INTO card_combination VALUES (149, 156, 2, 'Воля. Жребий. Вы ощущаете необходимость пересмотреть многое в своей жизни, необходима очередная трансформация личности. Но чтобы найти путь к истине, вам потребуется сосредоточить на этом всю свою волю.');
+INSERT INTO card_combination VALUES (149, 157, 2, 'Успех. Блестящее возрождение. Ваши тело, разум и дух достигли состояния гармонии. Такое состояние обычно называют счастьем. Это — возрождение ради того нового начала, о котором вы уже думали или мечтали.');
+INSERT INTO card_combination VALUES (150, 136, 2, 'Уход. Поражение. ""Быть или не быть? Вот в чем вопрос!" — эти слова говорит здесь Шут. "Клубы пыли", поднятые вихрем событий, снова на какое-то время скрыли от нас дорогу. Иллюзии были слишком сильны; однако с пути сбивается лишь тот, кот ищет его не в себе самом.');
+INSERT INTO card_combination VALUES (150, 151, 2, 'Помехи. Осознавание взаимосвязей. Такое сочетание карт — признак "бунта", назревающего в вашей душе. Оно же и напоминает Ученикам, которыми мы все являемся, что бунт напрасен, ибо проистекает из непонимания истинной сути вещей. Учитесь понимать законы Вселенной — вот совет, который дает нам здесь четырнадцатый аркан Таро.');
+INSERT INTO card_combination VALUES (150, 152, 2, 'Удар. Неприятная неожиданность. Похоже, над нашей головой вновь собирается гроза? Значит, мы еще не научились соблюдать некоторые ограничения. А ведь кроме них, нас мало что ограничивает! Так мы сами ставим себя под удар. Нужно быть осмотрительнее.');
+INSERT INTO card_combination VALUES (150, 153, 2, 'Миссия. Призвание. Еще не все потеряно. Верьте в свою удачу, она и в самое трудное время не покидает вас. Ничто не собьет вас с намеченного пути, и в конце концов все чудеса природы откроются перед вами. Ваши ожидания не напрасны.');
+INSERT INTO card_combination VALUES (150, 154, 2, 'Изменчивость. Возвращение к жизни. Эмоции, овладевшие человеком, сильны и глубоки, ему трудно быстро восстановить душевное равновесие. Чувства пока преобладают над разумом; нужно задуматься и тщательно проанализировать причины, вызвавшие эти эмоции.');
+INSERT INTO card_combination VALUES (150, 155, 2, 'в новой ситуации привычные средства не помогают. С успехом из нее выйдет лишь тот, кто уже "проработал" подобный вариант в прошлом.');
+INSERT INTO card_combination VALUES (150, 156, 2, 'Воля. Обретение утраченного. Человек оказался не готов к крутому повороту, и теперь над его головой скапливаются тучи. Новые пути и решения, конечно, будут найдены, но сначала должен увлечься всплеск эмоций.');
+INSERT INTO card_combination VALUES (150, 157, 2, 'Успех. Удача возвращается. Чтобы успешно разрешить проблему, созданную кем-то из ваших близких или коллег, от вас требуются доброжелательность и непредвзятость. Как проблема, так и ее разрешение связаны с процессом становления личности.');
+INSERT INTO card_combination VALUES (151, 136, 2, 'немного смелости и терпения, и вам удастся преодолеть этот скользкий подъем. А бегство — это не выход. На время же попробуйте взглянуть с точки Космического Разума, находящегося далеко за пределами трех наших измерений: оно для него не существует.');
+INSERT INTO card_combination VALUES (151, 152, 2, 'Удар. Потеря. В паре с этим арканом разрушения великий разделитель, Дьявол, составляет настоящую гремучую смесь. Из-за собственного высокомерия мы попадаем в неловкие положения, из-за чрезмерного самолюбия испытываем душевный разлад. Гораздо разумнее было бы проявить смирение.');
+INSERT INTO card_combination VALUES (151, 153, 2, 'Миссия. Обновление. Душевный разлад позади, нам удалось снова собраться с силами. Укротив свой "внутренний хаос" и бурные порывы, мы многое приобрели.');
+INSERT INTO card_combination VALUES (151, 154, 2, 'если ваши поиски не носят чисто духовного характера, вы обретаете не свободу, а кабалу.');
+INSERT INTO card_combination VALUES (151, 155, 2, 'Тепло. В кабале у мужчины. Прекрасный пример противоборства тьмы и света. Ведь Люцифер, как мы помним — "похититель света", своего рода черная дыра, как говорят современные астрономы. Эти карты олицетворяют бунт, борьбу темных сил с Истиной.');
+INSERT INTO card_combination VALUES (151, 156, 2, 'Воля. Это — "нисхождение в ад", неизбежный этап на пути для того, кто все время стремился избежать встречи с Истиной. Свернуть с этого пути нельзя. Уже поздно.');
+INSERT INTO card_combination VALUES (151, 157, 2, 'Успех. Свет в конце туннеля. Человек выходит победителем из изнурительной битвы с неуправляемыми силами собственной души. Карта Мира показывает, что вы вернули себе власть над самим собой. И заслужили награду.');
+INSERT INTO card_combination VALUES (152, 136, 2, 'Уход. Упадок сил. Наш разум вдруг освободился от всего, что ему мешало; именно этот, последний удар, пожалуй, и убедил нас окончательно, что никакие превратности судьбы не заставят нас сойти с избранного пути. "Собаки лают, а караван идет", — как говорят на Востоке.');
+INSERT INTO card_combination VALUES (152, 153, 2, 'Миссия. Спасение. Соединение этих двух карт выражает надежду на лучшее. Удар был болезнен, но он помог нам осознать суть и смысл мирового равновесия. Карта Звезды возрождает угасшую было надежду на выздоровление.');
+INSERT INTO card_combination VALUES (152, 154, 2, 'после такого количества треволнений мы "сломались"  Нарыв прорвался. Будем строить все заново, в новых условиях — и без излишних эмоций.');
+INSERT INTO card_combination VALUES (152, 155, 2, 'пока человек жив, он надеется. Несчастья уже в прошлом, они забыты, над горизонтом восходит заря новых надежд.');
+INSERT INTO card_combination VALUES (152, 156, 2, 'Воля. Целительный удар. Приговор судьбы суров, но действие его благотворно. В то же время человек должен добровольно принять его, чтобы благодаря ему действительно снова собраться с силами и найти им достойное применение.');
+INSERT INTO card_combination VALUES (152, 157, 2, 'Успех. Смирение. Поблагодарим судьбу за то, что "Хаосу" (карта Разрушаемой Башни) не удалось окончательно разрушить нашу личность. Теперь все силы, окружающие нас, будут лишь помогать нам вновь обрести себя и прочно встать на ноги.');
+INSERT INTO card_combination VALUES (153, 136, 2, 'Уход. Нежелание трогаться с места. Судьба ведет вас к благополучию, не иллюзии мешают вам понять ее замысел и согласиться с ним. Наберитесь терпения и ждите знамения, сигнала, который укажет вам верный путь.');
+INSERT INTO card_combination VALUES (153, 154, 2, 'Изменчивость. Встреча с женщиной. Это сочетание карт указывает на благоприятное разрешение ваших финансовых проблем в самом скором времени, призывая надеяться на лучшее. Оно говорит и о том, что вы обладаете богатым воображением, достаточным, чтобы проникнуть мысленным взором в окружающие вас миры, видимые и невидимые.');
+INSERT INTO card_combination VALUES (153, 155, 2, 'Тепло. Встреча с мужчиной. Свет Звезды активизирует все жизненные начала, ведь она и сама представляет собой одно из таких таинственных начал. Сочетание двух звезд (ибо Солнце — это тоже звезда) означает гармонию, истинную удачу, а не декартову удачу игрока.');
+INSERT INTO card_combination VALUES (153, 156, 2, '"Не судите, да не судимы будете"  Вы совершили действие, и его последствия не заставят себя ждать. Теперь вас ожидает приговор судьбы — возможно, суровый, но справедливый.');
+INSERT INTO card_combination VALUES (153, 157, 2, 'успех ожидает любое ваше предприятие. В настоящий момент все четыре измерения окружающего мира подчиняются вам.');
+INSERT INTO card_combination VALUES (154, 136, 2, 'мы целиком в его власти, говорят эти карты. Нужно вырваться из этого круговорота ледяных зеркал, искажающих наш собственный образ, и найти путь к нашей цели.');
+INSERT INTO card_combination VALUES (154, 155, 2, 'Тепло. Сближение. Сочетание двух светил означает начало новой фазы некоего цикла. В союзе с женским психическим началом (Луна) Солнце приобретает власть над окружающими его потоками энергии, начинает регулировать их. Такое "соединение" всегда означает взаимопонимание и сотрудничество.');
+INSERT INTO card_combination VALUES (154, 156, 2, 'Воля. Расхождение. Эти карты показывают, что силы, символизируемые Луной, следует упорядочить и перераспределить, пропустив через "призму", преломляющую образ настоящего в образы будущего. "Детство" уходит, уступая место "зрелости" и ответственности.');
+INSERT INTO card_combination VALUES (154, 157, 2, 'идеи уступают место действию, творчеству, становясь видимыми и осязаемыми для других. Это поможет нам яснее определить свои планы и намерения, откорректировать их — и найти для наших идей достойное место среди множества прочих.');
+INSERT INTO card_combination VALUES (155, 136, 2, 'остается надеяться лишь на собственные ум и волю. Однако при этом необходимо будет соблюдать полное хладнокровие. Сумеете?');
+INSERT INTO card_combination VALUES (155, 156, 2, 'Воля. Решающее усилие. Очередное решение судьбы принимается с участием могучих сил света, что дает возможность серьезно изменить многое в окружающем вас мире. Силы неба на вашей стороне; они помогут вам вновь обрести собственное "я" ');
+INSERT INTO card_combination VALUES (155, 157, 2, 'Успех. Значительное богатство. В самое ближайшее время перед вами наконец откроется возможность реализовать свои способности в самых благоприятных условиях. Космические течения сами несут вас к цели, помогая вам занять в мире свое истинное место, уготованное Творцом.');
+INSERT INTO card_combination VALUES (156, 136, 2, 'Уход. Упадок сил. Приговор судьбы, символизируемый картой Суда, вызывает у вас эмоциональный протест. Эти эмоции вызваны, в первую очередь, изменением условий жизни, наступившим столь внезапно. Вам необходим некоторый период отдыха, чтобы улеглись эмоции и восстановилась ясность мысли.');
+INSERT INTO card_combination VALUES (156, 157, 2, 'Успех. Полное владение ситуацией. Сама судьба благоприятствует вашему предприятию. Вы ощущаете полную гармонию со своим окружением и уверенно движетесь к намеченной цели. Напряжение спадает, наступает ощущение покоя.');
+INSERT INTO card_combination VALUES (157, 136, 2, 'Уход. Цель, ускользнувшая от нас. Иллюзии слишком сильны; нужно скорее проснуться, чтобы избежать неприятностей, которые могут затянуться надолго. Иллюзии скрывают от нас реальности повседневной жизни, с которыми надо считаться гораздо больше, чем с теми химерами, которые создает Шут. От иллюзий лучше избавиться раз и навсегда.');
+
+INSERT INTO meaning_major VALUES (224, 2, 'straight', 3, '**Луна** как символ нашего "лунарного" сознания, интуиции и могущества наших подсознательных сил.\n\n**Мифологический образ**: Царица Небесная, Все-Подательница, мать-владычица, создательница, начинательница во всех ее ипостасях: Эвринома, Майя, Найт; или же в проявлении своей двоичности — Изида/Нефтида, Ева/Лилит, Инанна/Эрешкигаль, Деметра/Персефона.\n\n**Ицзин**: 2 Кунь. Исполнение. \n\n**Жрица** олицетворяет наши неосознаваемые силы — интуицию, предчувствие, часто необъяснимую, но вполне твердую уверенность в чем-то. Это — ключ к той таинственной истине, которая ускользает от логически мыслящего разума. Она знает, что та истина, которую мы можем выразить словами, никогда не бывает вечной истиной. Ее светлая сторона — это выражение терпения, понимания, снисхождения, доброты и умения прощать, использование сил подсознания на благо людям — ради помощи, исцеления, ясновидения. Теневая же ее сторона соответствует архетипу *темной сестры*, ведьмы, использующей свои душевные силы, чтобы соблазнять других, заманивать их в ловушки или еще как-то вредить им. В Таро, однако, чаще всего выделяется ее светлая, добрая сторона. Она считается одним из трех *ангелов-хранителей* , так как, согласно христианской мифологии, Святая Дева-девственница может сделать человека неуязвимым. Работа **Жрица** означает, что либо наш род занятий так или иначе относится к сфере ее влияния, то есть к достаточно широкому спектру целительской или иной эзотерической деятельности, либо же мы относимся к своей работе в духе принципов Жрицы: подходим к любым своим задачам с открытой душой, с терпением, всегда готовы услышать и воспринять новое, принимаем решения, повинуясь не только логике, но прежде всего интуиции. Если такое отношение к работе достигнуто и поддерживается нами сознательно, у нас возникает прекрасное ощущение *ведомости*, руководства свыше. Правда, оно не должно, как говорится, выходить из берегов, чтобы мы не выглядели в глазах других людьми капризными, непредсказуемыми, а то и опасными. Сознание Эта карта означает период, когда нам следует посвятить максимум внимания своему подсознанию, тем образам, мечтам и фантазиям, которые возникают в самой глубине души, чтобы приподнять тот покров, под которым **Жрица** скрывает свое знание — ту самую *Древнюю Истину*, которую людям всякий раз приходится открывать заново. Наш дуалистически устроенный разум отказывает нам в этом путешествии, не позволяя больше различить, где добро, а где зло. *Мудрость Лона* же скрывает тайну присущей всему полярности, которая и заставляет нас с восхищением и ужасом одновременно вглядываться в лик белой и черной Изиды, потому что мы никогда не знаем, зло ли, добро ли перед нами, ждет ли нас исцеление или гибель. Этот архетип женского начала, со всей его красотой и опасностью, описан у многих авторов, в том числе у Райдера Хаггарда и Густава Мейринка . Личные взаимоотношения В них **Жрица** проявляет себя главным образом со светлой стороны. Она олицетворяет взаимопонимание, глубокую привязанность, близость и родство душ, чувствительность, заботливость по отношению друг к другу, а не в последнюю очередь — и уверенность в том, что нас с партнером соединяет невидимая, но прочная нить. Если эта карта выпадает в период, когда мы остались одни, то она означает, что такой образ жизни необходим нам сейчас, доставляет нам удовлетворение, или что мы находимся в ожидании, в состоянии открытости и готовности к новой встрече, прислушиваясь к своему внутреннему голосу и не принуждая себя ни к чему — или не давая другим принуждать нас.');
+INSERT INTO meaning_major VALUES (214, 4, 'straight', 3, 'Солнце в Козероге, олицетворяющее чувство ответственности, порядок, безопасность, структуру, последовательность и настойчивость.\n\n**Мифологический образ**: Праотцы Авраам, Исаак и Иаков.\n\n**Ицзин**: 7 Ши. Войско. \n\n**Хозяин** — это структурообразующий элемент, он символизирует наше стремление к стабильности, безопасности и последовательности. Это наше стремление к независимости от условий существования Природы и нашей собственной натуры, часто непредсказуемых. Таким образом, он соответствует нашему стремлению к цивилизации, благодаря которому мы строим себе дома, проводим в них отопление и устанавливаем кондиционеры, чтобы уберечь себя от жары, холода и сырости, создаем автомобили и самолеты, чтобы сократить расстояния и сделать путешествия более комфортабельными, и открываем школы, чтобы повысить уровень образования. Даже то, что при этом тоже многое делается чересчур, не зачеркивает для нас тех положительных сторон, которыми обладает эта часто аллергически воспринимаемая карта. Хозяин совмещает в себе не только стремление к порядку, трезвый ум, дисциплину, чувство ответственности и прагматизм, но и их крайности — упертость, косность, стремление *улучшать* все до бесконечности, жажду власти и деспотизм. Работа Эта карта означает, что мы прежде всего стремимся навести порядок и осуществить свои давние желания, намерения и планы. Хозяин — это четкая концепция, дисциплина, настойчивость, решительность и готовность нести ответственность за свои решения. При этом потрудиться нам придется на совесть, потому что нам никто ничего (или почти ничего) не подарит и не уступит, так что успех дела зависит целиком от наших собственных усилий. Сознание В плане сознания Хозяин указывает на реалистичность мышления, прагматический подход к делу, методичное следоание плану и трезвый ход рассуждений. Это — период, когда зыбкие, расплывчатые мечты упорядочиваются и обретают структуру. Пожелания становятся конкретными планами, подвергаются критическому разбору и могут быть приняты к осуществлению. На глубинном уровне эта карта может означать конфликт между различными сторонами образа отца: с одной стороны это защитник, податель благ, с другой — холодный, неприступный деспот. Личные взаимоотношения Период, когда наши отношения укрепляются, становятся надежными, прочными. Трезвый, критический аспект карты Хозяина может при этом означать утрату прежних иллюзий. В то же время его стремление к максимальной стабильности и безопасности может завести отношения в тупик, ограничив их лишь самым необходимым.');
+INSERT INTO meaning_major VALUES (219, 3, 'straight', 3, '**Венера в Тельце** как символ плодородия и роста.\n\n**Мифологический образ**: Великая Мать во всех ее ипостасях: Инанна, Иштар, Анат, Астарта, Афродита и Деметра, а также Мать-Земля как Гея или Рея.\n\n**Ицзин**: 48 Цзин. Колодец. \n\n**Хозяйка** — это неисчерпаемая мощь Природы, беспрерывно порождающей новую жизнь. Эта карта обозначает жизненную силу, плодородие, рост и зарождение нового. Она — неиссякаемый источник всякой жизни, нашего творческого потенциала и нашей способности воспринимать тонкие вибрации и воплощать их в форме чего-то нового. На физическом уровне это рост, плодородие, на эмоциональном — творческие способности, на уровне разума — богатство идей, изобретательность, а на уровне сознания — познание нового. Постоянное рождение нового означает как непрерывное изменение нашей жизни, так и необходимость терпеть боль, связанную с этим рождением. Работа Период высвобождения очень мощных энергий. Творческий подъем у художников, писателей, музыкантов или специалистов по рекламе, у инженеров, техников или дизайнеров — новые, нетривиальные идеи; у представителей других профессий — перемены, нововведения, рост и оживление деятельности. Хозяйка здесь может означать также, что перемены уже произошли, и мы теперь должны привыкать к ним, переучиваться со старого на новое. Это могут быть изменения условий труда, новый преподаватель, начальник или сотнудник, или смена рода или объекта деятельности. В некоторых случаях эта карта указывает на новую профессию или должность. Но, хоть роды — дело всегда болезненное, эти перемены, как правило, к лучшему. Сознание Новые знания, представления. Возможно, они не понравятся вам или даже покажутся горькими. Однако в любом случае это ценное приобретение. Оно заставляет нас внимательнее присмотреться к казавшемуся таким привычным ходу вещей, чтобы увидеть, как отмирает старое, а из него нарождается новое. На более глубоком уровне эта карта может означать конфликт между обоими аспектами образа матери: светлым, полным заботы и безоговорочной любви, и его темной стороной, которую Юнг называл Mater saeva cupidinum — *дикая мать похотей* . Личные взаимоотношения В этой области Хозяйка также указывает на перемены и зарождение нового, будь то прибавление семейства или любые иные изменения в отношениях партнеров. В любом случае они оживляют рутину и по сути своей благоприятны. Кроме того, она может означать наступление новой фазы материнской любви, или ту ниву, на которой вызревают ваши новые отношения с кем-то.');
+INSERT INTO meaning_major VALUES (142, 19, 'reverted', 1, 'Поздравляю!\n\nВы вытянули одну из самых лучших карт.\n\nПри ответе практически на любой вопрос она дает благоприятный ответ.\n\nКак правило, она говорит о хорошем здоровье (выздоровлении), благополучии детей, удаче в делах. Иногда подтверждает указание на рождение ребенка. И даже в перевернутом виде сохраняет свое значение, разве что указывая на легкое облачко, набежавшее на **Солнце**; но это облачко скоро рассеется.');
+INSERT INTO meaning_major VALUES (163, 15, 'straight', 1, 'Враги слабее вас, они отступят.\n\nПеревернутый, наоборот, советует пойти на компромисс или на уступки.');
+INSERT INTO meaning_major VALUES (148, 18, 'straight', 1, 'Вы стоите на пороге чего-то нового в своей жизни, чего раньше никогда не испытывали, и именно это вызывает у вас страх и беспокойство (новая работа, новый брак, новая страна).\n\nНе бойся, говорит эта карта, смело ступай вперед и берись за дело, ибо новое всегда лучше старого.Еще эта карта может служить одним из признаков материнства (беременности), вообще означать преобладание иньского, воспринимающего начала, а также мир иллюзий.\n\nПри этом *иллюзии* могут указывать на профессию или советовать заняться каким-нибудь таким делом, как кино, телевидение, фотография, реклама.\n\nДля_бизнесменов Ставки на шоу-бизнес, кино, рекламу, для особо смелых — на компьютерные игры, игровые автоматы, казино.');
+INSERT INTO meaning_major VALUES (152, 17, 'reverted', 1, 'Увы, сейчас у вас не самый удачный период в жизни.\n\nСкорого улучшения ситуации ожидать не приходится, награды тоже.\n\nПоэтому наберитесь терпения и постарайтесь восстановить свое душевное равновесие.\n\nМожет быть, есть смысл взять отпуск или просто уехать куда-нибудь отдохнуть. Или обратиться к одной из гармонизирующих техник — ушу, цигун, рейки и т.д.');
+INSERT INTO meaning_major VALUES (160, 15, 'reverted', 2, 'Преобладание влечения и агрессивности как в профессиональной, так и в личной жизни.\n\nПотребность самовыражения через чисто физическое действие.\n\nНенасытная жажда власти.');
+INSERT INTO meaning_major VALUES (197, 8, 'reverted', 1, 'Вы не захотели или не сумели действовать мягко, найти способ гармоничного сосуществования с другим человеком, отсюда — большая вероятность неудачи задуманного дела.');
+INSERT INTO meaning_major VALUES (169, 13, 'straight', 3, 'Сатурн в VIII доме.\n\nПланета Предела, разлуки и прощания, умирания и возрождения.\n\n**Мифологический образ**: Братья Сон и Смерть (Гипнос и Танатос), сыновья Ночи (Никты).\n\n**Ицзин**: 59 Хуань. Раздробление. \n\n**Смерть** — это расставание, прощание, конец. Тем самым она оказывается провозвестницей нового, грядущего, хотя по самой карте на первый взгляд этого не скажешь. Тем не менее карта скорее хорошая, потому что конец этот закономерен, мы его долго ждали, потому что это — освобождение, хотя и сопровождаемое огорчением и болью. В противоположность Десятке Мечей, означающей конец насильственный, то есть преждевременный, эта карта символизирует естественный конец, указывая, что пришла пора расстаться с кем-то или с чем-то. Так что ее совершенно напрасно связывают с одним только горем. Или, наоборот, считают эту карту только началом чего-то нового, не понимая смысла символики Смерти как начала и конца одновременно, как горечи расставания и радости ожидания. *Мы отделили жизнь от смерти, и заполнили промежуток между ними страхом*, — писал Кришнамурти. — *Однако жизни без смерти не существует* . Работа Здесь Смерть чаще всего означает конец нашей теперешней деятельности. Она советует окончательно проститься с той работой или должностью, к которым мы так привыкли, внутренне освободиться от них, чтобы подготовиться к тому новому, что ожидает нас впереди. Однако не следует торопить события: эта карта советует дать себе время покоя, чтобы подвести итоги и спросить себя, все ли мы выполнили из того, что должны были. Лишь убедившись, что мы больше ничего никому не должны, мы можем сделать следующий шаг. Сознание Завершение очередного этапа развития, пора расставания с прежними представлениями об окружающем мире или о себе самом. Чаще всего это определенные психологические установки, сложившиеся взгляды и убеждения, которые мы не сами в себе выработали, а переняли от родителей, учителей или иных людей, к которым не умели относиться критически. Однако это могли быть и собственные шаблоны поведения или маски, которые теперь придется отбросить, чтобы наше подлинное *Я* могло выйти на свет. В глубинном плане эта карта может означать, что у нас выработалось новое понимание смерти — возможно, в том смысле, как об этом сказано у К.-Г. Юнга: *Смерть, если подойти к ней психологически правильно, есть не конец, а цель, и поэтому человек, перевалив за вершину жизни, начинает жить ради смерти*  Личные взаимоотношения Конец очередного этапа в развитии этих отношений, то есть, как это часто бывает, расставание с прежним партнером. Даже если оно болезненно, избежать этого невозможно. Поэтому не стоит ни оттягивать это расставание, ни, наоборот, резко рвать все прежние узы, потому что когда рвешь, то и тебя рвет. Лучше поблагодарить своего партнера за все, что он успел дать вам за это время, и пожелать ему удачи на дальнейшем этапе дороги.');
+INSERT INTO meaning_major VALUES (217, 4, 'reverted', 1, 'Сейчас вам не следует применять силу.\n\nЕсли речь идет о *сильном* (влиятельном) человеке, то он не на вашей стороне и будет скорее противодействовать, чем помогать вам.');
+INSERT INTO meaning_major VALUES (174, 12, 'straight', 3, '**Рыбы** как символ жертвы и просветления.\n\nСолнце в XII доме, обозначающее "плен", а в более глубоком смысле — крупнейший жизненный переворот в результате осознания некоего важного принципа.\n\n**Мифологический образ**: Все прикованные или повешенные герои: Прометей на Кавказе, Один на мировом ясене Иггдрасиль, Аттис на сосне и Шем-Хасай на южном небе как созвездие Ориона.\n\nТакже Иона во чреве китовом. \n\n**Ицзин**: 12 Пи. Упадок. \n\n**Повешенный**, если взглянуть на него по-простому, означает, что мы *застряли*, зашли в тупик. При более близком рассмотрении оказывается, что за этой внешней неподвижностью кроются как необходимость, так и возможность пересмотра многого в своей жизни, а за счет этого — и глубоких перемен как в самой жизни, так и во взгляде на нее. Пассивность, к которой мы приговорены в этот период, лучше всего можно передать образом лежачего больного, — это тоже одно из значений карты. О Повешенном как ощущении хорошо сказал К.-Г. Юнг: *Висеть означает <…> и даже вполне позитивное hanging on , что, с одной стороны, означает некоторое затруднение, хотя и преодолимое, однако именно поэтому представляет собой с другой стороны ту редкую ситуацию, которая требует от человека величайшего напряжения, давая ему возможность раскрыть себя целиком* . Работа Первое значение — стагнация, то есть замедление хода дел, возможно даже значительное, если вообще не остановка. Ваш проект *завис*, обещали повысить — и дальше ни звука, перейти к новому делу никак не удается, самые важные планы натыкаются на неодолимые препятствия. Хотя такой застой редко наступает неожиданно. Начинается все с неурядиц, мелких, но досадных; мы злимся, конечно, но не придаем им значения. Лишь когда их накапливается много, потеря темпа становится заметной, а там уж недалеко и до остановки. И тут уж ни упорство, ни даже силовые методы не помогают. И единственный выход из такой ситуации — это осознать, что мы зашли в тупик. Только когда мы действительно будем готовы перестроиться и переучиться, проблемы начнут решаться. Эх, задумайся мы тогда над этими мелочами!.. Сознание И тут кризис. Мы слишком долго цеплялись за что-то и теперь видим, что это и было для нас самой большой помехой. Но в том-то и заключается великий смысл этой карты. Часто бывает, что человек долго болел или был без работы, и это давит и будет давить на него до тех пор, пока он не перестроится и не переучится, не привыкнет к новому взгляду на мир или к новым условиям и занятиям. Если мы уже пришли к выводу, что зашли в тупик, однако еще не поняли, в чем ошиблись или за что цеплялись слишком долго, то помочь нам может только обретение мира в душе и терпеливое самосозерцание. И, рассмотрев и проанализировав как следует свою проблему, мы рано или поздно придем к познанию ее сущности, обнаружим то, что до сих пор упускали из виду. Личные взаимоотношения В этой области карта Повешенного чаще всего проявляет свои самые печальные стороны. Мы прочно застряли в своей теперешней совсем невеселой ситуации. Это может быть жизнь в одиночестве в течение довольно долгого времени, целый ряд безуспешных попыток найти или восстановить надежные партнерские отношения, или, наоборот, освободиться от нелепого союза, в который мы впутались по собственной неосторожности. Но, как бы там ни было, эта карта и в данном случае означает необходимость перестроиться и прежде всего понять, что мы в свое время неправильно поняли или сделали, после чего и заработала эта молотилка.');
+INSERT INTO meaning_major VALUES (218, 4, 'straight', 1, 'Судя по всему, вам нужно обратиться за помощью к кому-то *сильному* (отцу, начальнику и т.п.) или самому проявить силу.\n\nОднако при этом необходимо знать меру, ибо сила, которую по инерции продолжают применять, когда необходимость в ней уже исчезла, становится разрушительной (порок всех революций).Для бизнесменов — совет не миндальничать с недобросовестными работниками, партнерами, любовницами, иначе погибнет дело.');
+INSERT INTO meaning_major VALUES (141, 19, 'straight', 2, 'Это — карта жизни и радости, света и тепла.\n\nЕсли удача еще не пришла к вам, то она уже близка и вполне реальна.');
+INSERT INTO meaning_major VALUES (153, 17, 'straight', 1, 'Вы чем-то расстроены?\n\nВам кажется, что полоса неудач никогда не кончится?\n\nПостарайтесь восстановить в себе внутреннюю гармонию, мир в собственной душе.\n\nВерьте в свою звезду, говорит эта карта, и следуйте ей. Скоро все переменится, ваша ситуация улучшится. Вы получите награду — может быть, в переносном, а может быть, и в самом прямом смысле.Что касается бизнесменов, то вообще-то это не их карта; но если уж она выпала, то совет: либо немедленно заняться обеспечением себе места в Госдуме (парламенте области, городской мэрии, сельсовете), либо же сделать ставку на благотворительность — жертвовать как можно больше на церковь, детские больницы, школы и т.п.');
+INSERT INTO meaning_major VALUES (165, 14, 'reverted', 2, 'Перевернутая карта Умеренности означает, что привыкание к новой ситуации происходит медленно и с трудом.\n\nОднако это не умаляет энергии, олицетворяемой этой картой: двигатель исправен, его нужно только запустить.');
+INSERT INTO meaning_major VALUES (183, 11, 'straight', 1, 'Это — карта, предвещающая скорое восстановление нарушенного равновесия.\n\nКто бы ни совершил дурной поступок, ему придется расплатиться за это, а пострадавший будет вознагражден.\n\nДобрый же поступок будет вознагражден.Если вас интересует исход судебного дела или вопрос, надо ли обращаться в суд, то прогноз благоприятный: дело будет решено в вашу пользу.Смысл предсказания этой карты для бизнесмена ясен из предыдущего.');
+INSERT INTO meaning_major VALUES (158, 16, 'straight', 1, 'Башня прямая_позиция Очевидно, совсем недавно вам довелось пережить крах чего-то старого и привычного (работа, брак, вера в авторитеты) — или вы чувствуете, что это вам еще предстоит.\n\nОднако карта говорит, что эти перемены необходимы, ибо нельзя построить новое, не разрушив старого.\n\nА старое уже отжило, и реанимировать его — пустая трата времени и сил.В чисто бытовом плане она может означать просто конфликт, даже скандал, однако и скандал бывает необходим, чтобы добиться истины.\n\nДля бизнесмена Совет бросить все и бежать, прихватив всю кассу, — или же, наоборот, нанять хорошего юриста и передать дело в суд, потому что шансы на выигрыш в обоих случаях выше, чем если просто сидеть и ждать, чем все закончится.');
+INSERT INTO meaning_major VALUES (156, 16, 'straight', 2, '**Разрушаемая Башня** означает либо сокрушительное поражение, либо, наоборот, блестящую и убедительную победу.\n\nВы должны платить — или же получать награду.\n\nКарта эта может означать также разрыв каких-то личных или служебных отношений.');
+INSERT INTO meaning_major VALUES (162, 15, 'reverted', 1, 'Ваши замыслы чересчур смелы, поэтому шанс *провалиться на экзамене* (как в прямом, так и в переносном смысле) очень велик.\n\nПока не поздно, откажитесь от задуманного дела — или просто не предпринимайте ничего в ближайшее время.Для бизнесмена — совет пойти на компромисс или на уступки.');
+INSERT INTO meaning_major VALUES (167, 14, 'reverted', 1, 'Если вас интересует результат задуманного или уже начатого дела, то сил дойти до цели у вас, скорее всего, не хватит, поэтому от дела лучше отказаться.\n\nКонтакты с зарубежными странами неудачны.Или — излишества, которые, как известно, вредны.Для бизнесмена перевернутая **Умеренность** — совет временно умерить свои аппетиты, затихнуть, *заморозить* фирму и переждать.');
+INSERT INTO meaning_major VALUES (146, 18, 'straight', 2, 'Спрашивающего переполняет энергия, его чувствительность обострена, и это его беспокоит.\n\nЛишь с помощью друзей или близких он может вновь обрести душевное равновесие.');
+INSERT INTO meaning_major VALUES (191, 9, 'straight', 2, 'Эта карта означает период размышлений перед лицом возможных материальных затруднений.\n\nКаждый шаг приходится взвешивать, рассчитывать и проверять.');
+INSERT INTO meaning_major VALUES (195, 8, 'reverted', 2, 'Перевернутая карта Правосудия означает поиски помощи извне, без которой борьба с чувством глубокого одиночества невозможна.');
+INSERT INTO meaning_major VALUES (199, 7, 'straight', 3, 'Овен как порыв силы.\n\n**Мифологический образ**: Порыв солнечного героя, но и его падение: сыновья богов и героев — Фаэтон, Икар, а также Беллерофонт, оседлавший крылатого коня-Пегаса.\n\n**Ицзин**: 4 Мын. Недоразвитость. \n\n**Колесница** означает значительный рывок вперед. Она показывает, что вы вырвались из круга прежних интересов и двинулись своим путем. Движущие силы этого — стремление к свободе, честолюбие, поиск утраченного рая или просто жажда самореализации. Колесница — единственная карта в колоде Таро, связывающая подобный порыв с ощущением радости и уверенности в себе. Во всех прочих случаях прощание со старым связано со страхом и тяжестью на душе. А тут перед нами — смелость, любознательность, когда человек рвется вперед, ища применение своим силам и не боясь рискнуть. Карта символизирует силу и ловкость (управление Колесницей), но также и неопытность (путешествие в неведомые края). Поэтому ее следует воспринимать и как предостережение — не переоценивай свои силы, не будь слишком самоуверен и не допускай *головокружения от успехов*  Лучше всего — вовремя осознать, сколь многому еще предстоит научиться. Работа Тут Колесница показывает, что нам удалось сделать большой шаг вперед, возможно даже — тот самый шаг к независимости, о котором мы мечтали так долго. Она говорит о нашем стремлении к успеху, о настойчивости и отваге, о готовности действовать и рисковать. Поэтому она может означать как уверенное вступление на новое поприще, так и принятие на себя более крупных задач и более высокой ответственности. Она открывает нам двери к успеху, однако предостерегает от злоупотреблений и переоценки собственных сил. Сознание Юное, но уже достаточно мощное самосознание, освобождение из-под гнета чужих мнений и представлений, строительство собственной, достойной нас картины мира, а также готовность смело взяться за решение самых трудных проблем. Часто при этом приходится преодолевать свои внутренние противоречия, например, *раскол* между эмоциями и разумом, волей и влечением, желанием и действительностью. Личные взаимоотношения Карта может указывать и на начало новых отношений, в том числе и как импульсивный, необдуманный рывок на волю из *пут* прежнего и еще не изжившего себя круга интересов или взаимоотношений. Но она может и означать порыв *свежего ветра* в рамках прежнего союза, снимающий накопившуюся усталость, рутину, вдыхающий в него новую жизнь.');
+INSERT INTO meaning_major VALUES (204, 6, 'straight', 3, 'Венера/Юпитер как выражение большой любви, а также Венера/Марс как выбор, обусловленный любовью.\n\n**Мифологический образ**: Утренняя звезда как символ богини любви Афродиты и ее мужская ипостась — Эрос, Амур или Купидон, вооруженный луком и стрелами; или Парис, вынужденный выбирать между Герой, Афиной и Афродитой.\n\n**Ицзин**: 8 Би. **Приближение**. \n\nВ этой карте, собственно, объединены два сюжета. Она открывает перед нами глубокие любовные переживания, но в то же время показывает, что такой шаг всегда предполагает выбор: отказ от прежнего образа жизни (родительского дома, *свободной* жизни и легких интрижек) и переход на новую ступень сознания, ступень осознания Любви. Лишь подлинное осознание ее открывает перед нами то мощное информационное поле, которое содержит карта Влюбленных. Потому-то ее в прежние времена и называли *Выбор*  Она может означать и какую-то иную ситуацию выбора, не обязательно связанную с любовью. Тогда она означает, что нам так или иначе придется сделать выбор, решительно и бесповоротно, не оставляя себе никаких лазеек. Который из двух сюжетов выступает перед нами в данном случае, зависит от смысла заданного вопроса. Однако в любом случае это — громкое и определенное *Да*  Работа Здесь перед нами тоже возникаает ситуация выбора. Эта карта показывает, что пришла пора тщательно проанализировать все обстоятельства и факты, чтобы решиться наконец на что-то определенное, потому что от этого зависит и наши дальнейшие шаги, и вся линия поведения. Это может быть выбор нового направления в работе, смена места работы или переход к новому кругу задач, к какому-то новому проекту и т.д. Впрочем, нового ничего может и не быть, и тогда карта означает лишь, что нам следует избавиться от иллюзий и предрассудков и научиться по-новому относиться к своей теперешней работе. Сознание "***Влюбленные*** показывают, что мы начинаем осознавать свои возможности, понимая, что по-настоящему глубокие и чрезвычайно важные знания откроются нам лишь в том случае, если мы будем твердо придерживаться однажды сделанного выбора, сознательно отказавшись от всего остального. Отсюда ясно, что так называемое *multiple-choice-society* на самом предоставляет людям лишь возможность колоссального самообмана: они верят, что эта возможность в любой момент *передумать*, перерешить все заново позволит им вернее ухватить птицу счастья, и не умеют быть довольны достигнутым, потому что всегда надеются найти что-нибудь получше. И то разочарование, которое можно видеть на их лицах, происходит вовсе не оттого, что им так и не подвернулась их *самая главная* возможность, а оттого, что всю жизнь им нехватало мужества и воли, чтобы хоть раз сделать определенный выбор, и она так и прошла в бесполезном ожидании этого *главного*, а ни на что другое они ни сил, ни чувств затрачивать не хотели. Лишь решась на сознательный, бескомпромиссный выбор и потом не оступая от него, мы открываем себе путь к подлинному, глубокому счастью. Личные взаимоотношения Любовь, озаряющая всю нашу жизнь и во многом меняющая ее. Она имеет мало общего с влюбленностью, которая, по меткому замечанию Эриха Фромма, в лучшем случае представляет собой лишь меру нашего предыдущего одиночества. Карта может указывать как на возникновение нового союза, так и на то, что свое счастье мы можем и должны в теперешнем союзе, именно Здесь и Сейчас. И, поскольку в ней присутствует также немаловажная тема *выбора*, это означает, что источники счастья и радости откроются нам, лишь когда мы добровольно *откажемся* от чего-то и от всего сердца решим, что дальше будем идти по жизни именно с этим человеком. Ясно, что, встретив *девушку своей мечты* или сказочного принца, любой был бы хоть сейчас готов к такому шагу. Однако пора покончить с этим самообманом и понять, что подобных идеалов для нас никто и нигде не припас, по крайней мере в готовом виде, зато не исключено, что этот идеал прячется где-то в глубине души нашего партнера, надеясь и ожидая, что мы найдем его и дадим ему воплотиться. Вотм почему путь, ведущий одного человека к другому, именуется *выбором*');
+INSERT INTO meaning_major VALUES (184, 10, 'straight', 3, 'Сатурн как владыка времени и наставник.\n\n**Мифологический образ**: Богиня судьбы Тихе или Фортуна (от лат. vortumna = "вращающая [колесо года]"  \n\n**Ицзин**: 50 Дин. Жертвенник.\n\nВ зависимости от того, как мы относимся к судьбе, и Колесо Судьбы предстанет перед нами либо как символ нашей зависимости и беспомощности перед нею, либо как жизненный урок, стимулирующий наши рост и взросление. На событийном уровне оно и в самом деле часто соответствует ситуациям, где мы пока ничего не можем поделать. Мы — на спуске Колеса, то есть наши планы не могут осуществиться: нам помешают *пробки* на дорогах, погода, забастовка авиадиспетчеров, *несговорчивость* объекта или иные непреодолимые обстоятельства. Колесо Времени показывает, что еще не время браться за это дело. Тогда карты в остальных позициях могут подсказать нам, что нужно сделать, чтобы перебраться на другую сторону Колеса, соответствующую подъему, успеху, а также неожиданным поворотам судьбы. Работа Здесь Колесо тоже символизирует зависимость, часто связанную с однообразием, рутинностью того дела, которым нам приходится заниматься. Однако именно в этом карта призывает нас увидеть важный положительный смысл. Чем сильнее давит на нас это ярмо, тем ближе мы к мысли, что пора брать судьбу в свои руки. Таким образом, Колесо Судьбы можно считать предвестником больших перемен, которых к тому же нельзя избежать. И, чем с большей готовностью примем мы эту свою судьбу, тем скорее поймем, в чем состоит наше истинное призвание, тем скорее окажемся на другой, поднимающейся стороне Колеса. Если соседние карты показывают, что мы уже находимся на подъеме, значит, смелее вперед, и успех, повышение или иные победы нам обеспечены. Если же оно поворачивается к нам своей падающей стороной, то это — совет задуматься над ситуацией, выяснить ее первопричину (*зри в корень*), чтобы за кажущимся поражением, проигрышем и даже увольнением увидеть *руку судьбы*, ведущую нас на новый путь. Сознание Здесь Колесо Судьбы — это призыв научиться осознавать необходимость, понять, что Колесо Времени требует от нас постоянно расти и развиваться. Не осознав этого закона, который, на первый взгляд, вовсе не обязательно касается именно нас, мы рискуем быстро успокоиться на достигнутом. Вот тут-то и вступает в действие Колесо Судьбы, сталкивая нас с ситуациями, из которых мы должны бываем извлечь очередной урок. Личные взаимоотношения Чаще всего это — сохранение status quo, и мы ничего не можем с этим поделать. Если это одиночество, значит, пока у нас нет шансов найти (или вернуть) партнера, если *проблемный* союз, нас не удовлетворяющий, — значит, и тут тоже ничего нельзя изменить. Однако и в этом случае мы должны понять и хорошенько усвоить урок, который нам дает такая ситуация, после чего для нас станут возможны и перемены к лучшему.');
+INSERT INTO meaning_major VALUES (168, 14, 'straight', 1, 'Если вас интересует исход задуманного или уже начатого дела, то он зависит исключительно от вашего терпения и выдержки.\n\nВ какой-то момент вам может показаться, что не хватит сил довести дело до конца; тогда нужно будет сосредоточиться, сделать необходимое усилие или просто двигаться дальше, не останавливаясь, и тогда успех вам обеспечен.Еще эта карта может означать успешные контакты за границей: поездки, деловые взаимоотношения или даже переселение *на постоянное место жительства*  Ну и, конечно, она может иметь и буквальное значение: *ничего слишком*, как говорили древние, то есть следует соблюдать умеренность во всем (иначе сил не хватит достичь цели).');
+INSERT INTO meaning_major VALUES (172, 13, 'reverted', 1, 'Судя по всему, вы достигли определенного возрастного рубежа, и у вас произошло обновление личности.\n\nСейчас вы уже другой человек, чем были раньше.\n\nДаже если это не так, в любом случае карта советует вам не торопить события, не стараться изменить что-либо.\n\nСейчас лучше всего — ждать.');
+INSERT INTO meaning_major VALUES (173, 13, 'straight', 1, 'Вы достигли определенного возрастного рубежа, когда у человека происходит обновление личности, переход в новое качество.\n\nНе надо бояться предстоящих перемен, говорит эта карта, не надо задерживать их.\n\nОни нужны для вашего дальнейшего роста.\n\nБизнесмену эта карта рекомендует немедленно закрыть фирму, если она сравнительно маленькая, и открыть новую где-нибудь в другом месте; если фирма крупная, то переименоваться (перерегистрироваться).');
+INSERT INTO meaning_major VALUES (177, 12, 'reverted', 1, 'Увы, сейчас вы не готовы воспринимать новое, поэтому учеба и пересмотр старых взглядов для вас маловероятны.\n\nДля_бизнесмена Одно из двух: либо ваша фирма богата и сильна, и тогда вам надо стоять на своем, не меняя ни буквы в ранее заключенных договорах, либо же это маленькая фирма, которая многим рискует, и тогда вам лучше сразу сдаться *на милость победителя*, то есть того, кто первым пришел и предложил энную сумму денег.');
+INSERT INTO meaning_major VALUES (178, 12, 'straight', 1, 'Эта карта говорит о необходимости и возможности учиться новому.\n\nПоэтому, если вас интересуют такие вопросы, как поступление в институт или вообще начало учебы чему-то, то она дает положительный ответ.\n\nИ прогноз тоже положителен: вы будете приняты, и учеба пойдет хорошо.\n\nЕсли же вас интересует иная житейская ситуация, то это совет не держаться за свои старые представления, а попробовать найти новую точку зрения. Для бизнесмена Cовет начать осваивать новые области бизнеса.');
+INSERT INTO meaning_major VALUES (138, 20, 'straight', 1, 'Если вы человек творческий (артист, музыкант, художник), то эта карта означает для вас наступление нового периода вдохновения, активной работы, возможно, поиск новых форм.\n\nВообще же она означает прилив новых сил, открытие *второго дыхания*, переход в новое качество.\n\nПравда, если вы не ищете успеха или славы, а мечтаете лишь о покое и сохранении status quo, то она может означать досадное беспокойство, нарушение привычного хода вещей.В бизнесе эта карта обещает благополучие кинематографистам, поэтам и прочим людям, бизнесом в прямом смысле слова не занимающимся; настоящим же бизнесменам обещает скорее большие хлопоты — и дает совет нанять (спонсировать) людей искусства, чтобы они создали имидж его бизнесу.');
+INSERT INTO meaning_major VALUES (182, 11, 'reverted', 1, 'Произошло нарушение космического равновесия.\n\nВесьма возможно, что вы сами совершили или намереваетесь совершить дурной поступок.\n\nЗадумайтесь над этим, пока не поздно — или готовьтесь к последствиям.Если вас интересует исход судебного дела, то прогноз неблагоприятен.');
+INSERT INTO meaning_major VALUES (187, 10, 'reverted', 1, 'Успех, если вы останетесь верны себе, вернетесь к своему истинному *Я*  Если у вас сейчас *темная полоса*, значит, скоро все обернется к лучшему.\n\nА если светлая, то приготовьтесь к ухудшению ситуации.\n\nЭто закономерно, и бояться этого не стоит: нужно просто отметить для себя, что полоса удач заканчивается, и какое-то время придется терпеть неудачи.');
+INSERT INTO meaning_major VALUES (188, 10, 'straight', 1, 'Вы ощущаете неуверенность в себе, в своих силах, в завтрашнем дне.\n\nОтсюда совет: твоя единственная, самая надежная опора — ты сам.\n\nБудь верен себе, и все будет в порядке.Если вас интересует, чем закончится то или иное дело, или стоит ли за него браться, то прогноз в целом положительный, а совет аналогичен: не изменяй своим принципам, и все получится прекрасно.**Колесо Фортуны** для бизнесмена — лишь признак перехода от удач к неудачам или наоборот.');
+INSERT INTO meaning_major VALUES (193, 9, 'straight', 1, 'Если вы — эзотерик или просто заботитесь о своем духовном росте, то **Отшельник** может означать для вас ощущение одиночества оттого, что друзья и близкие перестали понимать вас: вы ушли вперед, а они остались там же, где были.\n\nПоэтому общаться вы можете теперь лишь с такими же **Отшельник**ами, но их мало, а поблизости может не быть вообще.\n\nПоэтому иди вперед, говорит эта карта, покоряй следующие вершины, и ты найдешь новых друзей.Если вас интересуют вещи более простые и обыденные, например: *Вернется ли он?* Или: *Скоро ли я выйду замуж?* — то **Отшельник** означает просто одиночество, по крайней мере в ближайшее время, то есть отрицательный ответ на подобные вопросы.\n\nХотя та же карта дает и совет: займись пока лучше своим духовным ростом, используй отпущенное тебе одиночество для пользы дела. Для_бизнесмена **Отшельник** неблагоприятен. Единственный совет — немедленно бросить тот бизнес, которым он занимается в данный момент, и переключиться на что-то другое.');
+INSERT INTO meaning_major VALUES (198, 8, 'straight', 1, 'Эта карта означает возможность добиться успеха — и совет действовать мягко, ненавязчиво.\n\nЖенщине советует также умнее вести себя в отношениях с партнером, не вдаваясь в ненужные споры.\n\nМужчине — найти такую женщину, которая поможет ему стать уверенным в себе.Людям, чересчур увлекающимся духовными исканиями, аскезой, или просто кабинетным ученым она рекомендует вспомнить о своем физическом теле, которое нужно упражнять, кормить и баловать прочими радостями жизни.Людям, увлекающимся радостями жизни, напоминает о необходимости духовных исканий и/или серьезной научной работы.\n\nБизнесмену, которому грозит опасность, лучше как можно быстрее объединиться или хотя бы договориться о совместных действиях с какой-нибудь крупной фирмой, возможно, даже с конкурентом.');
+INSERT INTO meaning_major VALUES (181, 11, 'straight', 2, 'Это — внутренняя сила человека, деятельная и благотворная.\n\nОна помогает в любых жизненных ситуациях.\n\nУ того, кому выпала эта карта , хватит сил не только строить планы, но и выполнять их.');
+INSERT INTO meaning_major VALUES (151, 17, 'straight', 2, '**Звезда** — одна из самых прекрасных карт Марсельского Таро.\n\nОна означает раскрытие возможностей, вдохновение, призвание.\n\nЭто — карта творческой личности, стремящейся лишь к одному: выразить себя в творчестве, превзойти себя.');
+INSERT INTO meaning_major VALUES (203, 7, 'straight', 1, 'Эта карта означает конец одного периода жизни и начало следующего, нового.\n\nЭто может быть смена места жительства или работы, просто путешествие или поездка.Иногда (редко) **Повозка** может указывать на некую тайну, в которую лучше не проникать, даже просто вопрос, ответа на который добиваться не стоит, потому что вызванные им перемены могут быть катастрофическими.\n\nБизнесмену пора приготовиться к крупным переменам в его бизнесе — таким, как мировое падение цен и спроса, ликвидация важнейших производителей или переход на совершенно новые технологии.');
+INSERT INTO meaning_major VALUES (207, 6, 'reverted', 1, 'Карта Влюбленных может означать некоторое ухудшение отношений в союзе партнеров, иногда (но далеко не всегда) супружескую измену; в деловых отношениях — тоже проблемы.\n\nНо это временно, и восстановить добрые отношения по-прежнему вполне возможно.Для эзотериков — ложное посвящение или неготовность перейти на следующую ступень.');
+INSERT INTO meaning_major VALUES (137, 20, 'reverted', 1, 'Творческий кризис, застой, неудача или задержка с книгой, пьесой, проектом.\n\nИли — сохранение привычного состояния, неизменность, покой.');
+INSERT INTO meaning_major VALUES (208, 6, 'straight', 1, 'натуры деятельные, склонные к риску, в ситуации выбора скорее предпочтут карту Диавола (XV), обещающую перемены.\n\nКакими будут эти перемены, неизвестно, но риск, как известно, благородное дело.\n\nДля людей, идущих эзотерическим путем, **Влюбленные** имеют значение Посвящения, инициации, перехода на новую ступень духовного развития.Для бизнесмена — совет: любым способом войти в самый высший контрольный орган своей отрасли, иначе он ничего не добьется и разорится года через два-три.');
+INSERT INTO meaning_major VALUES (212, 5, 'reverted', 1, 'Возможности учиться у вас сейчас нет, брак не состоится, в том числе и мистический (учитель окажется лжеучителем, учение — лжеучением); или же в данное время вам не удастся посвятить себя искусству.');
+INSERT INTO meaning_major VALUES (237, 0, 'reverted', 1, 'Неготовность открыться будущему, цепляние за прошлое, отсутствие свободы или, если сюрприз, то уж точно неприятный.\n\nВ самом простом смысле — указание на нервное расстройство (невроз, психоз).');
+INSERT INTO meaning_major VALUES (213, 5, 'straight', 1, '**Первосвященник** указывает главным образом на две вещи: процесс учения, обучения, и брак.\n\nОн дает положительный ответ на вопрос о возможности или необходимости учиться или учить, а также предвещает заключение брака, официального или мистического.\n\nПод мистическим браком понимается союз человека не только с другим человеком, но и человека с некоей высшей силой, или же с тем или иным искусством, когда человек посвящает себя, например, музыке, поэзии.\n\nДля бизнесменов Нет совета. Если **Первосвященник**а вытянул бизнесмен, то возникает вопрос: зачем он вообще ввязался в этот бизнес?');
+INSERT INTO meaning_major VALUES (222, 3, 'reverted', 1, 'Хозяйка, она же Венера считается символом любви, искусства и некоторых сумм денег, от маленьких до средних.\n\nОтсюда и значение этой карты: если спрашивают о делах сердечных, то все замечательно или будет замечательно в самом скором времени.\n\nЕсли речь идет об искусстве, талантах, хорошем вкусе, то она также предвещает успех и радость.\n\nИ с деньгами тоже будет все в порядке. Правда, в данный момент этому что-то мешает. Но скоро ситуация изменится к лучшему.');
+INSERT INTO meaning_major VALUES (166, 14, 'straight', 2, 'Значение: пройдя *Смерть*, мы стали воспринимать мир более ясно и хладнокровно, более *умеренно*  Мы готовы отвечать за свои поступки, так что в конечном итоге наша духовная жизнь придет в равновесие с жизнью материальной.');
+INSERT INTO meaning_major VALUES (170, 13, 'reverted', 2, 'Перевернутая карта Смерти означает, что назревшая трансформация, перемена личности задерживается: человек еще не готов.\n\nОднако *желающего судьба ведет, а нежелающего — тащит*, — так говорил Сенека.');
+INSERT INTO meaning_major VALUES (176, 12, 'straight', 2, 'Наши ценности по-прежнему носят скорее духовный, чем материальный или социальный характер — даже если нам кажется, что все обстоит как раз наоборот.\n\nНесмотря на дискомфорт теперешней ситуации, нам все же удается сохранять определенное равновесие.');
+INSERT INTO meaning_major VALUES (180, 11, 'reverted', 2, 'Перевернутая карта Силы означает, что сейчас ваша энергия тратится впустую; правда, этот период продлится недолго.\n\nИ в таком положении предсказания карты благоприятны, хотя *партия* еще только начата и до выигрыша далеко.');
+INSERT INTO meaning_major VALUES (223, 3, 'straight', 1, 'Хозяйка, она же Венера считается символом любви, искусства и некоторых сумм денег, от маленьких до средних.\n\nОтсюда и значение этой карты: если спрашивают о делах сердечных, то все замечательно или будет замечательно в самом скором времени.\n\nЕсли речь идет об искусстве, талантах, хорошем вкусе, то она также предвещает успех и радость.\n\nИ с деньгами тоже будет все в порядке: Венера-Хозяйка не оставляет в беде своих питомцев. Очень хорошая и вполне благоприятная карта, дающая положительный прогноз при ответе на любой вопрос.Еще она может просто указывать на какую-то женщину, играющую важную и, как правило, благоприятную роль в вашей жизни. Для_бизнесменов Прогноз, благоприятный в отношении дел, но требующий задуматься об отношениях с партнером (партнершей).');
+INSERT INTO meaning_major VALUES (228, 2, 'straight', 1, 'Это — высшая ипостась Луны, то есть интуиция, эмпатия, работа с тонкими планами.\n\nВ обыденной жизни — гармония внутреннего и внешнего, духовного и физического, женское начало во всей его полноте — как констатация факта или как совет, к чему следует стремиться.\n\nИногда — указание на возможную беременность и рождение ребенка.\n\nДля_бизнесменов Совет действовать через женщину или прибегнуть к помощи нетрадиционных специалистов (гадалок, астрологов, экстрасенсов).');
+INSERT INTO meaning_major VALUES (232, 1, 'reverted', 1, 'Момент выбора уже упущен, события начали разворачиваться по своим законам, так что теперь вам остается лишь ждать результата.');
+INSERT INTO meaning_major VALUES (155, 16, 'reverted', 2, 'Перевернутая карта показывает, что предупреждение, сделанное картой Дьявола, не было услышано: самолюбие возобладало, и сосуд разбился, остается собирать черепки.');
+INSERT INTO meaning_major VALUES (150, 17, 'reverted', 2, 'Перевернутая, **Звезда** означает замедление бега жизни, наступившее по воле самого индивида, подобно замедлению бега реки, частично скованной льдом: она все равно вовремя достигнет моря.');
+INSERT INTO meaning_major VALUES (233, 1, 'straight', 1, 'Маг всегда означает начало какого-то нового периода жизни, когда еще ничего не решено и не сделано никаких ошибок.\n\nВсе в твоих руках, говорит эта карта, главное — принять правильное решение, а дальше все пойдет само собой.В обыденной жизни это — призыв к активному действию, к перехвату инициативы, в сознании того, что вам пока ничто не грозит.\n\nИногда он может означать и необходимость каких-то магических операций, чтобы обеспечить ровный ход событий.\n\nДля_бизнесменов Хорошая карта, призывающая к активным действиям и твердому отстаиванию своих позиций.');
+INSERT INTO meaning_major VALUES (238, 0, 'straight', 1, 'На все воля Божья, говорит эта карта, то есть: не вмешивайся в ход событий, не старайся *выпрыгнуть* из космического потока, а наоборот, прислушайся, куда он несет тебя, и доверься течению.\n\nБудь прост, не усложняй ситуацию постоянными размышлениями о ней, и все будет хорошо.В более *земном* плане **Шут** — это внутренняя свобода или совет дать себе больше свободы, избавиться от прежних шаблонов поведения и мысли.Иногда это и в самом деле неожиданный поворот событий, сюрприз, как приятный, так и неприятный. …Для бизнесменов **Шут** скорее неблагоприятен, а рекомендация, которую он может дать, проста: уехать, исчезнуть месяца на два — на три.');
+INSERT INTO meaning_major VALUES (135, 20, 'reverted', 2, 'Перевернутая карта означает, что приговор судьбы не может быть отложен или отменен, но у нас будет возможность восстановить ту ситуацию, которая была *до суда*');
+INSERT INTO meaning_major VALUES (136, 20, 'straight', 2, 'Все дела сделаны, все обдумано, итог подведен; теперь пора подумать и о себе.\n\nЭто могут быть проблемы, связанные со здоровьем, с личными и семейными отношениями, с местом работы и т.п.');
+INSERT INTO meaning_major VALUES (140, 19, 'reverted', 2, 'Перевернутая, эта карта означает потребность в любви и тепле, пока не удовлетворенную, но ждать осталось недолго.\n\nДаже перевернутый, этот аркан не доставит вам разочарования.');
+INSERT INTO meaning_major VALUES (161, 15, 'straight', 2, 'Преобладание влечения и агрессивности как в профессиональной, так и в личной жизни.\n\nПотребность самовыражения через чисто физическое действие.\n\nНенасытная жажда власти.');
+INSERT INTO meaning_major VALUES (171, 13, 'straight', 2, 'Смерть — это карта, означающая глубокую внутреннюю трансформацию.\n\nКакая-то часть нашей личности умирает, уступая место другой, новой.\n\nЭто может быть и действительная, физическая смерть, уносящая друга или родственника.');
+INSERT INTO meaning_major VALUES (175, 12, 'reverted', 2, 'Планы слишком иллюзорны, а их реализация слишком далека.\n\nЧтобы снова прочно встать на ноги, понадобятся значительные усилия.');
+INSERT INTO meaning_major VALUES (185, 10, 'reverted', 2, 'Перевернутая, эта карта по-прежнему благоприятна, хотя движение и будет затруднено значительными препятствиями.\n\nОсвобождение, предвещаемое ею, принесет спрашивающему удачу и прибыль, хотя, возможно, и несколько позже, чем он ожидает.');
+INSERT INTO meaning_major VALUES (186, 10, 'straight', 2, '**Колесо Фортуны** — это символ движения.\n\nОно показывает, что пора, наконец, выбрать направление движения и собраться с силами, чтобы придать ему размах.\n\nВсе привлекает нас, мы готовы двинуться в путь.');
+INSERT INTO meaning_major VALUES (190, 9, 'reverted', 2, 'Перевернутый, **Отшельник** означает почти то же самое, только затруднения уже позади, размышления же продолжаются, достигнутая мудрость заставляет еще тщательнее взвешивать каждый шаг.');
+INSERT INTO meaning_major VALUES (227, 2, 'reverted', 1, 'Совет воспользоваться не интуицией, а логикой.\n\nДля женщин — женские болезни или осложнения при родах, для мужчин — проблемы в отношениях с женщинами.');
+INSERT INTO meaning_major VALUES (132, 21, 'reverted', 1, 'Поздравляю, вы вытянули одну из самых лучших карт в колоде.\n\nОна дает благоприятный ответ практически на любой вопрос, будь то отношения между людьми, бизнес или духовный рост.\n\nУ тебя все в порядке, говорит она, ты действуешь правильно, продолжай так и дальше, и результат превзойдет твои самые смелые ожидания.\n\nИ даже в перевернутом виде (круг, он всегда круглый) она означает то же, разве что с некоторой задержкой во времени.');
+INSERT INTO meaning_major VALUES (202, 7, 'reverted', 1, '*Еще не конец*, старый период хотя и заканчивается, но торопить события не надо, лучше подождать.\n\nИли: переезд, путешествие откладываются.\n\nИли: вы все-таки проникли в ненужную вам тайну и теперь будете расплачиваться.');
+INSERT INTO meaning_major VALUES (143, 19, 'straight', 1, 'Поздравляю!\n\nВы вытянули одну из самых лучших карт.\n\nПри ответе практически на любой вопрос она дает благоприятный ответ.\n\nКак правило, она говорит о хорошем здоровье (выздоровлении), благополучии детей, удаче в делах. Иногда подтверждает указание на рождение ребенка.А вот для бизнеса **Солнце**, как ни странно, не означает ничего особенного, разве что бизнесмен вкладывает деньги в фармакологию или в образование, хоть начальное, хоть высшее. Тогда он может рассчитывать на солидную прибыль. В остальных отраслях это просто ровный, спокойный ход дел.');
+INSERT INTO meaning_major VALUES (200, 7, 'reverted', 2, 'Перевернутая, Колесница означает, что спрашивающий потерял веру в успех начатого дела.\n\nНо Колесница на самом деле не застряла, она скоро двинется дальше.');
+INSERT INTO meaning_major VALUES (196, 8, 'straight', 2, 'Это Правосудие сурово.\n\nВы сами решили свершить его над собой, а потому не сможете ему не подчиниться.\n\nВ ближайшее время вам предстоит важный выбор, и ошибка здесь недопустима.');
+INSERT INTO meaning_major VALUES (201, 7, 'straight', 2, 'Карта Колесницы указывает на действие, предпринимаемое спрашивающим в настоящий момент.\n\nКак правило, это действие уже близко к успешному завершению, тем более что путь к успеху был долог и полон препятствий.');
+INSERT INTO meaning_major VALUES (205, 6, 'reverted', 2, 'Означает временное ощущение беспокойства, неуверенности в завтрашнем дне.\n\nВыбор ограничен или уже сделан, так что остается только уповать на судьбу.');
+INSERT INTO meaning_major VALUES (206, 6, 'straight', 2, 'Карта Влюбленных — это, конечно, мир зарождающейся страсти, предстоящих перемен в жизни.\n\nТаким образом, она может представлять собой все крупные перемены, происходящие с индивидом и в обществе.');
+INSERT INTO meaning_major VALUES (210, 5, 'reverted', 2, 'В настоящий момент духовная защищенность отсутствует.\n\nСпрашивающий может надеяться только на собственные силы.\n\nИ если он не будет излишне самоуверен, он с честью выйдет из этого испытания, приобретя многое.');
+INSERT INTO meaning_major VALUES (211, 5, 'straight', 2, 'Карта Папы, естественно, означает все, связанное с религией, монашеством, церковью и т.п.\n\nКроме того, это также религиозные доктрины и догматы.\n\nПапа — символ некоей внешней силы, заставляющей нас задуматься о своей душе.');
+INSERT INTO meaning_major VALUES (215, 4, 'reverted', 2, 'Злоупотребление властью; теперь даже окружение Хозяина сомневается в его праве и способности обладать ею.\n\nВ конечном итоге материальное положение спрашивающего не пострадает и даже упрочнится, но пока придется запастись терпением.');
+INSERT INTO meaning_major VALUES (216, 4, 'straight', 2, 'Хозяин — это босс, крупный руководитель, распоряжающийся огромным потенциалом.\n\nЧеловек, вытянувший эту карту, обладает всеми предпосылками для успеха в материальном мире и пользуется поддержкой сил, за ним стоящих.');
+INSERT INTO meaning_major VALUES (220, 3, 'reverted', 2, 'Означает временную задержку, сон ума; природная живость дремлет, ожидая зова, толчка к пробуждению.\n\nЗато нервозность в это время усиливается, выходя из-под контроля.');
+INSERT INTO meaning_major VALUES (221, 3, 'straight', 2, 'Хозяйка, направляемая Меркурием, символизирует живость ума и склонность к приключениям.\n\nЖивая и несколько нервозная, она все время куда-то ездит, строит планы и делает сразу множество дел.');
+INSERT INTO meaning_major VALUES (225, 2, 'reverted', 2, 'Перевернутая, **Жрица** означает чувство бессилия, ощущения невозможности справится с задачей, которую человек считает самой главной.\n\nНо помощь придет, и он сумеет преодолеть этот временный упадок сил.');
+INSERT INTO meaning_major VALUES (226, 2, 'straight', 2, 'Тот, кому выпала эта карта, полон добрых чувств и благих устремлений; он хочет принести пользу людям, обществу, культуре.\n\nОн чувствует себя способным к этому и жаждет проявить эту свою способность.');
+INSERT INTO meaning_major VALUES (131, 21, 'straight', 2, 'Карта **Мира** означает успех в общественной, семейной и личной жизни, награду, заслуженную достойной жизнью.');
+INSERT INTO meaning_major VALUES (147, 18, 'reverted', 1, 'Ваши опасения обоснованны.\n\nТо, что вам предлагают или что вам предстоит, сопряжено с большими трудностями, а возможно, и с неприятностями.\n\nЕще она может означать, что беременности не будет, что кино-телевидением заниматься не следует — или что *мир иллюзий* приобретает негативный характер, то есть означает зависимость от алкоголя, наркотиков, компьютерных *виртуальных миров* и т.п.');
+INSERT INTO meaning_major VALUES (192, 9, 'reverted', 1, 'Для обычных людей **Отшельник** означает: в одиночестве вы не останетесь, а возможно, и свадьбу сыграете в самом скором времени.\n\nДля эзотериков и вообще людей духовного плана: увы, вершина еще не достигнута — возможно, путь был ошибочен.');
+INSERT INTO meaning_major VALUES (145, 18, 'reverted', 2, 'Перевернутая, **Луна** означает, что человек колеблется, теряя драгоценное время.\n\nЕму трудно сосредоточиться, он плохо и медленно воспринимает действительность.');
+INSERT INTO meaning_major VALUES (144, 18, 'straight', 3, '**Луна в Скорпионе как "темное знание" (бездна души), или Солнце в VIII доме** как символ нисхождения в Подземное царство.\n\n**Мифологический образ**: Лабиринт критского царя Миноса, путешествие в ад Орфея, Одиссея, Геракла, Психеи и Энея.\n\n**Ицзин**: 29 Си-кань. Повторная опасность. \n\nКарта Луны вводит нас в таинственное царство Тьмы и Ночи, в образный мир души, в мир наших снов, мечтаний и откровений. Светлая сторона Луны означает романтические грезы, живое воображение и художественную фантазию. Однако у этой карты есть и темная сторона, открывающая путь в самые бездны души. Это страх, неуверенность, кошмары, мрачные предзнаменования, вообще предубеждение перед всем неведомым, невидимым. Подобный страх мы ощущаем, бредя ночью по темному лесу, который днем казался нам таким безопасным; тьма, застилающая путь, научила нас бояться. Это может быть также страх перед ужасными демонами древности, обретшими в наше время новое имя: бактерии, вирусы, миллиремы, беккерели, загазованность, кислые дожди. Работа Карта Луны означает страх, неуверенность в завтрашнем дне (могут уволить), в собственных знаниях (не пройду аттестацию), компетенции (не примут на желаемую должность) или способностях (выбрал не ту профессию). При этом ее всегда отличает нотка иррациональности: даже сказав себе, что объективных причин для страха нет никаких, мы все равно продолжаем бояться. Неуверенность, мандраж или мрачные предчувствия лишь смягчаются, но не уходят. Это потому, что корни их лежат глубже, чем мы думаем, и тот симптом, который мы пытаемся побороть, есть не причина, а всего лишь повод. Поэтому от них нельзя избавиться, научившись лишь избегать определенных ситуаций. В любой новой ситуации эти наши страхи тотчас найдут себе новую пищу. Лишь подлинный и целенаправленный путь к себе, путь через бездну собственных страхов дает надежду вырвать их из своей души с корнем. Сознание Тут перед нами открывается уникальный шанс, сопровождаемый в то же время огромной опасностью. Нам предстоит путешествие в глубины нашего собственного подсознания, многократно описанное в мифах как *Некия*, то есть нисхождение в ад, в Подземное царство. Поэты и художники нашли выражение для этого ощущения: *Сон разума полон чудовищ*  Встреча с этими чудовищами, битва с ними — самый трудный этап Путешествия героя, самый сложный экзамен в нашей жизни. Опасность, о которой предупреждают мифы, таится в кносском лабиринте, где мы блуждаем, в сумрачном лесу, по которому нас ведут, как кажется, доброжелательно настроенные к нам духи, в забвении нашего собственного имени или в принятии пищи, предлагаемой нам обитателями Подземного царства. Эти мифологические образы проявляются, в частности, в ситуациях, когда нетрадиционные психология и терапия перестают быть средством адаптации к окружающей среде и становятся самоцелью, когда эзотерическая группа заменяет и подменяет собой настоящую жизнь. Еще одна опасность таится в том, что с силами подсознания можно и заиграться, да так, что и у крепчайших *корифеев духа* поедет крыша. Этапы и опасности этого Путешествия подробно описываются в мифодраме *Инанна в аду* . Личные взаимоотношения Романтическая, мечтательная натура, живущая тонкими эмоциями, фантазиями и строящая воздушные замки. При этом подспудно, *заглазно* все время присутствуют ощущения неуверенности и страха. Это может быть союз, разъедаемый ревностью, обидчивостью или жуткой боязнью одиночества одного или обоих партнеров. Однако, если им (или вам) выпала эта карта, это означает, что партнеры могут открыть в себе и проработать эти комплексы, идущие из раннего детства, чтобы избавиться от них раз и навсегда. Или что указываемый ею символ — всего лишь иллюзия, если не сказать кошмар, от которых давно пора избавляться.');
+INSERT INTO meaning_major VALUES (128, 22, 'straight', 1, 'Белая_карта.\n\nПолная неясность, вопрос преждевременен, ответа на него еще нет. Или же тот, о ком вы спрашиваете, не хочет, чтобы другие о нем что-нибудь узнали.\n\nИли вы сами еще не готовы узнать ответ. В любом случае лучше отложить гадание до другого раза — или попробовать другой расклад.');
+INSERT INTO meaning_major VALUES (154, 16, 'straight', 3, '**Уран/Сатурн** как символ лопнувшего нарыва.\n\n**Мифологический образ**: "Мене, текел, фарес" — зловещая надпись на стене пиршественного зала царя Валтасара; оскопление титана Крона его сыном Зевсом; вавилонская башня, иерихонские трубы, гибель Содома и Гоморры.\n\n**Ицзин**: 51 Чжэнь. Молния. \n\n**Башня** показывает, что мы долго считали свое положение стабильным и безопасным, и вдруг все рухнуло. При этом рушатся именно те структуры и условности, которые мы уже превзошли, они стали тесны нам. Речь может идти как о наших убеждениях или жизненных принципах, так и о работе, финансах, дружеских или иных отношениях, представлявшихся нам стабильными и неизменными. В любом случае Башня символизирует некую концепцию, которая долгое время обеспечивала нам уверенность в будущем, а возможно, и чувство защищенности, однако теперь перестала соответствовать нашему возросшему уровню. Как правило, это является для нас сюрпризом: когда старая концепция внезапно рушится, многие воспринимают это как удар молнии. И, поскольку рушатся именно те структуры, которые составляли самую основу нашей уверенности, это часто воспринимется как катастрофа. Лишь когда первый шок проходит, мы с облегчением обнаруживаем, что избавились от давнишнего балласта. Осознание этого приходит само или в виде некоей подсказки извне. Как сказано в книге Ицзин: "*Гроза с громом и молнией разрешает напряженность в Природе*  Работа Башня как бы вырывает нас из ставших слишком тесными рамок, из давно не нужного более сценария *добычи хлеба насущного*, открывая перед нами широкое и свободное поле деятельности. На событийном уровне это часто проявляется в виде увольнения по собственному желанию или по воле работодателя. Эта карта может означать и закрытие фирмы или, в более спокойной ситуации, неожиданный провал планов, казавшихся вполне надежными. Но, каким бы сокрушительным не казался нам этот удар, выбравшись из-под обломков, мы со вздохом облегчения почувствуем, что вышли наконец из тюрьмы, хотя раньше она казалась нам такой уютной. Сознание Здесь эта карта соответствует страшноватому, иногда даже жуткому ощущению краха всех наших прежних представлений, убеждений или целой жизненной философии. При этом часто речь идет о вещах, уже давно дававшим нам знать о себе, только мы не хотели принимать их на веру, потому что они раздражали или огорчали нас как *мрачные* предзнаменования, и мы подавляли их по мере сил. Теперь же, когда они все-таки прорвали линию нашей обороны, мы вдруг убеждаемся, что все наши прежние представления о жизни, о стабильности, о том, что нам надо делать, рухнули и превратились в кучу обломков. Лишь много времени спустя становится ясно, что этот период был для нас периодом важнейших перемен, пересмотра всех наших жизненных концепций, перехода к подлинно самостоятельному и ни от кого не зависимому бытию; это был прорыв к свободе, как о том замечательно пишет Кришнамурти . Кроме того, Башня может означать революционные взгляды в одной или нескольких областях, *эврику* в сфере нашей исследовательской деятельности или внезапное прозрение, как решить давно мучившую нас проблему. Личные взаимоотношения Очень часто, хотя и не всегда, эта карта олицетворяет некое разрушительное начало. Либо это — осуществление назревших перемен, которым мы так долго сопротивлялись, либо освобождение из плена отношений с партнером, ограничивавшим нас в развитии. Впрочем, она может означать также, что мы сами прятались от своих проблем за прочной стеной показного хладнокровия, упрямства и неприступности, и теперь настала пора выйти из этих стен навстречу новому, во всех отношениях плодотворному союзу.');
+INSERT INTO meaning_major VALUES (133, 21, 'straight', 1, 'Поздравляю, вы вытянули одну из самых лучших карт в колоде.\n\nОна дает благоприятный ответ практически на любой вопрос, будь то отношения между людьми, бизнес или духовный рост.\n\nУ тебя все в порядке, говорит она, ты действуешь правильно, продолжай так и дальше, и результат превзойдет твои самые смелые ожидания.');
+INSERT INTO meaning_major VALUES (230, 1, 'reverted', 2, 'Осуществление плана замедлится, хотя и не прекратится.\n\nЕще не все готово, еще есть сомнения, но со временем все осуществится.');
+INSERT INTO meaning_major VALUES (231, 1, 'straight', 2, 'Маг — карта действия, воли, энергии.\n\nЭто выработка планов, начало новых дел, подчинение всего распорядка жизни одной поставленной цели.');
+INSERT INTO meaning_major VALUES (157, 16, 'reverted', 1, 'Вам лучше не торопиться с разрушением старых отношений и связей, лучше решить дело миром, избегая скандалов и конфликтов.');
+INSERT INTO meaning_major VALUES (130, 21, 'reverted', 2, 'Перевернутая карта означает некоторую задержку, но кратковременную, ибо на этой стадии осуществления человеческих планов время уже бессильно.\n\nСчастье и слава уже у порога.');
+INSERT INTO meaning_major VALUES (234, 0, 'straight', 3, 'Уран/Меркурий в смысле открытости характера, любознательности, спонтанности и некоторой сумасшедшинки; во взаимосвязи с Нептуном — ощущение ведомости свыше.\n\n**Мифологический образ**: Парсифаль, отправившийся в путь в наряде шута, и в конце своего пути ставший королем Грааля.\n\n**Ицзин**: 25 У-ван. Беспорочность (Неожиданное). \n\n**Шут** — это наш внутренний ребенок. Он означает спонтанное начало чего-то нового, непредвзятость, открытость. Это — беззаботная игра, самая элементарная радость жизни, период, когда мы с веселым удивлением и без каких бы то ни было конкретных ожиданий вступаем в неизведанное. Впрочем, он может означать и инфантилизм со всеми его атрибутами — легкомыслием, наивностью, азартностью, да и просто глупостью. Или же — мудрую простоту, скромность запросов и смирение, к которым мы приходим обычно лишь в конце долгого и трудного пути. **Шут** может также быть и хитрецом, озорником вроде Тиля Уленшпигеля, и тайным советчиком короля, его вторым *Я*, единственным честным человеком при его дворе. Так или иначе, он живет только настоящим, это человек откровенный, искренний, мобильный. Хорошо это или плохо? О том, что означает эта карта, наше упорное нежелание стать взрослым или, наоборот, приобретенную вместе с долгим опытом простоту, придется судить по всему раскладу в целом. **Шут** всегда предупреждает о чем-то совершенно новом, что готово ворваться в нашу жизнь, отчего в ней возникает хаос, да и мы сами можем получить пару *шишек*, однако опасного в этом на самом деле ничего нет. Работа **Шут** показывает, что нам предстоит вступить в некую новую для нас область, пока еще не знакомую, однако у нас хватит и любознательности, и умения учиться, чтобы справиться с этими новыми задачами. На чисто событийном уровне он может означать и ошибки, какие-то ненужные шаги или безответственность. Однако его глубинное значение — мудрость, приобретаемая из любого, даже самого негативного опыта, осознание ограниченности всех и всяческих правил, которым мы привыкли следовать так долго, и возможность выйти за их пределы, за свои собственные границы, чтобы добиться удовлетворения и успеха. Сознание Здесь **Шут** означает удивление, с которого, по Платону, начинается всякое познание. И тут он опять-таки может символизировать как ребяческое невнимание к важным вещам, так и глубокое осознание того, что ничто в мире не важно настолько, чтобы нужно было делать из этого проблему, то есть подлинный жизненный опыт. И, хотя **Шут** — всегда образ высокого сознания, это не означает, что всякий шут мудрец. Слова Евангелия: *Если не обратитесь и не будете как дети, то не войдете в Царство Небесное* (Мф. 18:3) вовсе не означают, что мы должны возвращаться к своим детским шаблонам поведения всякий раз, когда сталкиваемся с проблемами взрослой жизни; цель процесса развития состоит в том, чтобы обрести такую же ясность понимания мира, какой мы обладали в детстве. Оскар Адлер сравнивает такой процесс развития с течением реки Нигер, одной из самых длинных рек в мире, которая, однако, незадолго перед тем, как впасть в океан, распадается на множество ручейков . Даже когда мы сознаем, что до желанной цели осталось недолго, не стоит искать кривых дорожек, чтобы сократить оставшийся путь. Личные взаимоотношения. Здесь **Шут** — символ радости жизни. Правда, и в этом случае он может означать излишнее легкомыслие и безответственность, которые могут привести к ненадежности, то есть к распаду союза; однако в целом он олицетворяет союз двух веселых, жизнерадостных людей, которым легко друг с другом, которые рады каждый день открывать в партнере что-то новое и любят его за такую неповторимость и многоликость. На событийном уровне эта карта может означать новую любовь или обновление старой, что в последнем случае часто происходит благодаря рождению ребенка.');
+INSERT INTO meaning_major VALUES (229, 1, 'straight', 3, '**Солнце** как символ силы и теплоты, Меркурий как символ гибкости, сообразительности.\n\n**Мифологический образ**: Творец; также Дедал, гениальный изобретатель, зодчий и мастер на все руки у древних греков.\n\n**Ицзин**: 1 Цянь. Творчество. \n\n**Маг** означает ум, сообразительность, активную жизненную позицию. Это — период, когда перед нами встают трудные проблемы, мы должны выдержать некое испытание, однако полная ясность сознания и жизненная сметка помогают нам успешно справиться с ними. Хоть карта и указывает на высокий потенциал сознания, это не означает, что потенциалом интуиции и других подсознательных сил в это время можно пренебречь. Наоборот: наша готовность к действию, к успеху, на которую указывает Маг, основывается именно на гармонии между сознанием и подсознанием. Только благодаря уверенности в себе, возникающей в результате этой гармонии, мы действительно можем сдвинуть с места горы. Работа Маг показывает, что мы осознаем этот свой потенциал и используем его, проявляя инициативу. В зависимости от того, на что направлена эта инициатива, мы можем добиться финансового успеха, продвижения по службе или решить какие-то сложные задачи, например, сдать экзамены или успешно завершить какой-то проект. Если нам приходится выполнять функции начальника, то мы можем многое сделать для улучшения климата в коллективе, дать своим сотрудникам стимул для увеличения эффективности их труда, а тем самым — и успешной деятельности всей фирмы. В переговорах, проектах, при проведении испытаний или выполнении других заданий, которые мы до сих пор откладывали из-за их трудности или проблематичности, мы можем теперь разрубить Гордиев узел (каким образом — покажут карты в позициях от 5 до 7). В этот период мы сумеем справиться с любыми проблемами. Сознание На уровне сознания эта карта свидетельствует, что наш ум ясен и точен, что у нас хватит сообразительности и дара убеждения, чтобы добиться успеха даже в том, за что мы прежде не решались взяться. Кроме того, Маг показывает, что у нас появилась *высота обзора*, позволяющая охватывать всю перспективу в целом, так что мы больше не увязаем в деталях. На более глубоких уровнях сознания Маг олицетворяет умение отвечать за свои шаги, решительность и осознанное управление собственной судьбой, осознание своей жизненной задачи. Личные взаимоотношения Период вдохновения, отваги, усиления *магнетизма* (привлекательностьи). Будучи использованы с толком, эти олицетворяемые Магом силы могут помочь вам преодолеть скованность, зажатость, вообще любые имеющиеся проблемы. *Использовать с толком* — значит ради блага всех, а не только ради собственной выгоды. Маг символизирует знание, в данном случае — осознание важности единства и гармонии во взаимоотношениях.');
+INSERT INTO meaning_major VALUES (235, 0, 'reverted', 2, 'Перевернутый, аркан **Шута** напоминает нам об оной, иррациональной стороне вещей и людей, обращающихся к Таро с вопросом.');
+INSERT INTO meaning_major VALUES (236, 0, 'straight', 2, 'Значение: карта **Шута** не имеет номера и потому кажется несколько странной.\n\nПомещенная в конце колоды, она как бы находится *вне игры*  Сыграв свою игру, человек подводит итог и убеждается, что он во всем равен другим.');
+INSERT INTO meaning_major VALUES (129, 21, 'straight', 3, '**Юпитер в Рыбах** как символ освобождения, или Юпитер в гармоничном аспекте с Сатурном как образ счастливого конца.\n\n**Мифологический образ**: Вновь обретенный рай, Остров Блаженных, Елисейские поля.\n\n**Ицзин**: 55 Фын. Изобилие. \n\n**Мир** указывает на вновь обретенное единство, гармонию и счастливое завершение некоего хода событий. Красоту этой карты трудно передать словами, не скатываясь в слащавое описание очередного хэппи-энда. В Путешествии Героя это счастливый конец, вновь обретенный рай, что в обыденной жизни означает достижение цели. Иногда, в редких случаях, это может быть цель всей жизни, однако чаще всего имеется в виду очередной ее этап. В области внешней жизни это означает, что мы нашли в ней наконец свое место, то самое, которое предназначено нам и только нам. Во внутренней жизни это завершение важнейшего этапа развития, становления нашей личности, целостности нашего самосознания. На событийном уровне **Мир** олицетворяет счастливый период, когда мы от души наслаждаемся жизнью. Еще эта карта может означать международные связи или путешествия. Работа **Мир** указывает, что мы правильно выбрали род занятий и работаем именно там, где и должны были — или, по крайней мере, находимся на пути к этому. Это, конечно, не значит, что дальше нам уже некуда развиваться. Это значит лишь, что все решения, которые мы принимали до сих пор, были правильны, и что мы нашли свое истинное призвание — по крайней мере, на данном этапе жизни. На повседневном уровне эта карта означает радость от блестяще выполненной работы, искренность и гармонию в отношениях с коллегами, а иногда и контакты с зарубежными коллегами или поездки за границу. Сознание Счастливое сознание того, что мы сделали пусть небольшой, но важный шаг на пути становления личности, осознали свое призвание и предназначение. Перестав воспринимать свой гороскоп, точнее, его *проблемные* элементы как подлые происки злодейки-судьбы и *вспомнив*, что наша жизненная задача в том и состоит, чтобы примирить, гармонизировать эти напряженные аспекты в нашем внутреннем и окружающем мире, мы поймем истинную красоту карты **Мир**а. Она показывает, как из первоначального Хаоса противоположностей и противоречий постепенно, шаг за шагом сложился упорядоченный, очищенный от всякой скверны Космос. Личные взаимоотношения В личном плане карта **Мир**а указывает, что наш союз с партнером — подлинный дом родной для нас обоих, или, если мы пока одиноки, что очень скоро нам предстоит встретить человека, который станет для нас спутником жизни. Союз, олицетворяемый этой картой, никогда не бывает *курортным романом* — это всегда нечто длительное, прочное, сердечный союз или брак, играющий важнейшую роль в нашей жизни.');
+INSERT INTO meaning_major VALUES (134, 20, 'straight', 3, '**Юпитер/Уран в гармоничном аспекте с Солнцем, или же знак Водолея** как символ освобождения.\n\n**Мифологический образ**: Открытие сокровища; освобождение прекрасной пленницы; обретение живой воды; божества и герои, воскресшие или спасшиеся из ада — Инанна, Озирис, Орфей, Одиссей, Геракл, Психея, Эней.\n\n**Ицзин**: 40 Цзе. Разрешение. \n\nВ толковании карты **Суда** легко ошибиться, если исходить только из ее названия. **Суд** вообще, а тот *суд*, который здесь имеется в виду, то есть Страшный **Суд** в особенности, обычно связывают с наказанием, карой, а тем самым — с безысходностью и страхом. Между тем значение карты основывается прежде всего на рисунке, включающем совершенно определенные культурологические и мифологические образы. На карте **Суд**а изображается воскресение, то есть момент возрождения и освобождения чего-то, долго бывшего подавленным или скрытым; это выход истинного, Божественного начала из темницы к свету. Таким образом, значение у этой карты как раз очень благоприятное. Она олицетворяет важный, решительный шаг на пути становления личности, удачное завершение очередного этапа ее алхимической трансформации, то есть превращения низшей материи в высшую. На бытовом уровне эта карта также означает освобождение — от каких-то забот или опасений, освобождение из затруднительной ситуации или от вредного человека, от *лишних* качеств вроде застенчивости, боязливости и т.п. А еще она может указывать, что в том деле, о котором мы спрашиваем, как раз и таится *сокровище*, разыскиваемое нами. Работа **Суд** означает большие перемены в работе. Наступает некая решающая фаза, на практике часто связанная с уходом или переходом на другую работу. В любом случае мы воспринимаем эти перемены как освобождение — и как возможность заглянуть вглубь себя, понять, в чем заключается наше истинное призвание. Но даже если никаких формальных перемен не предвидится, мы освобождаемся от каких-то старых проблем, надоевших обязанностей и прочих *хвостов*  Тогда карта показывает, что под внешне спокойной поверхностью нашей повседневной жизни открывается источник творческой самореализации. На событийном уровне это может означать успешную сдачу экзаменов, завершение какого-либо проекта или задания, окончание учебы или курсов повышения квалификации. Сознание Здесь перед нами открываются теневые стороны нашей личности, о которых мы до сих пор совершенно не думали или забывали. Теперь мы можем освободиться от них, открыть для себя их истинную, светлую природу. Далее карта **Суда** может указывать на события, благодаря которым мы освобождаемся от прежних связей, привычек или идей-фикс; часто это пробуждение сознания, освобождение от наркотической зависимости или иных иллюзий, возвращение к свободе, к непредвзятому восприятию красоты Творения. Личные взаимоотношения Мы находимся на пороге открытия *сокровища* в нашем теперешнем союзе или, если мы в данное время живем одни, то в предстоящем союзе с другим человеком. Это не обязательно должен быть сказочный принц или *женщина нашей мечты*, а просто глубокие внутренние изменения, благодаря которым нам станет ясно, что именно до сих пор мешало нам обрести это сокровище. Таков сюжет многих мифов и сказок: в конце долгого пути герой преодолевает последние барьеры (в том числе собственные страх или отвращение) — и происходит чудо, ужасное чудовище превращается в светлого принца или принцессу. Подобную историю очень занимательно и с большим юмором рассказывает Генрих Циммер о рыцаре Гавэйне и даме Рагнелле, которая сначала была ведьмой, но, когда рыцарь ясно и бесповоротно произнес свое *Да, я люблю тебя*, стала самой красивой женщиной на свете .');
+INSERT INTO meaning_major VALUES (139, 19, 'straight', 3, '**Солнце в V доме** как символ радости жизни, творческого потенциала и наслаждения игрой.\n\n**Мифологический образ**: Великие солнечные божества Ра, Гелиос, Соль, а также духи света; встреча и примирение двух неравных братьев — Гильгамеша и Энкиду или Парсифаля и Фейрефиса.\n\n**Ицзин**: 24 Фу. Возврат. \n\nКарта **Солнца** выражает жизнелюбие, радость жизни, теплоту и уверенность в будущем. В нашем сознании она соответствует силам, помогающим обрести ясность, победить опасения и глубокие страхи, преодолеть заблуждения. Кроме того, **Солнце** олицетворяет юность и такую свежесть чувств, как будто человек заново родился. Это — светлая, солнечная сторона жизни. На более глубоком уровне — совет преодолеть свою темную сторону, дать раскрыться нашей солнечной натуре, распознать в себе свое *теневое Я* и вывести его на свет Божий. Работа **Солнце** означает, что работа доставляет нам радость, и мы выполняем ее с энтузиазмом и успехом. Кроме того, оно символизирует теплую, дружескую атмосферу в коллективе, хорошие отношения с начальством, коллегами, деловыми партнерами, хорошая *аура* и умение ясно и доходчиво излагать свои идеи и соображения. Сознание В этом, пожалуй, сосредоточено главное значение карты Солнца. Это — пробуждение, расцвет и созревание нашей солнечной натуры, отождествляемой с нашим истинным *Я*  К солнечной натуре относятся, во-первых, такие качества как доверие к самому себе, уверенность в собственных силах, самопознание и самосознание, самостоятельность; а во-вторых — их диалектическая противоположность, то есть снятие их через отказ от самого себя, от себялюбия и эгоизма на низшем уровне и переход к осознанию своего *Я* на высшем. Этот процесс не имеет ничего общего с тем бегством от самого себя, которое лежит в основе самопожертвенных порывов любителей *всем помогать*  Подлинный путь к самому себе начинается с парадоксального вопроса о Йозефе Мюллере, сформулированного Диркгеймом: *Как Йозефу прорваться сквозь Мюллера?* Здесь фамилия Мюллер, как одна из наиболее распространенных, символизирует безличное внешнее *Я*, а имя Йозеф — индивидуальную сущность, внутреннее *Я* человека. Попробуйте задать такой же вопрос себе и вы поймете, о чем речь . Личные взаимоотношения Здесь **Солнце** олицетворяет самые безоблачные, теплые отношения, минимум проблем, предприимчивость и всяческие радости жизни. На событийном уровне может означать отпуск. На глубинном уровне — щедрость, великодушие, умение дарить себя, нисколько себя при этом не теряя. Это — символ сердечности, доброты, света, разгоняющего тучи. Партнеры заботятся друг о друге, даже балуют один другого, между ними царят взаимопонимание и любовь.');
+INSERT INTO meaning_major VALUES (149, 17, 'straight', 3, 'Юпитер в XI доме как покровитель и открыватель новых горизонтов.\n\n**Мифологический образ**: Бина или София как принцип Высшего разума, Семь столпов мудрости — жрицы храма Изиды.\n\n**Ицзин**: 61 Чжун-фу. Внутренняя правда. \n\n**Звезда** — это карта надежды, мудрости и понимания высших взаимосвязей всего. Она указывает, что мы в данный момент делаем или планируем вещи, результаты которых проявятся лишь в отдаленном будущем, однако именно поэтому мы можем и должны возлагать на них свои главные надежды. При этом сейчас, в момент самого действия, мы можем и не сознавать, что делаем. Лишь задним числом нам станет ясно, какие знаменательные решения принимались тогда (сегодня), под знаком Аркана Звезды. И, как семени нужно время, чтобы прорасти и стать растением, так и **Звезда** проявляет свое плодотворное действие далеко не сразу. В традиционном Таро Звезду считали одним из трех ангелов-хранителей , обещавших благоприятный исход любому задуманному делу. Работа Здесь она показывает, что мы стоим на пороге абсолютно новой карьеры. Это может быть как закономерное продолжение нашей предшествовавшей работы, так и переход к чему-то совершенно новому. Однако любые переговоры, проекты, договора и союзы, складывающиеся в этот период, обещают нам удачу на долгое время вперед. Сознание **Звезда** показывает, что будущее в наших руках. При этом нам открывается общая перспектива на далекое будущее, значительно превосходящая рамки конкретных договоренностей и планов. Как будто мы вознеслись на высоту полета орла, с которой нам видны все препятствия, могущие возникнуть у нас на пути, и средства, с помощью которых мы можем преодолеть их, и конечная цель, необходимая и достаточная, чтобы мы могли признаться себе в успехе. Личные взаимоотношения Происходят встречи, случайные, но чрезвычайно важные, завязываются контакты, которые будут иметь последствия в течение многих лет. Союзы, заключенные под знаком этой карты, имеют долгое будущее.');
+INSERT INTO meaning_major VALUES (159, 15, 'straight', 3, 'Плутон как выражение темной силы.\n\n**Мифологический образ**: Искуситель, Князь Мира, падший ангел Шем-Хасай, Азазел, Гелил, Самаил, а также упавшая утренняя звезда Люцифер-Денница, отождествляемый с Сатаной; Иуда-предатель.\n\n**Ицзин**: 36 Мин-и. Поражение света. \n\nИз всех Арканов Таро **Диавол** представляется самым трудным для понимания, потому что у каждого он свой. Общими для всех можно, пожалуй, считать лишь ситуацию зависимости от кого-то или чего-то, слабую волю, постыдный провал благих намерений, а также действия, совершаемые вопреки собственным убеждениям. Диавол как бы олицетворяет темную сторону нескольких карт: для Мага (I) это черная магия, для Жрицы (II) — часть ее негативного архетипа, для Жреца (V) — ханжество, стяжательство под личиной святоши, для Влюбленных (VI) — борьба за власть или вырождение партнерских отношений в чистый секс, похоть без малейшего признака любви, для Справедливости (XI) — коррупция или неумение признать собственную неправоту. Он олицетворяет также непомерную жадность как обратную сторону Силы (VIII), неумеренность в противоположность Умеренности (XIV), и правит многими областями Неведомого края карты Луны (XVIII). Как искуситель, он чаще всего предстает перед нами под той или иной привлекательной личиной. Так или иначе, эта карта показывает, что мы играем с огнем и должны быть чертовски (!) осмотрительны, чтобы не обжечься. На глубинном уровне он означает, что наш вопрос затрагивает прежде всего теневые стороны личности. Работа Тут Диавол показывает, что наша моральная крепость, наши убеждения и добрые намерения подвергаются серьезному испытанию, если не сказать искушению. При этом речь может идти даже о делах для нас выгодных, но только за счет неопытности или легковерия делового партнера. Может быть и так, что нам предлагают заняться чем-то, противоречащим нашим убеждениям (оружие, наркотики, загрязнение окружающей среды и т.п.), причем в случае отказа мы можем *влететь* на большую сумму или *вылететь* с работы. Диавол, конечно, достаточно хитер, чтобы и тут подкинуть нам то или иное оправдание, чтобы облечь черные дела в белые одежды, придать им благородную окраску, и снимая последние наши сомнения известным лозунгом палача: *Если этого не сделаю я, найдется другой*  Сознание Диавол показывает, что мы наконец познакомились с собственной теневой стороной достаточно близко. Произошли какие-то события, в результате которых мы осознали свою зависимость и несвободу. В редких случаях это бывает фанатизм, жажда власти, непреодолимое желание убить кого-нибудь или настроения вроде садизма или мазохизма. Однако чаще всего речь идет о вещах совершенно обыденных, которых мы потому и стыдимся, что они слишком примитивны, и тем не менее никак не можем от них избавиться: хроническая ненадежность, ничем не оправданная ложь, пьянство, клептомания, да что угодно. Или на ментальном уровне — в форме какой-нибудь идеи фикс, с которой мы уже всем надоели, или даже целой философии, основу которой составляет поиск *зла* и его причин исключительно в других людях, в жизненных обстоятельствах, в характере эпохи — где угодно, только не в себе самом. Хотя, как известно, все, что мы находим в людях, обстоятельствах и эпохе, есть лишь отражение нас самих. Личные взаимоотношения Тут Диавол оборачивается своей самой привлекательной стороной. Многозначительные обещания, дразнящий флирт, чувственная страсть — вот его приманки. Однако и здесь эта карта служит прежде всего предостережением: вы играете с огнем! и вам раньше, чем вы думаете, придется вспомнить о словах Шиллера: *Грешим мы миг, а каемся всю жизнь*  Кроме того, она, конечно, указывает и на всякие другие отклонения от истинного, сердечного партнерства, будь то странные выходки одного или обоих партнеров, молчаливая покорность или, наоборот, крикливый деспотизм, жестокая похоть или тонкое издевательство.');
+INSERT INTO meaning_major VALUES (164, 14, 'straight', 3, '**Венера** как символ гармонии, сбалансированности.\n\n**Мифологический образ**: Немезида, греческая богиня верной меры, хранительница божественного порядка.\n\n**Ицзин**: 15 Цянь. Смирение. \n\nЗначение карты **Умеренности** станет понятнее, если мы переведем его как *Верная мера*  Она составляет позитивную противоположность следующему за ней Аркану Диавола (XV), символизирующего неумеренность. ***Умеренность*** же характеризуется такими качествами как гармония, сбалансированность, беззлобность и душевный покой. Эта карта соответствует тому счастливому состоянию, когда человек чувствует себя здоровым и внутренне уравновешенным, когда он уважает себя, любит себя и находится поэтому в гармоничном равновесии с окружающим миром. Если вопрос касается здоровья, психического или физического, то **Умеренность** означыает хорошее самочувствие или выздоровление. Работа Здесь она означает, что к работе нужно прежде всего подходить сбалансированно и беззлобно. Требуется как дружеская, гармоничная атмосфера в группе, так и нормальный, никем и никуда не подгоняемый рабочий ритм, позволяющие действовать *с чувством, с толком, с расстановкой*  Тогда все задачи, раньше разрешавшиеся только в порядке аврала и жуткого стресса, можно будет выполнить спокойно и без напряжения. Причем это отнюдь не означает лености, слабости, пофигизма, а тем более халтуры. Наоборот: это и есть то самое душевное спокойствие, которое позволяет действовать быстро, эффективно и целенаправленно (ср. наставления Дзен в искусстве стрельбы из лука). Сознание На уровне сознания *Верная мера* означает гармоничное единение тела, души и духа. То есть нам нужно прежде всего договориться с самими собой, войти в резонанс с Космосом, обрести мир в душе, полюбить себя — и забыть о карьеризме-соперничестве, о самокопании и самоедстве из-за пустяков. Осознав, ощутив это, мы приобретем способность совершенно естественно, безо всяких амбиций, восстанавливать вокруг себя мир и покой, помогать другим найти свое счастье и служить им достойным образцом. Личные взаимоотношения Период мирного, счастливого единения душ, дружбы, доверия и взаимности. Это возможно лишь при полном душевном покое. А его, в свою очередь, можно достичь лишь тогда, когда главным для нас станет не успех во внешнем мире (на работе, в политике и т.д.), а тонкость и теплота отношений друг с другом, не показное благополучие семьи, а скромность и простота внутри нее. Еще эта карта может указывать на скорое заключение нового знакомства, новой связи.');
+INSERT INTO meaning_major VALUES (179, 11, 'straight', 3, 'Юпитер/Марс как способность суждения и Венера в Весах, символизирующая честность, справедливость и уравновешенность.\n\n**Мифологический образ**: Греческие божества-хоры: Дике (справедливость) и Эвномия (законность).\n\n**Ицзин**: 21 Ши-хо. Стиснутые зубы, реформа. \n\nКарта **Справедливости** включает в себя несколько сюжетов. Это и ясность, объективность познания, и сознательное, вполне определенное суждение, и неподкупность, уравновешенность и честность. Она говорит, что справедливость будет восстановлена. На обыденном уровне это означает, что все, что мы делаем, эхом отражается в окружающем мире, отвечающем нам добром на добро, злом на зло. Если мы поступаем честно и порядочно, то нас вознаграждают, если же пользуемся сомнительными средствами, то рано или поздно получаем по заслугам. Таким образом, эта карта предполагает высокую меро ответственности человека за свои поступки. Она показывает, что ничто не достается даром, но ни в чем и не будет препятствий, если мы готовы отвечать за все, что получаем. Работа В этой сфере ***Справедливость*** означает, что мы прежде всего должны очень четко представлять себе, чем и зачем мы занимаемся, чтобы ясно (а, возможно, и самокритично) решить, как вести себя дальше. Далее она говорит, что мы можем рассчитывать на справедливое и доброжелательное отношение к себе, однако если мы попытаемся добиться своего обманом или иным нечестным путем, то провал обеспечен. Поэтому и в бизнесе она предупреждает о необходимости соблюдать правила игры и платить по счетам. Сознание Карта показывает, что для нас настал период *отрезвления*, возвращения на чисто деловой уровень. В такое время мы пытаемся наконец разобраться в накопившихся проблемах, составить себе о них ясное и непредвзятое мнение. Тут решают уже не эмоции и чувства, а наш логически мыслящий рассудок. Личные отношения: Здесь также важнейшую роль играет принцип честности и уравновешенности. Однако это, увы, не всегда гармония и отдых — равновесие сил может быть и у воюющих сторон (равновесие страха). Во всяком случае, принцип тут один: *как аукнется, так и откликнется*');
+INSERT INTO meaning_major VALUES (189, 9, 'straight', 3, 'Сатурн в Водолее как стремление к мудрости и независимости.\n\n**Мифологический образ**: Нестор, мудрый советчик Агамемнона и других героев Троянской войны.\n\n**Ицзин**: 52 Гэнь. Сосредоточенность. \n\n**Отшельник** — это карта отрешенности от мира, путь к себе. Это — период интроверсии, когда мы *закрываемся* от внешних влияний, чтобы вдали от суеты и людей обрести покой и, главное, найти самого себя. Тем самым **Отшельник** символизирует важные события, показывающие нам, кто мы есть, к чему стремимся и как этого достичь. Он объединяет в себе два полюса: глубочайшие переживания и высочайшее познание. Многие побаиваются этой карты, однако совершенно напрасно. Лишь там, где эти качества **Отшельника** бывают поняты неправильно, возникает страх одиночества и покинутости. Однако тот, кто готов следовать зову **Отшельника**, не теряет себя, а находит, обретая ясность, силу и умение ладить с самим собой. Работа Время размышлений, когда мы должны наконец выяснить, чего же мы хотим в плане работы. Эти размышления могут привести к пересмотру всех прежних представлений об успехе, признании, престиже, востребованности, о деньгах и о сфере интересов. И в любом случае они приводят нас к лучшему пониманию наших истинных потребностей и нашего истинного призвания. Поняв же это, мы не только укрепляемся духом, но и приобретаем глубокую внутреннюю уверенность, благодаря которой можем теперь медленно, но верно приближаться к осуществлению своей новой цели. Бывает, что **Отшельник** олицетворяет саму работу; тогда он напоминает нам, что серьезность, спокойствие, взвешенность действий и опора на собственные силы в пределах ограниченных финансов дают большее удовлетворение, чем участие в групповых мероприятиях пресловутого общества потребления. Сознание Эта карта показывает, что мы отрешаемся от мира, чтобы освободиться от чужих мнений и вновь вернуться к своему изначальному *Я*  Поэтому **Отшельник** часто означает некоторый период, от нескольких дней до целого отпуска, когда мы бежим прочь от других, чтобы сосредоточиться на какой-то проблеме, плодотворно поработать или просто дать себе передышку и подумать, чтобы понимание пришло само собой. Часто в такие периоды покоя рекомендуется поститься, воздерживаться от всего, соблюдать обет молчания, заниматься медитациями или иными духовными практиками. В результате такой работы человек становится мудрее, у него прибавляется сил, мужества и решительности. Личные взаимоотношения Тут карта имеет двоякое значение, так как может указывать на одиночество, в том числе *одиночество вдвоем*  Однако ее основной смысл и здесь заключается в глубоком осознании, понимании наших истинных потребностей и нашего истинного призвания в партнерских отношениях. Часто она знаменует собой переход к более зрелым формам любви, к добровольному отказу от тех или иных чисто эгоистических требований ради сохранения и укрепления парности, союза, брака. Или же — понимание того, что умение обходиться друг без друга — одно из важнейших условий прочности и долговечности союза.');
+INSERT INTO meaning_major VALUES (194, 8, 'straight', 3, 'Лев как жизнеутверждающее начало, жизненная сила, гордость, горячее желание и страсть.\n\n**Мифологический образ**: Богини, изображаемые нагими верхом на льве: Геба, супруга хеттского бога бури; Геба, супруга Геракла. Или великая вавилонская богиня Иштар, сажающая небесное чудовище на поводок, чтобы выпустить его против Урдука, города Гильгамеша; греческая нимфа Кирена, укрощающая львов без оружия.\n\n**Ицзин**: 26 Да-чу. Воспитание великим. \n\nКарта **Силы** в определенном смысле сродни карте Мага (I). Так же, как и в ней, необычайная сила этого Аркана основана на глубокой внутренней гармонии. Если могущество Мага — результат гармоничного взаимодействия сознания и подсознания, то жизненная сила, смелость и страстность этой карты есть выражение того равновесия, которое цивилизованный человек находит со своей животной натурой. Это отражается и в мифах, например, о дружбе между Гильгамешем и Энкиду, бывшим сначала его непримиримым противником. Эта карта наглядно показывает, что наша цель — не в том, чтобы научиться скрывать свои инстинкты под покровом бледной добродетели, живущие в нас подчас страшноватые архаичные силы, а в том, чтобы научиться укрощать их любовью, мягкостью и настойчивостью. Таким образом мы приобретем контроль не только над этими дикими природными силами, но и над теми запасами энергии, которые нам до сих пор приходилось тратить на подавление этих сил. Работа Эта карта означает, что мы отдаем делу все силы и работаем *не за страх, а за совесть*  Когда мы находимся в хорошей физической форме, то беремся за дело с отвагой и энтузиазмом, а достигнув успеха, с радостью обнаруживаем, что сил у нас только прибавилось. Таким образом, карта Силы соответствует периоду высочайшего творческого подъема, горячего желания работать, а тем самым и радости, и успеха. Сознание На этом уровне карта свидетельствует о наступлении значительных изменений, понять которые помогает изображенная на ней алхимическая символика. Красный лев в алхимии означает средство, при помощи которого различные низшие вещества превращают в золото. В приложении к человеку это означает стоящую перед нами задачу: не отвергать и не подавлять в себе силы, так часто именуемые *низшими инстинктами*, а *снять* вражду между нашим цивилизованным сознанием и животной натурой. Причем *снять* в трояком смысле:1) снять эту вражду как проблему с повестки дня;2) *снять* эти силы в смысле *нанять* их, сделать своими работниками;3) и, как учит диалектика, *снять* их как противоположности на этом уровне, чтобы перенести на иной, более высокий, где они перестанут бороться друг с другом, а соединятся в новую, неодолимую силу. Личные взаимоотношения Это прежде всего страсть. Она проявляется в отношениях, характеризуемых живостью, горячим темпераментом, а иногда и драматическими стычками. Подобные союзы — неиссякаемый источник энергии и силы, необходимых нам в повседневной жизни, хотя бывают случаи, когда эти стычки имеют трагические последствия — возьмите Кармен и дона Хозе из известной оперы Ж. Бизе.');
+INSERT INTO meaning_major VALUES (209, 5, 'straight', 3, 'Солнце в Стрельце: проповедник, учитель, религиозный деятель.\n\n**Мифологический образ**: Хирон, мудрый кентавр и друг рода человеческого, учитель и воспитатель многих героев.\n\n**Ицзин**: 45 Цуй. Воссоединение. \n\n**Жрец** олицетворяет мир веры и глубочайшего доверия, основывающегося на уверенности в избранных ценностях. В древние времена он считался одним из трех ангелов-хранителей в Таро , обеспечивавшим благоприятный исход любому делу. В этом есть глубокий смысл, потому что доверие вообще и наше доверие к самим себе в особенности служат основой веры в жизнь, в будущее. Кроме того, эта карта символизирует путь этики и добродетели, то есть те моральные принципы, которыми определяются наши жизненные планы. Работа Эта карта ознаает, что нас волнует вопрос смысла всей нашей деятельности в целом, начиная от самого предмета наших занятий и кончая вопросами жизнеобеспечения, успеха, вознаграждения и признания. Жрец — это поиск глубинного смысла, *сверхзадачи*, истинного призвания. Наряду с этим карта может обозначать и конкретные ситуации, в которых испытанию подвергается наш моральный облик, наша нравственность, до сих пор не позволявшие нам участвовать ни в каких темных махинациях. Сознание Поиски смысла жизни, *проверка на прочность* наших духовных принципов и нашей системы ценностей. При этом объективная сторона жизни нас в данном случае почти не интересует. Наоборот, Жрец олицетворяет наш чисто субъективный опыт, наш собственный символ веры, который никак *объективно* не проверишь, а он между тем нисколько не теряет для нас своей ценности. Кроме того, эта карта символизирует значительное повышение нашей способности морального суждения, той самой, которая позволяет нам отличать добро от зла, а также какие-либо глубокие переживания, в том числе религиозные, оказывающие большое влияние на всю последующую жизнь. Личные взаимоотношения Период все возрастающего роста доверия и влечения партнеров друг к другу, когда идеал партнера становится все более возвышенным, а сами партнерские отношения изменяются к лучшему под влиянием системы моральных ценностей и личных добродетелей каждого. На событийном уровне эта карта может означать намерение или желание выйти замуж (жениться).');
+
+INSERT INTO meaning_minor VALUES (261, 1, 2, 'straight', 3, '**Марс в Весах** как символ вынужденного согласия без внутренней убежденности — или без практических последствий.\n\n**Мифологический образ**: Люди нерешительные, которых не пускают дальше прихожей Дантова ада.\n\n**Ицзин**: 43 Гуай. Выход.\n\nЭта карта соединяет в себе констатацию факта и в то же время вызов: она показывает, что мы хотим сохранить свою нейтральную, индифферентную позицию, однако ситуация вынуждает нас высказаться прямо и недвусмысленно. Ситуации, которым соответствует эта карта, часто напоминают тупики. На объективном плане нет ничего, что мы могли бы считать причиной нашего проигрыша, поражения или дурного настроения, однако вот они налицо, и мы не знаем, что же именно выбило нас из колеи. Тут дело в том, что нам надоело участвовать во внешней жизни, и мы заперлись от нее в очередной башне из слоновой кости. Во всяком случае, мы добились того, что нашу душу ничто больше не тревожит, и нам уже почти все равно, что происходит с нашими близкими, не говоря уже о дальних. Выбраться из этого состояния можно, лишь дав своим представлениям стать убеждениями, а своим намерениям — делами, причем по-честному. Работа Тут эта карта означает, что нам в действительности дела нет, чем мы занимаемся, и выполняем мы свою работу, лишь бы сбыть ее с рук. Она может означать также, что в очередном конфликте мы демонстративно занимаем абсолютно нейтральную позицию или становимся на сторону большинства, потому что нам на самом деле все равно, кто там прав, а кто виноват. Расплачиваемся же мы за это внутренней пустотой, которая со временем может привести к депрессии. Эта карта рекомендует нам проверить, верны ли еще наши внутренние установки, чтобы мы могли обеспечить себе в своих делах полную ясность. Сознание Здесь **Двойка Жезлов** показывает, что мы находимся как бы в состоянии летаргии, освободиться от которой мы можем, лишь претворив свои слова в дело. Если уж мы заявляем что-то, то должны и действовать соответственно, чтобы наши слова не оставались пустым звуком. Личные взаимоотношения Отступление на *заранее подготовленные позиции* человека, которому ничего не надо, который не испытывает никаких чувств. Это опасно. При этом наши слова могут быть полны страсти, однако за ними ничего не стоит. Опасность же состоит в том, что такой союз, лишенный внутреннего тепла, начинает отмирать. И тут **Двойка Жезлов** рекомендует нам наконец сделать выбор: либо мы делаем все ради сохранения союза, либо уж тогда сразу разрываем его, отпуская своего партнера.');
+INSERT INTO meaning_minor VALUES (285, 1, 10, 'straight', 3, '**Сатурн/Солнце** как символ тяжести на душе и мрачного настроения, или Сатурн в XI доме как образ бесперспективности.\n\n**Мифологический образ**: Атлант, держащий на своих плечах свод небесный — и с радостью готовый уступить его Гераклу.\n\n**Ицзин**: 28 Да-го. Переразвитие великого. \n\n**Десятка Жезлов** — это карта мрачного настроения, показывающая, что мы ожидали слишком многого, а теперь все наши радужные перспективы развеялись, как дым. Может быть, мы сами где-то плохо сработали и теперь расхлебываем. Иногда эта карта означает также, что на пути своего развития мы перескочили через несколько ступеней и теперь чувствуем себя первоклассниками, попавшими сразу в пятый класс; новые, непонятные задачи висят тяжелым жерновом на шее. Так что вполне возможно, что сейчас мы с ними опозоримся. Тут нужно время, чтобы привыкнуть, время и терпение, и тогда и задачи перестанут казаться непонятными, и мрачное настроение пройдет, и мы займем наконец подобающее нам место. Работа Стресс, перегрузки или слишком большая ответственность, доставшиеся нам на работе. Часто это результат чересчур быстрого возвышения, заставившего нас принять на себя обязанности, о которых мы до сих пор и понятия не имели. Тут важно сосредоточиться (может быть, и при помощи медитации), собраться с силами, чтобы прошло необходимое время, и мы вошли в нужную колею без особых потерь. Эта карта может также означать *барщину*, то есть нелюбимую работу, которую нам придется выполнять какое-то время, или долгие поиски работы через бюро трудоустройства, или трудные испытания, вызывающие у нас ощущение ужаса. Тут важно вовремя избавиться от того ненужного груза, который был накоплен за предыдущие годы. Сознание **Десятка Жезлов** показывает, что нас мучают сомнения и мысли о тяжелых проблемах, и выхода из них мы не видим. Нами правят усталость, переутомление, мрачное настроение. Лучший способ преодолеть эту черную полосу — абстрагироваться от нее, сделать перерыв, уйти в давно желаемый отпуск, будь то уединение на даче, дорогостоящая поездка к морю или паломничество в какой-нибудь знаменитый монастырь. Личные взаимоотношения Мрак, мрак. Скорее всего эта карта показывает, что вы давно страдаете от одиночества и не видите выхода из этой ситуации. Или что партнер-то у вас есть, однако с ним такие проблемы, что вы опять-таки не видите выхода. В такой период лучше всего дать себе передышку, взять отпуск, уехать, — или, если это невозможно, внимательно следить за собой, чтобы не дать себе сорваться и разрушить то, что еще осталось хорошего в ваших отношениях.');
+INSERT INTO meaning_minor VALUES (291, 1, 12, 'straight', 3, '**Марс в Овне** как символ жажды жизни, предпринимательской жилки, энергичности, нетерпения и поиска приключений.\n\n**Мифологический образ**: Нот, жаркий южный ветер древних греков. Они ценили приносимое им тепло — и боялись сопровождающих его ураганов, губивших урожаи.\n\nВсадник или **Рыцарь Жезлов** символизирует теплоту и даже жар души, проявляющиеся в жажде жизни, страстности и энтузиазме, но иногда переходящие в нетерпение, повышенную возбудимость, импульсивность и склонность к преувеличениям. Поэтому очень многое зависит от того, какой сферы нашей жизни касается заданный вопрос, ибо ответ карты может означать как согревающую теплоту, так и опаляющий жар. Нетерпение же, выражаемое этой картой, означает: я хочу все и по возможности сразу. Если не получается сразу, или если нам достается не все, мы раздражаемся, заводимся, становимся агрессивны. Однако внутренняя энергия, присущая Всаднику Жезлов, в любом случае приводит в движение целые пласты бытия, растапливает многолетний лед и вносит свежую струю в давно привычные ситуации. Работа Нас снедает нетерпение, наши силы требуют выхода, и нам очень трудно смириться с мыслью, что ничто не делается сразу, что нужно подождать какое-то время. Это может означать, что само дело, сама задача увлекает нас очень, однако нам хотелось бы приступить к ней сразу, и когда нас заставляют ждать, наше желание *перегорает*, и тогда даже малейшие препятствия отбивают у нас всякую охоту работать дальше. В каких-то иных ситуациях Всадник Жезлов может означать жесткую конкуренцию или столкновения интересов внутри коллектива. Сознание Здесь Всадник Жезлов означает, что мы находимся в фазе самоутверждения, отстаиваем свои убеждения, боремся за идеалы, не слишком заботясь об их практической ценности — или надеясь препоручить их осуществление другим. С другой стороны, тот энтузиазм, который мы при этом проявляем, заражает других, и они начинают помогать нам, что, конечно, стимулирует работу всего коллектива. Однако в тех случаях, когда нужно принимать решения, от которых зависит будущее и коллектива, и всех его членов, излишняя эмоциональность и поспешность может привести к ошибкам. Личные взаимоотношения Пламя любовной страсти — или, наоборот, ожесточенное противостояние. И то, и другое легко может привести к крайностям. Однако там, где эти мощные энергии могут быть объединены ради осуществления какого-либо общего дела, они приносят великолепные результаты, которых в одиночку вам никогда не удалось бы добиться.');
+INSERT INTO meaning_minor VALUES (297, 1, 14, 'straight', 3, 'Солнце во Льве как образ независимости и уверенности в себе.\n\n**Мифологический образ**: Благородный король Артур или премудрый царь Соломон.\n\n**Король Жезлов** — это мужская сторона стихии огня, силы и способности которой можно представить себе, вспомнив французского "короля-Солнце" Людовика XIV.\n\nЭто — олицетворение безграничной веры в себя, любви к жизни и ее радостям: ботатству, власти, величию. Причем эти качества не эгоистичны в примитивном смысле этого слова, это не самодовольство и не корысть: такой человек щедр и великодушен со всеми, кто его окружает. Таким образом, эта карта выражает волю, уверенность в своих силах, стремление не столько к материальному, сколько к духовному росту, к полной реализации и раскрытию собственной личности. Лишь в отдельных случаях, когда эти качества переходят в крайности, они могут выразиться в самолюбовании, самовозвеличении, тщеславии и хвастовстве. Работа Здесь **Король Жезлов** олицетворяет умение действовать решительно, уверенно и убедительно, энергичность и сильную мотивацию. Еще это организаторский талант, стремление к лидерству и достаточную для этого квалификацию. И, конечно, энергичность, увлеченность своим делом и готовность пойти на риск. **Король Жезлов** — это движущая сила, *мотор* всего коллектива. Если же чувство реальности у него ослаблено, это выливается в торопливую суету, в бесплодную имитацию деятельности, о которой баварцы говорят: *весь пар в свисток уходит*  Неумение рассчитывать время часто оказывается слабым местом Короля Жезлов: на осуществление своих планов он отводит самые кратчайшие, то есть обычно нереальные сроки, отчего многие его добрые намерения так и остаются лишь намерениями. Сознание Эта карта означает процесс созревания, связанный с расширением наших горизонтов в процессе поиска мудрости. Часто это выражается в укреплении силы воли, моральных принципов и убежденности в своих взглядах. Для Короля Жезлов жизнь — это поле деятельности, на котором мы сеем семена своих идей и вкладываем волю и силы, чтобы они дали ростки и плоды. Нередко она обозначает период, когда наша система ценностей или религиозные убеждения развиваются настолько, что начинают определять все наше поведение, не теряя при этом того оттенка субъективности, который помогает нам завоевывать симпатии других. Личные взаимоотношения Эта карта выражает теплоту, великодушие и искреннее увлечение. **Король Жезлов** здесь — это живость, дух предпринимательства, желание быть щедрым, побаловать своего партнера, отчего человек и сам радуется. Таким образом, карта показывает, что мы в принципе гордимся нашими партнерскими отношениями — или ищем партнера, который был бы сильной, достойной нас личностью.');
+INSERT INTO meaning_minor VALUES (284, 1, 10, 'reverted', 1, 'Вас постигла неудача, вы *надорвались*, пытаясь поднять слишком большой груз, и поплатились за это.');
+INSERT INTO meaning_minor VALUES (296, 1, 14, 'reverted', 1, 'Вами движут излишние амбиции, безрассудство или ложное честолюбие, что скорее всего будет означать неуспех задуманного дела, появление новых препятствий.\n\nЕще это может быть неблагоприятное известие.');
+INSERT INTO meaning_minor VALUES (417, 4, 12, 'straight', 3, '**Юпитер в Тельце** как символ постоянства, роста и приумножения богатства.\n\n**Мифологический образ**: Кузня Гефеста на Олимпе, где он изготавливал необходимые богам вещи.\n\n**Ицзин**: 32 Хэн. Постоянство. \n\n**Рыцарь Денариев** олицетворяет состояние духа, характеризуемое усердием, терпением и настойчивостью, в котором создаются вещи нужные и полезные. Он символизирует нечто солидное, прочное, постоянное, ту почву или фундамент, на которых мы можем строить свое будущее. Это настоящее выражение стихии земли, той вещественности и материальности, которая придает нам уверенности в себе, помогает смотреть на вещи с практической точки зрения и позволяет добиваться реальных результатов. Она же, впрочем, означает и вполне определенную границу способности нашего чувственного восприятия. Там, где мы выходим за эту границу, положительные качества Рыцаря Денариев искажаются или переходят в свою противоположность, оборачиваясь упрямством, косностью, толстокожей ленью и чувственными излишествами. Работа Здесь **Рыцарь Денариев** означает здоровую рабочую атмосферу, дух трудолюбия и надежности, благодаря которым и дело ладится, и планы успешно выполняются. Это и чутье на выгодные сделки, на хороших партнеров, и желание (и умение!) работать четко и продуктивно. Карта может означать также, что впереди у нас — благоприятный период, во время которого мы сумеем реализовать свои способности и заслужить уважение других благодаря умению отличать возможное от невозможного, ценное от пустого. Если спрашивают о предстоящем экзамене, переговорах или каком-либо проекте, то **Рыцарь Денариев** предвещает солидный, прочный и долговременный результат. Сознание На уровне сознания эта карта показывает, что мы овладеваем умением отличать реальное от нереального, а также находим наконец пути и средства к осуществлению своих замыслов и идей. Это может означать *политику маленьких шажков*, при которой ближайший, хоть и небольшой, результат ценится выше, чем отдаленные, хотя и великие цели . Кроме того, карта показывает, что нам придется заниматься чисто материальными вопросами и проанализировать свой замысел с точки зрения затрат и хозрасчета. Бывают, хоть и редкие, случаи, когда эта карта предостерегает от излишнего упрямства, черствости или жадности. Личные взаимоотношения Постоянство, надежность, продолжительность, верность — и, не в последнюю очередь, нежная чувственность. Прочный союз или перспектива такого союза, если партнера у нас пока нет. В союзе, характеризуемом Рыцарем Денариев, доверие и верность играют более важную роль, чем поиск новизны, а понятие *мы вдовоем* — более важную роль, чем поиск общения с друзьями или забота о личной свободе и независимости.');
+INSERT INTO meaning_minor VALUES (290, 1, 12, 'reverted', 1, 'Риск не оправдан, события обернутся совсем не так, как вы ожидаете.\n\nПоездка (перемена места) будет вынужденной.');
+INSERT INTO meaning_minor VALUES (289, 1, 12, 'straight', 1, 'Молодой человек, романтик, готовый пойти на риск ради благородного дела.\n\nШансы на удачу достаточно высоки.\n\nСовет — действовать; если речь идет о поездке, то — ехать, не сомневаясь и не откладывая.');
+INSERT INTO meaning_minor VALUES (292, 1, 13, 'straight', 1, 'Скорее всего, эта карта символизирует некую женщину. Хотя это может быть и мужчина. Во всяком случае, это человек, способный сыграть важную роль в решении вашей проблемы.\n\nОтличительные черты и совет: смелость, твердость характера, выдержка; главное — довести свое дело до конца, не поддаваясь на провокации и не впадая в излишние эмоции, особенно в стрессовых ситуациях. Тогда вас ожидает успех.');
+INSERT INTO meaning_minor VALUES (293, 1, 13, 'reverted', 1, 'Излишняя *деловитость*, работа или увлечение, отнимающие много времени и сил, от чего страдает личная жизнь.\n\nВозможны разлад в семье и общий неуспех дел.');
+INSERT INTO meaning_minor VALUES (347, 3, 3, 'reverted', 1, 'Карта вполне благоприятная, прогноз тоже, хотя в данный момент успеху и добрым отношениям что-то мешает.\n\nНо это пройдет со временем.');
+INSERT INTO meaning_minor VALUES (295, 1, 14, 'straight', 1, 'Умный руководитель, добрый отец семейства, надежный партнер.\n\nЭто — человек, достигший многого, умеющий трезво оценивать свои возможности и точно знающий, к чему приведут те или иные его усилил. Достигнутое его не удовлетворяете он хочет большего, ибо боится, что, не стремясь к большему, потеряет и то, что имеет.\n\nКогда в его жизни завершается какая-то битва, он тут же начинает новую. Об этом говорит и саламандра, кусающая себя за хвост, изображение которой выгравировано на троне фараона: она символизирует снедающий его *огонь*');
+INSERT INTO meaning_minor VALUES (310, 2, 5, 'straight', 1, 'Вам предстоит упорная борьба, однако исход ее неясен: потери во всяком случае очевидны, а выигрыш сомнителен.\n\nТак стоит ли ее затевать?\n\nЕще карта может означать уход, расставание с кем-то.');
+INSERT INTO meaning_minor VALUES (386, 4, 2, 'reverted', 1, 'Вы стоите перед выбором, не зная, на что решиться.\n\nОднако эта карта показывает, что сейчас вам лучше ничего не менять в своей жизни, а остаться *при своем*, потому что перемены будут скорее неблагоприятны.');
+INSERT INTO meaning_minor VALUES (298, 2, 1, 'straight', 1, 'Прекрасная карта, означающая возможность решить вашу проблему в самом скором времени, или совет: не затягивать дело, а *разрубить* его подобно Гордиеву узлу.\n\nЕще она может означать разрешение от бремени, то есть рождение ребенка или замысла.');
+INSERT INTO meaning_minor VALUES (342, 3, 1, 'straight', 3, 'Нептун/Юпитер в каком-л. гармоничном аспекте к Солнцу как дар полной самореализации.\n\n**Мифологический образ**: Священный Грааль как символ высшего блага, к которому нужно стремиться.\n\n**Ицзин**: 64 Вэй-цзи. Еще не конец!\n\n**Туз Кубков** — одна из самых лучших карт в колоде Таро. Как и другие тузы, он указывает на величайший шанс, нам открывающийся; это может быть и шанс полной самореализации. Что это означает для каждого конкретного человека, зависит от его собственных экспектаций (ожиданий). Спектр значений этой карты включает в себя радость, благодарность, удовлетворение, успех, счастье и единение. На первом месте, конечно, находится любовь как величайший дар во всех ее проявлениях: любовь к ближнему, к родителям, чувственно-эротическая любовь, любовь к самому себе и любовь к богу. Но она может проявляться и на самом что ни на есть обыденном уровне — как удача, везение, подарок судьбы, хотя, как правило, и без материального субстрата: за материальные *дары* отвечает скорее Туз Денариев. Во всяком случае нам должно быть ясно, что **Туз Кубков** обозначает некий необычайно удачный шанс, открывающийся перед нами или перед тем делом, которое мы себе наметили; но этот шанс не реализуется сам собой, для этого нужно приложить усилия. Работа В плане работы **Туз Кубков** означает, что мы находимся на верном пути, который приведет нас не только к ощутимым результатам и профессиональному росту, но прежде всего — к осознанию собственного призвания. Таким образом, здесь эта карта тоже открывает нам уникальный шанс — шанс найти гармоничное единство повседневного труда и процесса самореализации. И, поскольку этот процесс происходит не во внешнем, а во внутреннем мире человека, то ощущения полной самореализации он может достичь, работая и дворником, и профессором тропической медицины. В обыденных ситуациях эта карта может обозначать исполнение желаний, касающихся работы — например, успех на экзамене или на аттестации, выполнение намеченных планов, осуществление проекта и т.п. Сознание Здесь **Туз Кубков** означает *путь к себе*, познание своего глубинного *Я* и нахождение ответов на такие глубинные вопросы, как доверие к себе, вера в свои силы и опора на них же. Это также победа над тремя глубинными страхами, о которых писал еще граф Диркгейм: страхом уничтожения, отчаянием из-за чужого непонимания и безутешностью одиночества . Таким образом, эта карта показывает, что при помощи медитации или иных духовных практик мы можем достичь того счастливого состояния, которое древние безуспешно пытались описать как *прикосновение Вечности* или *Всеединство*  Личные взаимоотношения В сфере личных отношений **Туз Кубков** означает исполнение желаний, в первую очередь — желания настоящей Любви. Шансы, открывающиеся перед нами, могут означать и внезапную влюбленность, и счастье зрелой любви, и глубокое взаимное доверие, и ощущение безопасности и уверенности в завтрашнем дне.');
+INSERT INTO meaning_minor VALUES (299, 2, 1, 'reverted', 1, 'Ваша проблема, увы, пока не решается, *разрешения от бремени* не происходит.\n\nВозможно, задача вообще не имеет решения. Попробуйте найти другой путь.\n\nИли просто подождите: многие проблемы решаются со временем сами собой.');
+INSERT INTO meaning_minor VALUES (301, 2, 2, 'straight', 1, 'Ситуация такова, что сейчас вам лучше не делать ничего, хотя она вас очень беспокоит.\n\nНо все равно: не вмешивайтесь, оставьте человека или проблему в покое, потому что добиться вы все равно ничего не добьетесь, а испортить все дело можете.\n\nЛучше подождите.');
+INSERT INTO meaning_minor VALUES (302, 2, 2, 'reverted', 1, 'Ситуация обостряется, поэтому вам лучше принять меры: вмешаться, предложить свою помощь или сказать свое веское слово.\n\nИ не откладывайте, потому что потом может быть поздно.');
+INSERT INTO meaning_minor VALUES (287, 1, 11, 'reverted', 1, 'Скорее всего, возникнут обстоятельства, которые помешают осуществлению ваших благих намерений: болезнь, забастовка транспортников, природные катаклизмы.');
+INSERT INTO meaning_minor VALUES (282, 1, 9, 'straight', 3, 'Сатурн/Венера как защитники и покровители.\n\n**Мифологический образ**: Товий, отказавшийся поступить по велению Господа Бога.\n\n**Ицзин**: 47 Кунь. Истощение. \n\n**Девятка Жезлов** означает упрямство, сопротивление какой-либо ситуации или событию, от которых мы ожидаем лишь неприятностей. При этом она показывает, что никакой угрозы на самом деле нет (в противоположность Семерке Жезлов), а есть лишь наши воспоминания о прежних неприятностях, откуда и наше теперешнее *оборонительное* положение. Она олицетворяет состояние души, которое в сказках называют *холодным сердцем*  В переводе на житейский язык это означает, что мы ощетиниваемся всеми своими иглами и противимся любым переменам, хотя и догадываемся, что они могут быть очень важны для нашего дальнейшего развития. Хотя иногда она может означать и нечто прямо противоположное — если мы, например, сделали решающий шаг и закрыли за собой все двери, могущие вести к отступлению. В таком случае эта карта имеет позитивное значение, однако указывает и на одну допущенную нами ошибку: мы позорно сбежали от ответственности за свое прошлое из страха, что оно может нас настигнуть. Для того, чтобы от него освободиться, нужно окончательно отпустить от себя свое прошлое, превратить его в фундамент наших дальнейших преобразований, вместо того чтобы чувствовать себя его заложником. Работа Эта карта означает, что мы подсознательно противимся нововведениям, переменам или иным передвижениям, которые назревают для нас на работе. Она может показывать также, что мы ощущаем угрозу для себя от начальства, коллег или партнеров по бизнесу, и это тоже объясняется тем, что наша работа вызывает у нас опасения. Нам слишком мешают воспоминания о прошлых поражениях и неприятностях, мы переносим их на нашу теперешнюю ситуацию, хотя на самом деле теперь у нас достаточно сил, чтобы одолеть их. Таким образом, эта карта предлагает нам побороть страх и не только преодолеть возникающие перед нами препятствия, но и освободиться от старых страхов. Сознание На уровне сознания **Девятка Жезлов** показывает, что мы совершенно зря занимаем оборонительную позицию по отношению к вещам, для нас новым и неожиданным. Это образ человека, никогда не признающего, что он может ошибаться, всегда стремящегося еще *улучшить* то, что он только что, казалось бы, довел до конца, уверенного, что всегда лучше перебдеть, чем недобдеть, и тем самым лишь замуровывающего самого себя в каменных стенах своих собственных, давно отживших убеждений. Старинная пословица о человеке, который не учится на своих ошибках и оттого будет страдать вдвойне, конечно, права, однако лишь до тех пор, пока этот человек не превратит ее в закон собственной жизни, неизбежным следствием которого становятся застой и страх перед самой жизнью. Это напоминает шутку Фрица Римана о человеке, считавшем, что у рая две двери, на одной из которых написано: *Вход в рай*, на другой же — *Вход в зал заседаний по вопросу о рае*, и безоговорочно выбравшем последнее . Личные взаимоотношения "*Ребенок, обжегшийся на молоке*  Здесь эта карта показывает, что мы боимся, что нас обидят, или что заденут старые раны. Мы как бы носим панцирь, оберегающий наше внутреннее *Я* от ударов извне — и платим за это тем, что нас не достигают и те импульсы, которые могли были бы быть нам полезны. **Девятка Жезлов** предостерегает, что это может привести к полной самоизоляции, озлоблению и ожесточению, потому что наше *Я* загнивает без общения. Но в то же время она и радует нас, сообщая, что внешний мир, которого мы так боимся, не таит для нас больше никаких опасностей.');
+INSERT INTO meaning_minor VALUES (314, 2, 6, 'reverted', 1, 'Вы затеваете рискованное дело: шанс удачи ничтожно мал, так что браться за него, скорее всего, не стоит.');
+INSERT INTO meaning_minor VALUES (294, 1, 13, 'straight', 3, '**Луна во Льве** как символ радости жизни, темперамента, гордости, самоуверенности и неукротимости.\n\n**Мифологический образ**: Ипполита и Пентесилея, царицы амазонок. Или Артемида, богиня охоты и покровительница зверей.\n\n**Дама Жезлов** олицетворяет женскую сторону стихии огня. Тем самым она символизирует доверие к себе, мир с самим собой, кошачью ласковость, свидетельствующую скорее об уме, чем о приспособленчестве. Дама Жезлов всегда сама по себе, однако открыта всему новому и легка на подъем. Гордость, управляющая ее личностью, дает ей большую внутреннюю силу, однако делает ее уязвимой для критики и всяких обидных замечаний. Она символизирует радость жизни и жажду счастья в такой степени, что это может вылиться в чистую жажду наслаждений, в бегство в мир иллюзий и в самую расточительную трату душевных сил. Ее актерский талант и страстность делают ее *королевой сцены*, прекрасный пример которой дает Кармен в известной опере Ж. Бизе. Работа Это — наше стремление к независимости и самостоятельности. Тут Дама Жезлов показывает, что мы на данный момент открыты по отношению к любым контактам, непредвзяты и готовы взяться за новые, большие задачи, сил для решения которых у нас теперь уже хватит. Эта карта олицетворяет нашу готовность к дальнейшему созреванию и развитию, благодаря которым мы можем перейти на новый уровень осознания нашего бытия. Сознание В нас вливается некая сила, несущая теплоту и радость, смелость и жажду приключений. Кроме того, карта означает, что нам больше не мешают мелочные размышления вроде: *А стоит ли? *"*А получится ли?*, и что мы больше не позволяем другим людям решать за себя. Она, эта карта, показывает, что мы готовы взять судьбу в свои руки и устроить жизнь так, как того хотим сами. Теперь у нас хватит для этого и опыта, и независимости, и сознательности. Личные взаимоотношения Время раскрытия душевных способностей и *взятия новых высот*, часто необычайно интересных. Мы открыты всему новому, готовы к диалогу, к свободному обмену мыслями и чувствами, что может означать как завязывание новых, живых и плодотворных контактов, так и заметное оживление прежнего союза. *Мир с самим собой*, олицетворяемый этой картой, означает, что мы (в плане отношений с партнером) стали наконец *взрослыми* и больше не тушуемся перед партнером, до сих пор в чем-то нас превосходившим.');
+INSERT INTO meaning_minor VALUES (304, 2, 3, 'straight', 1, 'Проблема у вас действительно сложная.\n\nНе удивительно, что вы обеспокоены. Однако взгляните: проблема-то ведь не одна, их три, а может быть, и больше.\n\nПричем та проблема, о которой вы спрашиваете, возможно, даже не самая главная. Подумайте же, с которой из них следует начать, чтобы потом решилась и эта?');
+INSERT INTO meaning_minor VALUES (413, 4, 11, 'reverted', 1, 'В таком положении эта карта означает упущенный шанс, то есть дает неблагоприятный прогноз.\n\nИногда, правда, она намекает на возможность поправить дело деньгами.');
+INSERT INTO meaning_minor VALUES (339, 2, 14, 'straight', 3, '**Меркурий в Близнецах** как символ ловкости, остроумия, хитрости и находчивости.\n\n**Мифологический образ**: Хитроумные герои Одиссей и Сизиф.\n\n**Король Мечей** — это мужское проявление стихии воздуха.\n\nЭто — сила острого, быстрого, тонкого ума, выражающегося здесь как в своей творческой, многосторонней, часто непредсказуемой форме, так и в форме хитрости и ироничной дерзости. Такой ум — прекрасное средство познания, понимания, анализа, логического рассуждения и абстракции, математического и практического расчета, решения и всестороннего разрешения любых проблем. Однако там, где он доминирует надо всеми остальными способностями, проявляются его отрицательные стороны: сугубый интеллектуализм, холодность чувств, едкое и циничное критиканство, увлечение игрой слов и *легкость мысли необыкновенная*  Работа Гибкость, разумность, контактность и умение договориться с кем угодно. Высокопрофессиональный, гибкий и тактически грамотный подход к работе. Красноречие, обаятельность и умение понимать намеки в общении с коллегами, начальством, партнерами и клиентами. Однако это может быть и предостережение от впадания в такие крайности как холодная расчетливость, стремление запутать собеседника или заговорить ему зубы. Сознание Здесь эта карта означает период обучения и усвоения. Мы познаем, накапливаем информацию, ведем научно-методическую работу. В это время мы оттачиваем разум, чтобы с его помощью разобраться в своих проблемах, в себе самих и в окружающем мире. Главное здесь — стремиться к объективности. Мы ищем некую формулу, идею, которая лежит в основе всего. Точно так же, как в математике верный результат достигается не за счет энтузиазма или зубрежки, а лишь путем последовательного логического рассуждения, так и от нас требуется не эмоция, а рациональный анализ. Часто это затрагивает самые сокровенные области наших чувств или веры, которые подвергаются теперь самой тщательной проверке на логическую непротиворечивость. Однако острый меч интеллекта требует осторожного обращения. Иначе яд сомнения легко может уничтожить самые основные ценности, и мы вместо желанной ясности получим лишь груду обломков своих прежних чувств и убеждений, оставшись стоять перед нею с пустыми руками. Личные взаимоотношения Эта карта вносит ясность и в личные отношения, однако это часто сопровождается отчуждением. Рациональный анализ помогает распознать неверные шаблоны поведения и таким образом достичь исцеления. И там, где мы ощущаем зависимость или сталкиваемся с запутанными, неразрешимыми проблемами, *оперативное вмешательство* отчуждающего рассудка может быть хотя и болезненным, но целительным и полезным. Там же, где он превращает живое человеческое существо в объект анатомического исследования, душевное общение подменяется пустым интеллектуализмом.');
+INSERT INTO meaning_minor VALUES (265, 1, 4, 'straight', 1, 'У вас уже есть (или вы можете найти, если не поленитесь оглядеться вокруг) хороший дом, хорошие друзья, короче — *место покоя*, где вас ожидает радость.\n\nИными словами, прогноз для задуманного дела благоприятен, желательно лишь не искать лучшего в обмен на хорошее, не требовать от судьбы слишком многого — того, что предназначено не вам.');
+INSERT INTO meaning_minor VALUES (307, 2, 4, 'straight', 1, 'Вы решили удалиться от мира?\n\nЛечь на дно и позывных не передавать? Что ж, иногда это бывает полезно.\n\nНо, раз вы вытянули эту карту, значит, период такой *изоляции* для вас уже подходит к концу. Пора выйти наружу, к людям, которые вас ждут. Пора действовать!');
+INSERT INTO meaning_minor VALUES (308, 2, 4, 'reverted', 1, 'Временная самоизоляция, творческое одиночество будут вам только полезны.\n\nСуета внешнего мира мешает сосредоточиться, от этого и проблемы не решаются.\n\nВозьмите отпуск. отдохните — и решение придет само собой.');
+INSERT INTO meaning_minor VALUES (311, 2, 5, 'reverted', 1, 'Вам предстоит упорная борьба, даже битва, урон и травмы в которой неизбежны, однако исход для вас явно проигрышный, то есть прогноз неблагоприятен.');
+INSERT INTO meaning_minor VALUES (313, 2, 6, 'straight', 1, 'Если вы человек смелый, привыкший полагаться на свои силы, то эта карта означает для вас шанс удачи: хоть дело, которым вы намерены заняться, не знакомо вам, однако сил и решимости у вас хватит, чтобы добиться успеха.Для человека же робкого, нерешительного она неблагоприятна: риск и опасности слишком велики, поэтому его затея скорее всего обречена на неудачу.');
+INSERT INTO meaning_minor VALUES (316, 2, 7, 'straight', 1, 'Проблему-то вы оцениваете верно, да только ваш способ решения не годится.\n\nРаньше годился, да, но теперь ситуация иная, проблема новая. А новые проблемы нельзя решать старыми средствами: и проблема не решится, и неприятностей не оберетесь.\n\nПоэтому: ищите новые средства! И тогда успех обеспечен.');
+INSERT INTO meaning_minor VALUES (319, 2, 8, 'straight', 1, 'Трудности и препятствия у вас на пути не так велики, как вы себе представляете.\n\nПоэтому: не нужно считать себя слабым и беспомощным, говорит эта карта.\n\nСмело идите вперед, действуйте, и тогда сами увидите, как легко вам удастся преодолеть их.');
+INSERT INTO meaning_minor VALUES (393, 4, 4, 'straight', 3, '**Сатурн во II доме** как символ озабоченности будущем в силу неблагоприятных обстоятельств.\n\n**Мифологический образ**: Этрусский царь Мидас с "золотыми руками"  Бог Дионис исполнил его желание, чтобы все, к чему он прикасался, превращалось в золото, и царь с ужасом убедился, что ему грозит голодная смерть: в золото превращалась даже его пища.\n\n**Ицзин**: 60 Цзе. Ограничение.\n\n**Четверка Денариев** означает чрезмерную озабоченность будущим, проявляющуюся в алчности, скупости и глубоко укоренившемся страхе перед любыми переменами. Такая *установка* по сути своей враждебна жизни, потому что любые попытки *остановить мгновение*, удержать все, как есть, не только напрасны, но и противоречат естественному ходу вещей. Таким образом, эта карта обычно указывает, что нам остался один шаг до стагнации (застоя), и часто является предвестником Башни (XVI), означающей разрыв затвердевшей корки. Если **Четверка Денариев** оказывается в позиции, требующей от нас такого поведения, то это значит, что нам в данный момент необходимо сосредоточиться, ограничить себя в чем-то, отступить — или же, взявшись за дело, уже не выпускать его из своих рук. Работа Здесь эта карта показывает, что мы цепляемся за свою должность или, по меньшей мере, ожидаем, что она гарантирует нам стабильный доход и благополучие. Это — выражение излишне консервативного отношения к жизни, которое блокирует нам путь к новым, более благоприятным ситуациям. Иногда она означает, что мы просто привыкли думать и действовать определенным образом, не желая ни пересматривать свои позиции, ни учиться новому. Мы отчаянно пытаемся выжать еще хоть что-то из исчерпавшего себя поприща, тогда как нас давно уже ждут новые пути и возможности; или же мы просто не желаем их замечать, потому что не верим в их надежность, предпочитая держаться за свое убогое, но привычное кресло. Сознание На уровне сознания **Четверка Денариев** означает некую идею-фикс или укоренившийся предрассудок, который мешает нам воспринимать новые возможности и взгляды. Здесь она играет роль предупреждения: мы близки к тому, чтобы пасть жертвой собственного упрямства или собственной же ограниченности. Эту карту следует воспринимать как совет отказаться от наших прежних представлений как можно скорее, научиться воспринимать новые взгляды и способы действия, потому что иначе нас ожидает кризис, который в конечном итоге заставит нас это сделать. Личные взаимоотношения Печальное состояние. Человек боится открытых, естественных отношений, пытаясь заменить их ритуалами и раз навсегда заданными шаблонами поведения. Человек *цепляется* за партнера, что говорит о его подсознательном страхе оказаться брошенным, покинутым. И, хотя эти попытки по-человечески понятны, они в то же время глупы: там, где доверие заменяется постоянной озабоченностью (*а что будет завтра?*), а естественные человеческие отношения — ритуальными, *договорными*, всякая любовь умирает. Поэтому **Четверка Денариев** и здесь служит предостережением: даже если подобная озабоченность — результат самых благих намерений, она все равно приведет к разрыву.');
+INSERT INTO meaning_minor VALUES (396, 4, 5, 'straight', 3, 'Сатурн во II доме как показатель кризиса, упадка.\n\n**Мифологический образ**: Страдания Иова, или "семь тощих лет" Египта.\n\n**Ицзин**: 41 Сунь. Убыль.\n\n**Пятерка Денариев** — это карта кризиса, лишений и треволнений. Это упадок, провал в делах, когда мы чувствуем себя жалкими, несчастными, брошенными, а иногда и в самом деле находимся в стесненных обстоятельствах. При этом **Пятерка Денариев** редко служит предвестницей по-настоящему серьезных потерь, горестей или разорения, а лишь указывает на неопределенность положения и на наше субъективное ощущение страха лишиться почвы под ногами. Подобные периоды упадка характерны для всякого кризиса роста, когда человек покидает некую сферу, успевшую стать привычной, стабильной, и переходит в новую, еще не знакомую и, возможно, опасную. Работа Проблемы и объективные трудности, которые могут помешать нашим делам и планам, или даже лишить нас работы. Таким образом, карта означает опасение потерять что-то (имущество, деньги, должность), провалиться на экзамене, вообще потерпеть жизненное фиаско. Она также часто выпадает в связи со сменой места работы, когда привычное дело и гарантированный заработок сменяются новыми задачами и повышенным риском, как, например, при переходе от службы в фирме к частному предпринимательству. Однако карта вовсе не указывает на ошибочность такого шага. Это, скорее, вполне нормальное ощущение неуверенности при переходе от старого к новому в процессе профессионального роста. Таким образом, она характеризует лишь субъективное ощущение, и лишь в редких случаях означает действительную неудачу. Сознание Субъективное ощущение нищеты, упадка, хотя в реальности ничего подобного может и не быть. Карта показывает лишь, что мы озабочены своей дальнейшей судьбой, хотя жизнь у нас при этом может быть вполне обеспеченной и даже богатой. Урок подобных ощущений состоит в том, что нищету духа нельзя преодолеть никаким внешним богатством, — и, наоборот, что внешняя бедность не помеха богатству души. Кроме того, эта карта может указывать на очередной кризис роста, вполне естественный для любого процесса развития, в ходе которого проявляется наш (врожденный) страх перед нищетой и упадком, хотя путь к новым, светлым далям может лежать именно через них. Личные взаимоотношения В личных отношениях эта карта означает чувство покинутости, потери. Ужасное чувство, что нас не любят, что мы никому не нужны. Она может также выражать ощущение безнадежности, которое нужно воспринимать как приближение фазы *перелома*, когда мы сможем наконец избавиться от старых шаблонов поведения и построить качественно новые отношения. Если она и служит предвестницей кризиса, то и этот кризис есть неотъемлемый элемент развития, а не катастрофа. После такого кризиса отношения между партнерами лишь налаживаются.');
+INSERT INTO meaning_minor VALUES (320, 2, 8, 'reverted', 1, 'Ситуацию вы оцениваете правильно, однако трудности и препятствия на вашем пути еще слишком велики.\n\nПоэтому карта советует пока не предпринимать ничего: лучше подождать более благоприятного момента.');
+INSERT INTO meaning_minor VALUES (322, 2, 9, 'straight', 1, 'И настроение плохое, и жизнь тяжела?\n\nВаши страхи и заботы так велики, что, кажется, дальше и жить не стоит? Не отчаивайтесь, говорит эта карта, ваша *черная полоса* подходит к концу.\n\nНе волнуйтесь, не беспокойтесь о *мировых проблемах*, в чем бы они ни заключались: займитесь насущными делами, и ваше состояние стабилизируется.');
+INSERT INTO meaning_minor VALUES (403, 4, 8, 'straight', 1, 'Вас ожидает успех, но при одном условии: если вы не будете искать личной выгоды, особенно обогащения. Любая корысть может свести на нет все усилия.\n\nВаш труд будет вознагражден, говорит эта карта, но скорее всего не там и не так, как вы рассчитываете; поэтому — не рассчитывайте на награду заранее.');
+INSERT INTO meaning_minor VALUES (323, 2, 9, 'reverted', 1, 'И настроение плохое, и жизнь тяжела?\n\nВаши страхи и заботы так велики, что, кажется, дальше и жить не стоит? Не отчаивайтесь, говорит эта карта, ваша *черная полоса* уже заканчивается.\n\nМожет быть, не так скоро, как хотелось бы, но осталось все равно уже недолго. Поэтому не волнуйтесь, не беспокойтесь о *мировых проблемах*, в чем бы они ни заключались: займитесь насущными делами, и ваше состояние стабилизируется.');
+INSERT INTO meaning_minor VALUES (325, 2, 10, 'straight', 1, 'Если вам выпала эта карта, значит, дела у вас идут неважно, да и настроение далеко не радужное.\n\nОднако смысл ее скорее оптимистичен: это ведь десятая, последняя карта ряда мечей, после нее начинается новая масть, новый период жизни.\n\nСейчас уже бесполезно расстраиваться: в прошлом все равно ничего не исправишь, не лучше ли отдохнуть и собрать силы для новой работы?');
+INSERT INTO meaning_minor VALUES (326, 2, 10, 'reverted', 1, 'У вас был и еще продолжается трудный период в жизни.\n\nИ это *еще не конец*, как говорится: кое-какие огорчения вам еще предстоят, но они будут уже последними, и после них настанет светлый период.');
+INSERT INTO meaning_minor VALUES (305, 2, 3, 'reverted', 1, 'У вас была большая проблема, и не одна даже, а целый ряд проблем.\n\nЯ рад, что они уже разрешились, и теперь это все позади.');
+INSERT INTO meaning_minor VALUES (328, 2, 11, 'straight', 1, 'Эта карта может указывать на молодого человека или ребенка, с которым связана волнующая вас проблема.\n\nВ целом она означает объяснение, выяснение отношений путем переговоров, мягкое, *мирное* решение.\n\nПостарайтесь договориться.');
+INSERT INTO meaning_minor VALUES (329, 2, 11, 'reverted', 1, 'Излишняя резкость.\n\nВозможны цепляние к мелочам, ссора, бурные дебаты и взаимные оскорбления вплоть до разрыва отношений.\n\nПостарайтесь смягчить ситуацию, не доводя ее до конфликта.');
+INSERT INTO meaning_minor VALUES (331, 2, 12, 'straight', 1, 'Карта символизирует,как правило, молодого человека, находящегося в начале своей карьеры: он хочет показать себе и другим, на что способен.\n\nВ личном плане это может быть человек, еще не умеющий приспосабливаться к партнеру и предпочитающий идти на обострение отношений.');
+INSERT INTO meaning_minor VALUES (332, 2, 12, 'reverted', 1, 'Не следует слишком много думать о своих проблемах, а тем более зацикливаться на них: вы рискуете нажить себе новые неприятности или упустить хороший шанс.\n\nПостарайтесь развеяться, отвлечься.\n\nЗаймитесь медитацией, йогой, цигуном.');
+INSERT INTO meaning_minor VALUES (344, 3, 2, 'reverted', 1, 'Возможность счастья, успеха, радости есть, только в настоящее время ее по каким-то причинам трудно реализовать.\n\nПодождите какое-то время, и ситуация изменится к лучшему.');
+INSERT INTO meaning_minor VALUES (415, 4, 12, 'straight', 1, 'Как конкретное лицо это обычно специалист, исполнитель, которому вы должны поручить заниматься вашим делом: тогда вас ожидает успех.\n\nЭта карта вообще олицетворяет профессионализм, надежность, обязательность в выполнении обещаний, а иногда и просто везение, удачу.');
+INSERT INTO meaning_minor VALUES (334, 2, 13, 'straight', 1, 'Эта карта может служить указанием на какую-то умную женщину, от которой зависит решение вашего вопроса, или просто помощь с неожиданной стороны.\n\nВ любом случае это — разъяснение, прояснение какой-либо ситуации, а также ваш полный контроль над собой, своими реакциями и поступками, что на практике обычно означает успех задуманного дела.');
+INSERT INTO meaning_minor VALUES (335, 2, 13, 'reverted', 1, 'Вы слишком стремитесь все контролировать вокруг себя — и достигаете прямо противоположного результата: вы утрачиваете контроль над ситуацией, а это может привести к потерям и даже лишениям.');
+INSERT INTO meaning_minor VALUES (337, 2, 14, 'straight', 1, 'Возможно, представитель властей, чиновник, служащий, который может помочь вам в вашем деле.\n\nТак или иначе, это — официальный путь, трезвое рассуждение и привычка не верить никому на слово. Нужно, чтобы все было оформлено как полагается.\n\nТогда вы достигнете успеха.');
+INSERT INTO meaning_minor VALUES (338, 2, 14, 'reverted', 1, 'Похоже, что вас ожидает долгое разбирательство во многих инстанциях, и дело ваше затянется.\n\nВпрочем, вы сами виноваты, потому что не доверяли людям и пытались действовать неофициальными путями, отсюда — победы на промежуточных этапах, но высокая вероятность поражения в конечном итоге.');
+INSERT INTO meaning_minor VALUES (340, 3, 1, 'straight', 1, 'Эта карта не рекомендует вмешиваться в ход событий: лучше ждать, пока проблемы разрешатся сами собой.\n\nВ таком случае **Туз Кубков** означает *подарок судьбы*, поворот к лучшему. Однако и в вопросах любви или коммерческих предприятий ее предсказание благоприятно.\n\nВ чисто практическом плане может означать и просто подарок, например, на день рождения.');
+INSERT INTO meaning_minor VALUES (341, 3, 1, 'reverted', 1, 'Время для проявления способностей и творческого потенциала еще не пришло. *Подарков судьбы* не будет.\n\nВ практическом плане — подарок, который вы должны преподнести кому-то (то есть, возможно, взятка).');
+INSERT INTO meaning_minor VALUES (343, 3, 2, 'straight', 1, 'Дружба и любовь.\n\nНа практике, таким образом, прогноз она дает благоприятный, однако это касается главным образом отношений двоих, в отличие от Тройки, означающей радость многих (семьи, группы, коллектива).\n\nПри ответе на другие вопросы она также предвещает не только благоприятный исход дела, но и возникновение какого-то нового качества, нового направления развития.');
+INSERT INTO meaning_minor VALUES (346, 3, 3, 'straight', 1, 'Дружба и любовь.\n\nУспех.\n\nВ отличие от Двойки, Тройка указывает на успех и налаживание отношений многих или хотя бы нескольких людей, составляющих семью, группу, коллектив и т.д.\n\nВ сравнении с высшими картами Кубков эта карта может означать сравнительно малую выгоду (успех) по сравнению с иными, более благоприятными шансами — зато без лишнего риска.');
+INSERT INTO meaning_minor VALUES (349, 3, 4, 'straight', 1, 'Из-за детской *обиды* или неуместных амбиций вы рискуете упустить уникальную возможность поправить свои дела, добиться желаемого… Перестань дуться, говорит эта карта, прислушайся к тому, что тебе говорят, чтобы не пришлось потом пожалеть.');
+INSERT INTO meaning_minor VALUES (350, 3, 4, 'reverted', 1, 'Боюсь, что свой шанс вы уже упустили. *Поезд уже ушел*, и теперь вам остается лишь думать, как исправить ошибку.');
+INSERT INTO meaning_minor VALUES (352, 3, 5, 'straight', 1, 'Вы огорчены и расстроены, и будущее представляется вам мрачным и беспросветным.\n\nНет, жизнь не окончена, говорит эта карта: горе ваше действительно велико, и потери значительны, но впереди будут еще и радости, и приобретения.\n\nНужно лишь оглянуться, перестать размышлять о своем горе — и оно уйдет в прошлое, и счастливое будущее откроется перед вами.');
+INSERT INTO meaning_minor VALUES (353, 3, 5, 'reverted', 1, 'Жизнь не окончена, говорит эта карта: горе ваше действительно велико, и потери значительны, но ведь это все уже в прошлом.\n\nИли, по крайней мере, ждать вам осталось уже недолго: впереди будут еще и радости, и приобретения.');
+INSERT INTO meaning_minor VALUES (355, 3, 6, 'straight', 1, 'Дом, уют, покой.\n\nЕсли речь идет о задуманном или начатом деле, то прогноз благоприятен. *Маленькое счастье*, прибыль три цента на доллар, зато без всякого риска.\n\nХорошо для начинающих и людей *домашнего* склада; для людей предприимчивых, авантюрных *королевство, конечно, маловато *: развернуться негде.');
+INSERT INTO meaning_minor VALUES (356, 3, 6, 'reverted', 1, 'Успеха и прибыли, на которые вы рассчитывали, скорее всего, не будет.\n\nОднако в целом ситуация стабильна: не будет и ничего плохого, все останется, как было.');
+INSERT INTO meaning_minor VALUES (358, 3, 7, 'straight', 1, 'Вы стоите перед выбором, не решаясь сделать какой-то важный для себя шаг, потому что боитесь ошибиться.\n\nИ это правильно, потому что Кубки на этой карте — лишь искушение, иллюзия: что бы вы ни выбрали из предложенных даров, это не принесет вам счастья.\n\nВ данном случае вы должны либо найти еще один, совершенно иной, еще не известный вам вариант, либо отложить решение, ибо сейчас оно просто не может быть правильным.');
+INSERT INTO meaning_minor VALUES (361, 3, 8, 'straight', 1, 'Вам кажется, что вам чего-то не хватает для полного счастья.\n\nЧто надо немедленно бежать за ним в погоню, чтобы не упустить свой шанс. На самом деле это иллюзия.\n\nУ вас есть все, чтобы почувствовать себя счастливым. От добра добра не ищут, говорит эта карта; нужно лишь научиться замечать это добро, не ища лишнего. Да вы и сами догадываетесь об этом.');
+INSERT INTO meaning_minor VALUES (362, 3, 8, 'reverted', 1, 'Кажется, вы снова отправились в погоню за счастьем, не желая довольствоваться тем, что имеете, и снова безрезультатно.\n\nСколько можно повторять одну и ту же ошибку?\n\nВозможно, стоит подумать, как изменить свое отношение к жизни, как научиться ценить то, что есть.');
+INSERT INTO meaning_minor VALUES (364, 3, 9, 'straight', 1, 'Вам выпала очень благоприятная карта.\n\nКак правило, она означает индивидуальный успех — защиту диссертации, завершение какого-либо иного большого и важного дела. Или крупную прибыль, если вас интересуют финансовые дела.\n\nВ личных отношениях тоже все будет в порядке.');
+INSERT INTO meaning_minor VALUES (365, 3, 9, 'reverted', 1, 'Успех не за горами, у вас все получится, как и было задумано.\n\nТолько придется немного подождать.');
+INSERT INTO meaning_minor VALUES (367, 3, 10, 'straight', 1, 'Прекрасная карта, означающая успех и хорошие человеческие отношения.\n\nПравда, обычно это коллективный успех, события, приносящие радость не одному, а нескольким людям — семье, группе, коллективу.');
+INSERT INTO meaning_minor VALUES (368, 3, 10, 'reverted', 1, 'Прекрасная карта, означающая успех и хорошие человеческие отношения.\n\nПравда, это придет не сразу, потому что дело еще не завершено, но ждать осталось уже недолго.');
+INSERT INTO meaning_minor VALUES (370, 3, 11, 'straight', 1, 'Возможно, это некий молодой человек, с которым связано решение вашего вопроса.\n\nЧеловек несомненно одаренный, с богатым воображением, артист в прямом или переносном смысле. Так или иначе, эта карта означает возможность уладить какой-либо конфликт, восстановить мир и добрые отношения с другими людьми.\n\nНужно учитывать также, что вам предстоит еще многому научиться и многое понять, чтобы в будущем избегать конфликтов и напряженных ситуаций.');
+INSERT INTO meaning_minor VALUES (371, 3, 11, 'reverted', 1, 'Конфликт нужно уладить.\n\nПопробуйте договориться с другими людьми, уступите им, признайте свою неправоту, даже если вы и правы.\n\nИ, пусть примирение будет недолгим, однако оно даст вам возможность продвинуться по пути к намеченной цели.');
+INSERT INTO meaning_minor VALUES (373, 3, 12, 'straight', 1, 'Это может быть человек, от которого зависит решение вашего вопроса, — тонкий, умный, дипломатичный; попросите его помочь вам, и дело сдвинется с мертвой точки.\n\nВообще эта карта означает способность человека внушать другим то, что требуется для выполнения его намерений, а иногда — наличие некоего скрытого намерения.\n\nЕсли речь идет о вас, то главное, чтобы вы сами отдавали себе отчет в своих истинных намерениях.\n\nЕсли о другом человеке — остерегайтесь.');
+INSERT INTO meaning_minor VALUES (374, 3, 12, 'reverted', 1, 'Не исключено, что вы человек внушаемый, вас легко убедить сделать что-то, помочь другому, принять участие в деле, которое вас не интересовало и т.д.\n\nБудьте осторожны, чтобы вас не вовлекли в неприятную или опасную ситуацию.');
+INSERT INTO meaning_minor VALUES (376, 3, 13, 'straight', 1, 'Человек, скорее всего — женщина, чья интуиция или просто догадка могут помочь вам в вашей ситуации.\n\nЭта карта вообще означает необычайно развитую интуицию, доходящую до ясновидения, и совет воспользоваться ею, то есть в конечном итоге она предвещает успех задуманного дела.');
+INSERT INTO meaning_minor VALUES (377, 3, 13, 'reverted', 1, 'Боюсь, что в данном случае вы находитесь в плену иллюзии, принимая желаемое за действительное. Поэтому прогноз, скорее всего, будет неблагоприятен.\n\nИли, по крайней мере, остерегайтесь угодить в ловушку.');
+INSERT INTO meaning_minor VALUES (379, 3, 14, 'straight', 1, 'Как указание на какое-то конкретное лицо, эта карта может означать писателя, художника, вообще человека творческой профессии.\n\nИли совет обратиться к психотерапевту, астрологу, гадалке.\n\nНедаром **Король Кубков** означает эмпатию, то есть способность сопереживать, а также бескорыстную помощь, моральную и психологическую поддержку.');
+INSERT INTO meaning_minor VALUES (380, 3, 14, 'reverted', 1, 'У вас тоскливое настроение?\n\nДело не движется, и вы не можете заставить себя за него взяться?\n\nПопробуйте обратиться к психологу, психотерапевту, просто хорошему знакомому и поговорите с ним *по душам*  И вам станет легче.');
+INSERT INTO meaning_minor VALUES (395, 4, 5, 'reverted', 1, 'Вы слишком погружены в заботы этого материального, физического мира.\n\nПоэтому вы уже упустили шанс подняться над его суетой, открыть в себе источник новых духовных сил, и сейчас рискуете упустить его вновь.\n\nПрислушайтесь же к доброму совету, найдите время и средства, чтобы вступить на путь внутреннего роста.');
+INSERT INTO meaning_minor VALUES (382, 4, 1, 'straight', 1, 'Поздравляю: вы вытянули самую лучшую карту в колоде!\n\nЭто означает не только благоприятный ответ на ваш вопрос, но и прекрасный прогноз во всех областях жизни. Здоровье, деньги, личная жизнь, работа — у вас все будет в порядке.\n\nМало того: даже если другие карты сегодня оказались для вас не столь удачны, **Туз Денариев** отменяет или сильно смягчает их неблагоприятное влияние.В старину считалось, что после того, как выпадет **Туз Денариев**, лучше не продолжать гадать, по крайней мере на этом раскладе, чтобы не спугнуть удачу. Поэтому поблагодарите колоду, пусть и виртуальную, и завершите работу с этим раскладом. В жизни же действуйте смело: сейчас самый блестящий период для осуществления ваших планов. Все они осуществятся.');
+INSERT INTO meaning_minor VALUES (398, 4, 6, 'reverted', 1, 'Вы считаете себя человеком опытным и всегда знающим, кто, что и как должен делать. И всегда готовы наставить других на путь истинный. Или оказать помощь.\n\nУвы, это ошибка: вы принимаете честных людей за жуликов, а жуликов за честных. Поэтому вас легко обмануть. Эта карта рекомендует: вместо того, чтобы давать советы другим, прислушайтесь сами к добрым советам.');
+INSERT INTO meaning_minor VALUES (383, 4, 1, 'reverted', 1, 'Поздравляю: вы вытянули самую лучшую карту в колоде!\n\nЭто означает не только благоприятный ответ на ваш вопрос, но и прекрасный прогноз во всех областях жизни. Здоровье, деньги, личная жизнь, работа — у вас все будет в порядке.\n\nМало того: даже если другие карты сегодня оказались для вас не столь удачны, **Туз Денариев** отменяет или сильно смягчает их неблагоприятное влияние.В старину считалось, что после того, как выпадет **Туз Денариев**, лучше не продолжать гадать, по крайней мере на этом раскладе, чтобы не спугнуть удачу. Поэтому поблагодарите колоду, пусть и виртуальную, и завершите работу с этим раскладом. В жизни же действуйте смело: сейчас самый блестящий период для осуществления ваших планов. Все они осуществятся.');
+INSERT INTO meaning_minor VALUES (385, 4, 2, 'straight', 1, 'Вы стоите перед выбором, не зная, на что решиться.\n\nОднако обе возможности равны: что бы вы ни выбрали, ваша ситуация от этого не изменится. Да и настроение тоже.\n\nВы пока просто не готовы к чему-то новому. Так стоит ли менять что-либо?');
+INSERT INTO meaning_minor VALUES (388, 4, 3, 'straight', 1, 'Для решения проблемы одних ваших усилий будет недостаточно, нужен как минимум еще один или даже два человека.\n\nИли, если вы давно занимаетесь эзотерикой и работаете над собой, то тогда эта карта означает, что вы должны найти в себе три необходимых качества: благословение (священник), то есть дело должно быть благое, умение (зодчий), то есть профессионализм и опыт, и чувство юмора (шут), чтобы не принимать все слишком всерьез.\n\nТогда успех обеспечен.');
+INSERT INTO meaning_minor VALUES (389, 4, 3, 'reverted', 1, 'Для решения проблемы одних ваших усилий недостаточно, нужен как минимум еще один или даже два человека.\n\nИли, если вы давно занимаетесь эзотерикой и работаете над собой, то тогда эта карта означает, что вы должны найти в себе три необходимых качества: благословение (священник), то есть дело должно быть благое, умение (зодчий), то есть профессионализм и опыт, и чувство юмора (шут), чтобы не принимать все слишком всерьез.\n\nОднако сейчас у вас либо кого-то из нужных людей пока нет, либо недостает одного из этих трех качеств, поэтому выполнение плана придется отложить.');
+INSERT INTO meaning_minor VALUES (410, 4, 10, 'reverted', 1, 'Задуманное или начатое вами дело завершится успешно и принесет желаемые результаты, но путь к нему может оказаться таким долгим, что вас эти результаты уже не будут радовать.\n\nБраться ли за дело в такой ситуации, решать вам.\n\nХорошие отношения между родителями и детьми возможны, но пока не реализуются, что-то мешает.');
+INSERT INTO meaning_minor VALUES (391, 4, 4, 'straight', 1, 'Вы боитесь или стыдитесь проявлять свои чувства, всякий раз долго раздумывая: можно ли, нужно ли показывать близкому человеку, что я его люблю?\n\nМожно ли руководствоваться движением души, не лучше ли холодный расчет?Но, если силы не тратить, они иссякают: если экономить чувства, они *перекисают* и могут со временем превратиться в свою противоположность.\n\nЧеловек остается одиноким.Не стесняйтесь чувств, говорит эта карта; не все в мире поверяется холодным расчетом.Еще она может иметь и прямое значение — например, вам откажут в займе или кредите там, куда вы хотели обратиться, так что придется искать другой источник.');
+INSERT INTO meaning_minor VALUES (392, 4, 4, 'reverted', 1, 'Вы боитесь или стыдитесь проявлять свои чувства.\n\nВы больше полагаетесь на разум и расчет, чем на эмоции. В этом ваша ошибка.\n\nВы рискуете остаться в одиночестве. Если же вы спрашивали о деньгах, то в ближайшее время вы их, увы, не получите.');
+INSERT INTO meaning_minor VALUES (394, 4, 5, 'straight', 1, 'Вы слишком погружены в заботы этого материального, физического мира.\n\nЗабудьте о них хоть на миг, говорит эта карта, поднимите голову, проснитесь — и вам наверняка откроется нечто новое и светлое.\n\nНе проходите мимо своего счастья, не упускайте возможности решить свои проблемы хоть и необычным, но действенным путем.');
+INSERT INTO meaning_minor VALUES (266, 1, 4, 'reverted', 1, 'У вас неважное настроение, вы разучились ощущать радость жизни, доверие к друзьям и близким, а отсюда совет: отложите свои планы до тех пор, пока не восстановите душевное равновесие.');
+INSERT INTO meaning_minor VALUES (397, 4, 6, 'straight', 1, 'Вы считаете себя человеком опытным и всегда знающим, кто, что и как должен делать. И всегда готовы наставить других на путь истинный. Или оказать помощь.\n\nИ гордитесь этим. Увы, это ошибка: вы сильно переоцениваете свои знания и опыт, и поэтому другие, скорее всего, вас не любят. Но ошибку можно исправить.Даря другим свои чувства, оказывая им помощь, будь не горд, а просто добр, хотя и осмотрителен, говорит эта карта. И — не присваивай себе права судить кого бы то ни было.');
+INSERT INTO meaning_minor VALUES (400, 4, 7, 'straight', 1, 'Вы начали большое и важное дело, и уже многого добились.\n\nНо вас одолевают сомнения: все ли было сделано правильно? Удастся ли довести его до конца?\n\nИ будет ли результат?Имей терпение, говорит эта карта. Всякому делу требуется время, чтобы созреть. Если ты посеял доброе, то добрыми будут и всходы. Твой труд не пропадет даром. Ну, и поскольку урожая обычно ждут на следующий год, то карта может означать год времени.');
+INSERT INTO meaning_minor VALUES (401, 4, 7, 'reverted', 1, 'Вы начали большое и важное дело, и уже многого добились.\n\nНо вас одолевают сомнения: все ли было сделано правильно? Удастся ли довести его до конца?\n\nИ будет ли результат?Имей терпение, говорит эта карта. Всякому делу требуется время, чтобы созреть. Если ты посеял доброе, то добрыми будут и всходы. Твой труд не пропадет даром. Через год-два ты увидишь плоды своего труда.');
+INSERT INTO meaning_minor VALUES (404, 4, 8, 'reverted', 1, 'Вы затеваете какое-то большое и трудное дело, или уже потратили на него много времени и сил.\n\nМне жаль разочаровывать вас, но этого труда никто не оценит, он фактически пропадет даром.\n\nМожет, лучше бросить его и заняться чем-то другим?');
+INSERT INTO meaning_minor VALUES (406, 4, 9, 'straight', 1, 'Эта карта символизирует успешное завершение какой-то долгой и трудной работы. Но это не сделает вас счастливым. Так что, карта советует хорошенько подумать, прежде чем браться за осуществление своего плана.\n\nИли — обратиться за советом к мудрой женщине, опыт и знания которой широко известны.');
+INSERT INTO meaning_minor VALUES (407, 4, 9, 'reverted', 1, 'Вы затеваете какой-то проект или уже начали работать над ним?\n\nЧто ж, проект действительно принесет вам деньги. Но не больше.\n\nПоэтому, если вы рассчитывали на что-то еще, например, уважение и любовь окружающих, то вас ждет разочарование.');
+INSERT INTO meaning_minor VALUES (409, 4, 10, 'straight', 1, 'Эта карта обещает вам успех задуманного дела, хотя путь к успеху долог и труден, и ощущение покоя и счастья после его завершения.\n\nБудут и деньги, и прочие радости жизни.\n\nЕще она символизирует хорошие отношения между родителями и детьми.');
+INSERT INTO meaning_minor VALUES (412, 4, 11, 'straight', 1, 'Это может быть какой-то молодой человек, судьба которого вас интересует.\n\nЧто ж, для него ситуация складывается благоприятно.\n\nВообще эта карта означает шанс, возможность приобрести знания, то есть поступление в учебное заведение — или успешную учебу.');
+INSERT INTO meaning_minor VALUES (416, 4, 12, 'reverted', 1, 'Боюсь, что обстоятельства складываются для вас неблагоприятно.\n\nПоэтому рассчитывать на успех в данном случае не приходится, да и денег вы можете потерять много.\n\nСейчас лучше не стоит вкладывать средства даже в самые привлекательные проекты: подождите хотя бы месяц-другой.');
+INSERT INTO meaning_minor VALUES (418, 4, 13, 'straight', 1, 'Эта карта может указывать на женщину, скорее всего вашу родственницу, которая вас любит и сумеет помочь вам.\n\nЕсли вас интересует ситуация, то карта предвещает скорые счастливые события в семье: свадьбу, новоселье, рождение ребенка и т.д.\n\nНе обязательно вы станете их главным героем (или героиней), но и вам они тоже доставят радость.');
+INSERT INTO meaning_minor VALUES (419, 4, 13, 'reverted', 1, 'В скором времени в вашей семье произойдут события, которые скорее огорчат, чем обрадуют вас.\n\nВидимо, не вы будете их главным героем (или героиней), однако вам они тоже доставят много хлопот.\n\nПоэтому соберитесь с силами и будьте готовы поддержать родных в трудную минуту.');
+INSERT INTO meaning_minor VALUES (421, 4, 14, 'straight', 1, 'Это может быть какой-то богатый и влиятельный человек, к которому вы должны обратиться за помощью.\n\nСитуативно карта означает практицизм, настойчивость, умение добиваться своей цели, доводить дело до конца.\n\nОтсюда совет: на помощь надейся, но и сам не плошай.');
+INSERT INTO meaning_minor VALUES (422, 4, 14, 'reverted', 1, 'Не будьте чересчур практичны!\n\nНе все поддается расчету и не все покупается деньгами. Если вы всерьез хотите добиться успеха, то в данной ситуации вам лучше совершить какой-нибудь *безумный* поступок, повинуясь только эмоции.\n\nНо — один раз, не превращайте это в привычку!');
+INSERT INTO meaning_minor VALUES (359, 3, 7, 'reverted', 1, 'Кубки на этой карте — лишь искушение, иллюзия, и вы, кажется поддались ей, сделав ложный выбор.\n\nТеперь вам остается лишь думать, как исправить свою ошибку.');
+INSERT INTO meaning_minor VALUES (274, 1, 7, 'straight', 1, 'Там, где вы видите опасность, ее на самом деле нет, говорит эта карта.\n\nВ темной комнате нет чудовищ, она пуста.\n\nСмело войдите в нее — и вы сами убедитесь в этом.То есть опасность в принципе есть, и она вполне реальна, говорит эта карта.\n\nОднако в данном конкретном случае вам ничто не угрожает, так что действуйте смелее.');
+INSERT INTO meaning_minor VALUES (258, 1, 1, 'straight', 3, '**Солнце/Марс** как символ мужества, решительности, готовности рисковать и раскрытия личности.\n\n**Мифологический образ**: Посох, которым пророк Моисей выбил воду из скалы; или дубина Геракла.\n\n**Ицзин**: 46 Шэн. Подъем. \n\nТак же, как и другие Тузы, **Туз Жезлов** олицетворяет шанс, открывшийся перед нами — или нам еще предстоит открыть его. Туз масти Жезлов обозначает предприимчивость, смелость и готовность пойти на риск, а также вдохновение, энтузиазм и раскрытие личности, причем в важнейших ее составляющих. Учитывая, что Жезлы соответствуют стихии огня, к этим составляющим можно отнести укрепление воли, убеждений и моральных принципов и другие процессы внутреннего созревания и роста. Таким образом, в целом **Туз Жезлов** означает жизнеутверждение, оптимизм и радость жизни. Хотя иногда, впрочем, указывает на недостаток терпения. Работа Нам открывается возможность узнать, в чем заключается наше призвание, а тем самым и реализовать наконец свои интересы, таланты и способности. Диапазон значений этой карты простирается от поручения нам сложного, блестящего и самого *нашего* задания по месту нашей непосредственной работы до уникального шанса заявить о себе там, куда мы прежде не могли иметь доступа. В любом случае она свидетельствует о честолюбии, сильной мотивации и энтузиазме по отношению к предстоящему делу. Сознание На уровне сознания эта карта означает период, когда все наши силы, убеждения и наша воля получают свое полное раскрытие. Это — время внутреннего роста, самопознания и самосознания. Личные взаимоотношения Живое, плодотворное партнерство, время *притирания* друг к другу, возможность создать сердечный, по-человечески добрый союз или же внести доброту и любовь в союз уже устоявшийся (и несколько прискучивший). В любом случае эта карта означает возрастающую привязанность друг к другу, взаимопонимание и желание предпринять что-нибудь совместно. Поскольку **Туз Жезлов** — это все-таки стихия огня, тут могут происходить взрывы темперамента и вспышки страсти, однако без трагических последствий.');
+INSERT INTO meaning_minor VALUES (264, 1, 3, 'straight', 3, 'Меркурий во Льве как уверенность в завтрашнем дне и широта кругозора в гармоничном аспекте с Сатурном как надежной основой.\n\n**Мифологический образ**: Моисей на горе Синай. Видение Земли обетованной.\n\n**Ицзин**: 20 Гуань. Созерцание.\n\n **Тройка Жезлов** объединяет в себе два важных образа. Во-первых, это человек, проделавший долгий и трудный путь и достигший наконец вершины, и во-вторых, это тот, кому с высоты открылся широкий обзор всего окружающего мира. Прочный фундамент, наша опора, и перспектива светлого будущего, наша цель — вот два значения этой в целом вполне положительной карты. Работа Мы достигли некоего поворотного пункта, за которым начинается уже наша земля, принадлежащая только нам, где мы сами можем решать, что и как делать, и теперь мы можем строить свои собственные планы на будущее. **Тройка Жезлов**, как уже говорилось, объединяет в себе два аспекта: прочный фундамент и широкую перспективу. Сознание Мы достигли вершины, с которой видны широкие горизонты. Теперь, когда мы совершили нечто важное в своей жизни, взошли на очередную вершину, мы можем дать себе передышку — и взглянуть вдаль, чтобы узнать, какая цель ждет нас впереди. То, что мы сумели достичь поставленной цели, придает нам уверенности в себе, и мы знаем, что сможем теперь достичь и следующей цели. Личные взаимоотношения Ваш союз построен на прочной, творческой основе, позволяющей смело планировать самое далекое будущее. Союзы, подчиненные этой карте, легки, надежны и богаты творческими потенциями. Если вы пока пребываете в одиночестве, эта карта означает, что вы уже заложили фундамент прочных отношений с другим человеком, и теперь осталось лишь немного подождать, чтобы эти отношения реализовались.');
+INSERT INTO meaning_minor VALUES (267, 1, 4, 'straight', 3, '**Венера в V доме** как символ радости, игры, развлечений, или Луна/Венера как олицетворение чувства безопасности и редости общения.\n\n**Мифологический образ**: Греческая хора Эйрини, богиня мира и покоя; Ной, покидающий ковчег.\n\n**Ицзин**: 22 Би. Убранство. \n\n**Четверка Жезлов** соответствует периоду мира и покоя, во время которого мы раскрываемся навстречу жизни и ее радостям. Мы уверены в собственной безопасности, а потому готовы покинуть *крепостные стены* и выйти во внешний мир. Это означает общение, развлечения, удовольствия и радость жизни, а также раскрытие нашего *Я* ей навстречу, ощущение глубокой взаимосвязи с другими людьми. Работа Работа хорошая, перспективная, лишиться ее нам не грозит, условия труда нас вполне устраивают, так что дело свое мы делаем с удовольствием. Период удовлетворения от работы, сама работа приносит радость, и дело спорится. Впрочем, карта может означать и спокойный, без проблем, переход на другую работу или к новым обязанностям. Сознание Опыт и знания, приобретенные нами ранее, были весьма ценны и полезны, но они уже сослужили свою службу, и теперь нам пора двигаться дальше, навстречу новому, говорит **Четверка Жезлов**. *Фундамент* у нас хороший, мы готовы непредвзято воспринять новые вещи, поэтому следующий этап познания доставит нам истинную радость. Личные взаимоотношения Безоблачный период мира и покоя. Мы испытываем чувство безопасности, защищенности, сохраняя при этом открытость души и относясь к окружающим с добротой и любовью. Мы предпринимаем что-то совместно с партнером, и это доставляет нам удовольствие, новые контакты и радость жизни.');
+INSERT INTO meaning_minor VALUES (366, 3, 9, 'straight', 3, '**Луна в Тельце** как символ радости, удовольствия, веселой компании.\n\n**Мифологический образ**: Круглый стол короля Артура.\n\n**Девятка Кубков** означает период, когда мы испытываем подлинную радость, купаемся в ней.\n\nПравда, такой период может обернуться и излишествами, стремлением *съесть больше, чем можешь переварить*, однако в целом значение у этой карты положительное: приятные события, беззаботность, веселая компания, словом, то, что называется *красиво жить не запретишь*  Работа В этот период работа доставляет нам удовольствие, и дело ладится как никогда. Большую роль играет также спаянный коллектив, хорошая и надежная *команда. Душевный подъем и хорошее настроение помогают забыть о трудностях и конфликтах прошлого. Однако не стоит забывать о мере, иначе спаянный коллектив превратится в споенный, а работа — в халтуру. Сознание Период, когда наше мировоззрение обращено к наслаждению. В своих наиболее ярких проявлениях зто — гедонист, главный принцип которого: *Живи и радуйся", или эпикуреец, любитель чувственных наслаждений. В умеренных формах это чувство юмора, период чистой радости бытия, когда у нас есть время и желание наслаждаться красотой мира, радоваться жизни и с удовольствием вспоминать о каждом прожитом дне. Личные взаимоотношения На этом уровне карта олицетворяет период счастья и наслаждений. Фантазии, прежде осуществимые лишь в мечтах или в кратком отпуске, становятся повседневной действительностью. Однако и здесь **Девятка Кубков** предостерегает от излишеств, которые могут превратить радость жизни в погоню за наслаждениями или тупое переваривание уже надоевших удовольствий.');
+INSERT INTO meaning_minor VALUES (268, 1, 5, 'straight', 1, '*Шума много, а толку мало* — вот что означает эта карта.\n\nЕсли вы хотите начать какое-то дело, то **Пятерка Жезлов** обещает лишь хлопоты и чьи-то обещания, но отнюдь не результат.\n\nЕсли же вы, напротив, опасаетесь чего-то — например, что на вас подадут в суд, — то можете ничего не опасаться, потому что пустые хлопоты выпадут на долю ваших недоброжелателей.');
+INSERT INTO meaning_minor VALUES (269, 1, 5, 'reverted', 1, 'Много хлопот и шуму, а результат более чем сомнителен: синяки и шишки гарантируются, а все остальное уж как повезет.\n\nНо повезет вряд ли.');
+INSERT INTO meaning_minor VALUES (256, 1, 1, 'straight', 1, 'Воля, сила и энергия — вот что означает эта карта, *хотеть — значит мочь*  Будьте тверды в своих намерениях, и победа останется за вами, успех обеспечен.\n\nЕще этот Туз может означать мужское начало, фаллос.\n\nЕсли он выпадает мужчине, значит, у него с этим делом все в порядке, если женщине — совет найти себе мужчину.');
+INSERT INTO meaning_minor VALUES (270, 1, 5, 'straight', 3, '**Марс в V доме** как символ спортивных соревнований, игры.\n\n**Мифологический образ**: Олимпийские игры, основанные, по преданию, идайским дактилом Гераклом.\n\n**Пятерка Жезлов** — это брошенный нам вызов, приглашение померяться силами, посоревноваться.\n\nПроверка: хватит ли у нас силенок? Это отнюдь не связано с враждой или битвой не на жизнь, а насмерть. Нам предлагают попробовать свои силы — может быть, и вправду в каком-то состязании, споре, а может быть, и в некоем новом для нас деле. Силы (или навыки) для этого у нас есть, и нам просто предоставляется шанс применить их, так что опасаться серьезного поражения или ущерба нет причин, разве что при стечении целого ряда неблагоприятных обстоятельств. Шансы, какие предлагает эта карта, упускать не следует, потому что они дают нам возможность убедиться в своих способностях, осознать их. Работа **Пятерка Жезлов** означает задачу, которую нам предстоит решить; разумеется, она потребует от нас напряжения, но отнюдь не перенапряжения сил. Это может быть качественно новое, непривычное задание, или необычный объем или масштаб работы, на который мы прежде не замахивались; или многоходовая комбинация, требующая ловкости и сообразительности. Это — конкурсное задание, как в телевикторине, и к нему надо относиться как к игре, тренировке сил, а не браться за него с убийственной серьезностью. Сознание Здесь **Пятерка Жезлов** означает проблему или загадку, над которой придется поломать голову, однако это поможет нам значительно продвинуться вперед по пути познания. В качестве примера можно привести те горячие споры и ожесточенные дискуссии, которые подростки ведут друг с другом и со взрослыми, шлифуя свой ум и постепенно вырабатывая собственные идеи и представления вместо прежних, навязанных другими людьми. Личные взаимоотношения Семейство спорщиков, которые любят друг друга, но то и дело пререкаются или соревнуются в чем-то. Это не романтичный союз двух нежных душ, а постоянная борьба. Впрочем, эта особенность может и не быть присуща союзу как таковому, а отражает лишь некий период в жизни партнеров. Или же им приходится совместно бороться за решение какой-то проблемы. Еще карта может указывать, что без споров союз быстро превратился бы в унылое болото, поэтому партнеры и поддерживают себя *в боевой готовности*  Пример — союз главных олимпийских божеств, Зевса и Геры.');
+INSERT INTO meaning_minor VALUES (273, 1, 6, 'straight', 3, '**Юпитер в Х доме** как символ успеха и славы.\n\n**Мифологический образ**: Ника, греческая богиня победы, и ее римский аналог Виктория.\n\n**Ицзин**: 63 Цзи-цзи. Уже конец. \n\n**Шестерка Жезлов** — это карта победы, успеха, славы и *всенародного признания*, а отсюда и радости, удовлетворения, удовольствия. Если уж быть совсем точным, то она представляет собой обнародование успеха, провозглашение победы. Так что в обыденной жизни она может означать и просто хорошую новость, без всякой помпы и парада. Как правило же эта карта показывает, что наш труд и наши усилия увенчаются успехом. Иногда это бывает и неожиданный, *незаслуженный* успех. Работа Карта показывает, что в своей работе мы вполне можем рассчитывать на успех и признание. Наш труд будет вознагражден, он не пропадет даром, у нас сейчас вообще успешный период. И это успех не рядового масштаба, это — высший балл за блестяще сданный экзамен, овации за речь или публичное выступление. Эта карта выпадает победителю на выборах, популярному артисту, вообще человеку, переживающему нечто радостное и интересное, то есть, если попросту, и тому, кого повысили в должности. Сознание Эта карта показывает, что наши поиски ясности и смысла в отношении какой-то проблемы наконец увенчаются успехом. Это может означать и освобождение от утомительных дум и забот, которого мы ждали так долго. Или — период подъема, дающий нам такую уверенность в себе и своих силах, какой мы никогда прежде не испытывали. Отсюда — еще одно возможное значение: перемена в сознании, замена *психологии неудачника* психологией победителя. Личные взаимоотношения Здесь эта карта победителя означает, что нам предстоят волнующие, радостные переживания. Это может быть начало нового, чудесного союза, или подъем к вершинам счастья в союзе, уже существующем. **Шестерка Жезлов** показывает также, что нам удастся разрешить какую-то давнюю проблему, связанную либо с неким человеком, либо с вашими партнерскими взаимоотношениями. На бытовом уровне она можут означать хорошую новость, приятное известие или звонок, которого вы, возможно, долго ждали.');
+INSERT INTO meaning_minor VALUES (276, 1, 7, 'straight', 3, 'Меркурий/Марс в аспекте с Сатурном как олицетворение ловкости, побеждающей силу.\n\n**Мифологический образ**: Битва Давида с Голиафом.\n\n**Ицзин**: 33 Дунь. Бегство.\n\n**Семерка Жезлов** означает, что мы подверглись нападению, что против нас выступают конкуренты, завистники или иные противники, причем они сильнее нас или их больше. Тем не менее эта карта показывает, что шансы на победу у нас хорошие, потому что мы находимся в более выгодном положении. Поэтому ее следует воспринимать как предупреждение: будь ловок и бдителен, чтобы не утратить своего позиционного преимущества. Само собой, что о настоящем, физическом нападении речь здесь идет редко; чаще всего это чье-то вмешательство в наши частные или даже личные дела, попытка лишить нас чего-либо или помешать чего-то добиться. Работа Здесь эта карта означает главным образом конкуренцию. Мы внедряемся в рынок, на котором царит ожесточенное соперничество, или стремимся получить место, на которое претендует несколько человек. Еще она может означать чьи-то нападки на нас по работе или попытку поставить под сомнение нашу квалификацию. В некоторых случаях она указывает, что у нас есть завистник, стремящийся занять наше место. Но, так или иначе положение у нас прочное и, если мы будем бдительны и не дадим лишить себя своих преимуществ, то нам ничто не грозит. Сознание Наши взгляды и убеждения подвергаются резкой критике; возможно, нам пришлось или еще придется участвовать в жарких дебатах. И, хотя в этих дебатах мы можем найти для себя и кое-что полезное, ведутся они не ради поиска истины, а чтобы насолить нам или заставить нас замолчать. Так что от нас в данной ситуации требуются скорее стойкость и твердость убеждений, чем готовность к диалогу и желание учиться у других. Личные взаимоотношения Наши отношения с партнером находятся под угрозой. Это может быть ваш с ним конфликт, хотя чаще всего сюда вмешивается кто-то третий. Или, если партнер пока *не ваш*, и вы еще только боретесь за его благосклонность, эта карта может означать, что у вас есть соперники (соперницы). В таком случае она дает совет: отнеситесь к этой конкуренции вполне серьезно, подойдите к делу с умом, и успех будет на вашей стороне.');
+INSERT INTO meaning_minor VALUES (279, 1, 8, 'straight', 3, 'Фактор времени в астрологическом прогнозировании: момент, когда начинает действовать транзит, дирекция, прогрессия, цикл и т.п.\n\n**Мифологический образ**: Косяк перелетных птиц как примета приближающихся событий.\n\n**Ицзин**: 35 Цзинь. Восход.\n\nОсновное значение этой карты — время. Это — единственная из всех 78 карт, ясно указывающая, что событие произойдет в самом скором времени . Но у нее есть и более глубокое значение. Она показывает, что что-то меняется, что-то носится в воздухе и наступит скорее, чем мы ожидаем, и что этот процесс уже начался, даже если мы этого еще не заметили. При этом **Восьмерка Жезлов** предвещает скорее благоприятные события, добрые вести или приятные сюрпризы. Работа Если у нас были определенные планы или ожидания, связанные с работой, то эта карта показывает, что они осуществятся в самом ближайшем будущем; если же это были опасения, то осуществятся, увы, именно они. Она говорит также о том, что этот процесс готовился уже долгое время, и результаты уже близки, или что его темп неожиданно ускорился, так что нам надо срочно готовиться к его окончанию, хотя мы думали, что у нас впереди еще много времени. Часто она означает неожиданный успех — в поисках нового поприща, новых связей или контрактов. Сознание Тут **Восьмерка Жезлов** означает, что наш процесс познания ускоряется, что что-то носится в воздухе, и мы получим информацию из неожиданного источника, однако она значительно поможет расширению нашего кругозора. Это — живая, гибкая карта, указывающая на спонтанный характер происходящих изменений и часто означающая, что застывшие, закоснелые представления приходят в движение. Личные взаимоотношения В плане личных отношений она означает оживление и новые стимулы. Это может быть скорое заключение нового, необычайно плодотоворного союза (партнерства), или положительное развитие союза уже существующего. И только в случае, если все остальные карты расклада предвещают неприятности, нам следует ожидать неприятных сюрпризов.');
+INSERT INTO meaning_minor VALUES (420, 4, 13, 'straight', 3, '**Луна в Тельце** как символ трезвого взгляда на вещи, плодотворного труда и семейной близости.\n\n**Мифологический образ**: Изида, египетская богиня плодородия, изображавшаяся с головой быка, и ее греческий аналог — волоокая Ио. "Широколицая" Европа, возлюбленная Зевса (принявшего образ белого быка).\n\n**Королева Денариев**, олицетворяющая женскую сторону стихии земли, означает трезвость, доброту, надежность, реализм, трудолюбие, плодородие и плодотворный труд, душевность и чувственные радости.\n\nОна обладает природным инстинктом и любовью к земле, что ярче всего проявляется в образе крестьянки. Кроме того, у нее *легкая рука* в отношении материальных ценностей, о чем напоминает грубовато-добродушный образ маркитантки — матушки Кураж из одноименной пьесы Б. Брехта. Это также заботливая, многодетная мать, талантливая актриса или художница или просто чувственная натура, получающая истинное удовольствие от самых простых радостей жизни. Но в этом также нужна мера, иначе вышеназванные положительные качества обернутся разочарованием или инертностью, ленью, жаждой одних только наслаждений. Работа Работа, требующая практической сметки и навыка, терпения и упорства, но в то же время и творческая, которую мы выполняем не только успешно, но и с удовольствием. **Королева Денариев** означает здесь нашу надежность и высокую квалификацию, умение работать и творческий рост. Шумного успеха или наград ожидать не приходится, потому что главное в этой работе — ее непрерывность и наша способность *дать всему идти своим чередом* (К.-Г.Юнг). Сознание Здесь **Королева Денариев** указывает, что мы переживаем период *вынашивания* очередной идеи, призванной сыграть в нашей жизни важную роль. Мы открыты для восприятия советов и идей других, но всегда проверяем их на осуществимость и полезность. Туманные мечтания или модные концепции нас сейчас не увлекают, мы ищем то, что имеет практический и долговременный смысл. При этом мы вовсе не *уходим из мира*, не отказываемся от радостей жизни. Даже наоборот: **Королева Денариев** символизирует чувственные радости, придающие этой карте значение душевной теплоты и полноты чувств. Личные взаимоотношения Наступает период душевной теплоты и сердечности, чувственных радостей и вообще радостей жизни. Кроме того, эта карта указывает на верность, постоянство и стремление к доброте и защищенности. Она может означать также, что мы *созрели* для того, чтобы вступить в брак и создать семью.');
+INSERT INTO meaning_minor VALUES (288, 1, 11, 'straight', 3, 'Венера в соединении с Луной в Стрельце как открытие новых возможностей, воспринимаемых как освобождение.\n\n**Мифологический образ**: Ясон, увлекший аргонавтов в авантюрный поход за золотым руном.\n\n**Ицзин**: 16 Юй. Вольность. \n\nВестники вообще олицетворяют шансы и возможности, открывающиеся нам на жизненном пути. Жезлы — это стихия огня, и шанс, открывающийся здесь, имеет форму какой-либо увлекательной идеи или предложения, принимаемого нами с восторгом. Обычно это возможность выйти далеко за рамки привычной сферы интересов, приглашение к приключению, требующему смелости и желания рискнуть, яркий мазок на фоне серых будней. Это может быть участие в конкурсе, спортивном соревновании, возможность проверить свои силы, какие-то необычные переживания, маленькое *открытие Америки*  Работа Нам предложат что-то интересное, и мы будем рады принять это предложение. Новое задание, где мы сможем блеснуть своими способностями, новые место или должность, поездка (например, за границу) или вообще что-то необычное, но весьма для нас привлекательное. В любом случае для нас это означает рост и возможное повышение. Даже если задача окажется трудной, взяться за нее стоит, потому что ее выполнение доставит нам радость. Сознание Нас ожидают открытия, благодаря которым мы сможем расширить свой кругозор. Какие-то вещи, на которые мы раньше не обращали внимания, вдруг откроются нам с неожиданной стороны, увлекут в новую, еще не знакомую или до сих пор не интересовавшую нас область. Это может быть книга, театральный спектакль, концерт, лекция или семинар, наконец, просто интересный разговор с кем-то. Карта может означать также необходимость решать какие-то чисто бытовые вопросы, в связи с чем у нас возникнут новые идеи. Личные взаимоотношения В этом плане Вестник Жезлов часто означает, что нас ожидает нечто увлекательное. Это может произойти как в рамках уже устоявшихся отношений, которые станут интереснее и богаче, так и через знакомство с новым партнером; или же это может быть некое приключение, открытие новой, еще не привычной нам формы отношений.');
+INSERT INTO meaning_minor VALUES (300, 2, 1, 'straight', 3, '**Меркурий/Марс** как символ остроты ума и решительности, или Меркурий/Юпитер как прирост познания и высшего Разума.\n\n**Мифологический образ**: Меч (сила познания), которым Александр Великий разрубил Гордиев узел (душевные метания).\n\nКаждый из Тузов олицетворяет некий шанс, который нам открывается.\n\n**Туз Мечей** соответствует высшему Разуму, той силе познания, которая ведет нас к ясности, непротиворечивости и способности принимать решения. В противоположность следующей карте (Двойке Мечей), символизирующей разрушительную силу сомнения, здесь работа ума осуществляется в своей самой чистой, светлой и освобождающей форме. Всю остроту ума, которой мы располагаем, нужно направить на проникновение в суть проблемы, не теряя при этом из виду общей картины, не топя проблему в споре о словах и не отмахиваясь от нее *за незначительностью*, говорит эта карта. Работа Ясное, четкое представление о своем деле, которое позволяет принимать вполне однозначные решения, а также признак близкого разрешения какой-то запутанной проблемы. Некая свежая струя, дающая новый стимул ставшему привычным делу или сдвигающая с мертвой точки *буксующие* переговоры. Или беседа, которая расставит все по своим местам и оживит *застоявшуюся* атмосферу. Наконец, символика остроты и разделения, связанная с этой картой, может означать наше хорошо продуманное решение расстаться с прежней работой. Сознание Здесь этот Туз показывает, что нам нужно по-новому взяться за проблему, долго казавшуюся нам неразрешимой, чтобы разделить ее на отдельные, доступные анализу части и разобраться с ними по очереди: только так мы сможем решить всю проблему в целом. Благодаря этой способности к анализу и синтезу мы можем найти выход из запутанных ситуаций, освободиться от зависимостей разного рода и начать целенаправленно двигаться по новому пути. Личные взаимоотношения **Туз Мечей** может означать здесь некий серьезный разговор, который принесет нам свободу, или решение (и преодоление) какой-то глубоко лежащей проблемы. Кроме того, ясный и трезвый взгляд на вещи, с которым связывается эта карта, может означать освобождение от иллюзий, то есть, в конечном итоге, распад союза.');
+INSERT INTO meaning_minor VALUES (318, 2, 7, 'straight', 3, '**Меркурий** как символ хитрости, обмана, подлости и неискренности.\n\n**Мифологический образ**: Гермес как божество-покровитель воров и его сын Автолик, непревзойденный вор.\n\n**Ицзин**: 44 Гоу. Перечение.\n\n**Семерка Мечей** показывает нам оборотную сторону Мага (I), с которой ее роднит золотистый фон обеих карт. Сила познания, ясный, острый ум превращаются здесь в хитрость, интригантство, подлость и обман. В более мягкой форме это может быть злой язык и интеллектуальное высокомерие или позиция *моя хата с краю* — нежелание замечать какие-то вещи, привычка юлить, увиливать, филонить и т.п. При этом непонятно, кто же кого тут обманывает, кто мы — жулик или жертва. Но чаще всего оказывается, что мы вредим тут сами себе. Работа Нас могут обмануть, или мы сами можем обмануться; возможно, что мы пытаемся добиться чего-то нечестным, жульническим путем. В ход идут такие сомнительные средства как лицемерие, *подставка*, проход вне очереди и прочие малопочтенные трюки. Если эта карта выпадает в ответ на вопрос о работе, нам, конечно, нужно быть начеку, но главное — повнимательнее присмотреться к тому, как мы ведем себя сами, чтобы не пасть жертвой своих собственных интриг. На событийно-личностном уровне она символизирует волынщика, либо всячески увиливающего от ответственности, либо откровенно халтурящего. Сознание На уровне сознания она показывает, что мы упорно не желаем признавать очевидное и боимся дискуссий на эти темы. А это нечестно прежде всего по отношению к самим себе: так мы сами закрываем себе путь к познанию истины, а в особо тяжелых случаях посвящаем всю свою жизнь делу заведомо ложному. Поэтому здесь эту карту нужно воспринимать как предостережение и совет не возводить в абсолют свои взгляды, почаще подвергать их сомнению. Личные взаимоотношения Здесь спектр карты довольно широк: от мелких гадостей вроде злорадства и лицемерия до самой низкой лжи, злобы, подлости и предательства. С другой стороны, она может указывать на недостаток открытости в отношениях, когда мы избегаем прямых разговоров с партнером или увиливаем от важного решения.');
+INSERT INTO meaning_minor VALUES (324, 2, 9, 'straight', 3, '**Сатурн/Луна** как символ озабоченности, подавленности и чувства вины.\n\n**Мифологический образ**: Эриннии (фурии) как персонификация угрызений совести: Алекто (Неотступная), Тисифонм (Отмщающая за убийство), и Мегера (Завистливая). Этот образ бессонных ночей соответствует состоянию глубокой озабоченности и подавленности.\n\nЭто может быть нечистая совесть, не дающая уснуть, или же ощущение какой-либо опасности для жизни, например, болезни или горькой утраты. Карта изобразает ночные страхи, когда мы лежим без сна, мучимые своими мыслями, и ждем не дождемся рассвета. При этом она не говорит нам, что именно нас так угнетает: чувство собственной вины или стиыда, которое гонит сон прочь, ощущение неуверенности в своих силах перед трудным испытанием или какой-то реальной опасностью, угрожающей нашей жизни. Она показывает лишь наше отчаяние, озабоченность, внезапное кошмарное пробуждение, бессонную ночь . Работа Унылый, пессимистический настрой из-за того, что работа, начальник или атмосфера в коллективе нас угнетают. Это может быть боязнь не справиться с полученным заданием, переутомление из-за повышенной нагрузки, ощущение повышенного риска или мандраж перед экзаменом, публичным выступлением и т.п. Или нечистая совесть, мешающая нам спать, потому что мы где-то допустили ошибку, небрнжность и теперь опасаемся, что это будет обнаружено. Сознание Наш горизонт затянут тучами, мы утратили ясность взгляда из-за страха, озабоченности или чувства вины. Эти чувства могут быть обоснованны, а могут и нет, однако они все равно вмешиваются в наше сознание, сбивают нас с толку и мешают уверенности в себе. Или, возможно, мы сделали для себя некое неприятное открытие и теперь опасаемся последствий. Личные взаимоотношения Страх оказаться *брошенным*, боль разлуки. Подавленное настроение из-за одиночества или боязнь потерять любимого партнера, повергающая нас в пучину душевных мук и сомнений. Это могут также быть наши опасения по поводу близких, как оправданные, так и неоправданные. Иногда это стыд оттого, что партнер вдруг узнал о нас (или чуть было не узнал) такие вещи, которые могут уронить нас в его глазах.');
+INSERT INTO meaning_minor VALUES (405, 4, 8, 'straight', 3, '**Меркурий в III доме** как символ усердия и сноровки.\n\n**Мифологический образ**: Талос, племянник и самый одаренный ученик великого мастера Дедала.\n\n**Ицзин**: 17 Суй. Последование.\n\n**Восьмерка Денариев** означает начало какого-либо многообещающего дела. Это — карта Ученика, приступающего к долгой и трудной, однако чрезвычайно важной для него работе. Таким образом, карта объединяет в себе мотивы начала и прекрасной перспективы на будущее. Кроме того, она показывает, что мы заинтересованы в своем деле, получаем от него удовольствие и гордимся первыми результатами. Она может означать также удачу новичка. Работа Переход на новую работу или выполнение нового, непривычного для нас задания. Мы приступаем к нему, еще не имея ни необходимых навыков, ни опыта, зато с горячим желанием и интересом. В практическом плане эта карта может обозначать плату за обучение в буквальном смысле — или ту психологическую *плату*, в которую нам обходится вступление в новую для нас область. Однако главное значение карты — радужные перспективы, открывающиеся для нас именно в этой новой области. Сознание Здесь **Восьмерка Денариев** означает, что нам вновь придется сесть за парту — в прямом или переносном смысле. Наступает период учебы, и нам предстоит либо освоить новую область знаний, либо приобрести новый жизненный опыт. И, в зависимости от нашей готовности воспринимать новое, этот период принесет нам радость открытий или покажется мучительным и трудным. Но в любом случае эти знания и опыт будут для нас ценными и полезными. Личные взаимоотношения В области личных отношений эта карта также обозначает начало нового, многообещающего этапа. Это может быть новое знакомство, а может и качественно новая стадия развития прежнего союза, в ходе которой нам открываются далеко идущие и, как правило, обнадеживающие перспективы.');
+INSERT INTO meaning_minor VALUES (327, 2, 10, 'straight', 3, '**Марс/Сатурн** как символ искусственного, насильственного конца.\n\n**Мифологический образ**: Термин, бог рубежей и межевых столбов у римлян.\n\n**Ицзин**: 18 Гу. Исправление. \n\nПодобно карте **Смерти** (XIII), **Десятка Мечей** олицетворяет завершение, конец чего-то, размежевание. Отличие заключается в том, что Смерть означает естественный, а **Десятка Мечей** — искусственный, иногда насильственный конец, то есть наступающий *не в свое время*  И, хотя такой конец часто сопровождается тяжелыми, болезненными переживаниями, это не обязательно так: такое множество мечей символизирует могучую силу разума, решившего *подвести черту* под чем-то. Это могли быть важные, обогащающие нас переживания, но могли быть и неприятнфе ситуации, дурные привычки или трудный, неблагоприятный период в жизни. Так или иначе, это горький момент расставания с кем-то или с чем-то. Какое ощущение возникает у нас при этом, трагической потери или облегчения, как после хирургической операции, можно определить лишь по контексту. Точно так же и о том, нужно ли было такое вмешательство или не нужно, было ли оно своевременным или нет, нам скажут лишь остальные карты расклада. Работа Решительное, даже резкое прекращение работы, уход с должности, прощание с прежней деятельностью. На практике это чаще всего уход и переход к новому роду занятий. Реже — небольшие перемены, например, переход на другую работу в рамках той же фирмы или внезапная отмена прежнего задания. Сознание Здесь эта карта чаще всего означает, что мы *подводим черту* под своим прошлым, напрягая для этого весь свой разум и волю. Это могут быть как отдельные представления и убеждения, так мировоззрение в целом. Впрочем, элемент искусственности, присущий этой карте, всегда служит предостережением: не поторопились ли мы со своим решением, не отказываемся ли от чего-то действительно важного, или не проходим ли мимо ценного жизненного урока, сопротивляясь ему только потому, что он противоречит нашим прежним взглядам? Личные взаимоотношения В этой сфере **Десятка Мечей** оборачивается своей самой опасной, кризисной стороной. Она может указывать и на разрыв каких-то действительно ненужных, дурных связей, однако чаще всего она означает, что мы употребляем всю силу разума, чтобы подавить свои чувства, роя самому себе яму и отказываясь от чего-то чрезвычайно ценного, рискуя разрушить добрые человеческие отношения.');
+INSERT INTO meaning_minor VALUES (345, 3, 2, 'straight', 3, '**Венера на асценденте** как символ_соединения_влюбленных.\n\n**Мифологический образ**: Конкордия, римская богиня согласия.\n\n**Ицзин**: 31 Сянь. Взаимодействие.\n\n**Двойка Кубков** означает соединение влюбленных. Это может быть новое знакомство, примирение с прежним возлюбленным или проявление добрых чувств в устоявшемся союзе. Значение этой карты затрагивает в первую очердь глубоко личную, интимную сферу, о чем бы ни шла речь — о легком флирте, внезапной влюбленности, свидании после долгой разлуки или начале новых дружеских или любовных отношений. Но она может означать, например, и теплый прием, гостеприимство или желание пойти нам навстречу, когда мы приезжаем куда-нибудь в незнакомые места, отправляемся в деловую командировку или на переговоры. Работа Хорошая атмосфера, дух товарищества и сотрудничества, словом — место, где приятно работать. Если эта карта выпадает в ответ на вопрос о возможной смене места работы, то она означает, что на новом месте нас ожидает дружеский прием, что новые коллеги и руководители помогут нам скорее войти в ритм. То же относится к таким нашим намерениям, как поиск работы, открытие собственной фирмы, вообще к любым делам и проектам. Сознание Здесь **Двойка Кубков** означает, что нас занимают в первую очередь вопросы любви и гармонии, и что мы готовы открыть свою душу другим людям. Нередко она означает оптимистический, жизнеутверждающий взгляд на окружающий мир, и этим мы обязаны некоей важной встрече, которая в свое время многое изменила в нашей душе. Карта может показывать также, что мы осознали то огромное значение, которое имеет любовь к ближнему, и теперь строим свою жизнь на ее основе. Личные взаимоотношения Это — главная сфера действия Двойки Кубков. Она олицетворяет период влюбленности, ухаживания, то есть чаще всего — нового знакомства, многое меняющего в нашей жизни. Но это может быть и чувство, вновь возникшее между прежними партнерами, или светлый период жизни в уже устоявшемся союзе, а также примирение после ссоры или периода отчуждения.');
+INSERT INTO meaning_minor VALUES (271, 1, 6, 'straight', 1, 'Вы вытянули карту Триумфатора — человека, добившегося своей цели, блестяще выполнившего труднейшую задачу.\n\nКарта предвещает успех начатого дела: ведь вы сделали уже так много, перенесли столько трудностей, прошли большую часть пути.\n\nПоэтому не падайте духом: победа уже близка.');
+INSERT INTO meaning_minor VALUES (348, 3, 3, 'straight', 3, '**Венера** как символ радости и благодарности.\n\n**Мифологический образ**: Три хариты или грации, богини веселья: Аглая ("Блеск"), Эвфросина ("Радость") и Талия ("Цвет").\n\n**Ицзин**: 58 Дуй. Радость.\n\n**Тройка Кубков** выражает радость, беззаботность и благодарность — чувства, так ярко проявляющиеся у людей, например, на традиционном Празднике Урожая. Она показывает, что в нашу жизнь вошло нечто важное и прекрасное, или что мы получили подарок (от человека или от самой судьбы), наполнивший нас радостью, счастьем и благодарностью. На более глубоком уровне карта может означать оптимизм и ощущение полноты бытия, на внешнем (событийном) плане — праздник, застолье. Работа Окончание важного этапа работы и банкет по этому поводу. Это может быть сдача экзамена, защита диссертации, повышение в должности или прибавка к зарплате, заключение выгодной сделки. Или — теплая, дружеская атмосфера в коллективе, совместное мероприятие, пикник с коллегами. Сознание **Тройка Кубков** указывает, что мы с радостью и благодарностью пришли к завершению какой-то важной фазы своего внутреннего развития — или достигли результатов, которые наполнили нас ощущением радости и счастья. Или же она показывает, что для нас закончился период кризиса, испытания, пусть трудный, но чрезвычайно ценный в смысле приобретенного опыта. Конечно, она может означать, и что у нас сейчас просто хорошее самочувствие и настроение, и мы благодарны судьбе за возможность наслаждаться жизнью. Личные взаимоотношения Здесь эта карта означает радости *медового месяца* или просто гармоничные отношения с партнером. А также благодарность, испытываемую нами за то, что у нас такой прекрасный партнер — или за прибавление семейства, ожидаемое или уже имеющее место.');
+INSERT INTO meaning_minor VALUES (262, 1, 3, 'straight', 1, 'Дело-то хорошее, да только трудное очень.\n\nСправитесь ли? Если вы верите в себя, если готовы трудиться долго и упорно, то успех вам обеспечен.\n\nПравда, только в решении материальных вопросов, в создании вещей, которые можно потрогать руками: строительство дома, покупка квартиры, создание фирмы. Если же вас интересует интеллектуальный труд, то это не ваша карта, и прогноз тут скорее неблагоприятен.');
+INSERT INTO meaning_minor VALUES (354, 3, 5, 'straight', 3, '**Сатурн/Венера или Сатурн/Луна** как символ расставания, боли, горя и отчаяния.\n\n**Мифологический образ**: Отчаяние Психеи, любопытство которой вынудило к бегству ее возлюбленного Эрота, и она думала, что потеряла его навсегда.\n\n**Ицзин**: 54 Гуй-мэй. Невеста.\n\n**Пятерка Кубков** — это карта печали, боли, отчаяния и меланхолии. Она показывает, что мы потеряли что-то, бывшее для нас долгое время очень важным. Она говорит также, что мы не останемся один на один с нашим горем, а можем рассчитывать на сочувстве и помощь друзей. Однако эту утешительную сторону карты не следует истолковывать в том смысле, что горе наше надуманное, и что скоро нам снова будет хорошо и весело. Часто карта показывает, что виной всему было наше собственное легкомыслие, что мы не ценили того, что имеем, и поэтому лишились его. Однако есть выход и из этого положения: карта советует не зацикливаться на своем горе слишком долго. Работа **Пятерка Кубков** — это разочарование и боль из-за неудавшегося проекта. Она может означать провал на экзамене, отказ от места или сделку, от которой мы ожидали большой прибыли, но несем лишь убытки. Однако карта советует: проанализируй все, найди свою ошибку, проверь, не слишком ли легкомыслен или негативен был твой настрой с самого начала. Сознание **Пятерка Кубков** показывает, что мы испытали разочарование, боль и обиду и должны теперь извлечь урок из этого. Часто это означает отказ от наивных, идеалистических представлений и переход к более зрелой и прагматичной жизненной философии. Особенно глубокое значение имеет эта карта в тех случаях, когда мы наконец осознаем, что причиной нашего разочарования был наша собственная небрежность, невнимательность или капризность, или когда испытываемая боль заствляет нас пересмотреть прежние шаблоны поведения и выработать новый взгляд на жизнь. Личные взаимоотношения В сфере личных отношений **Пятерка Кубков**, как правило, означает распад прежнего союза или разочарование в знакомстве, казалось бы, столь много обещавшем. Если это действительно печальный конец когда-то прекрасного союза, то нам стоит спросить себя, какую лепту внесли в это мы лично. Часто при этом мы с удивлением убеждаемся, что одной из главных причин распада было наше собственное раздражение, развившееся на почве скуки. Лишь осознав эти причины, мы получим шанс извлечь урок из всего происшедшего, чтобы не повторять своих ошибок.');
+INSERT INTO meaning_minor VALUES (369, 3, 10, 'straight', 3, '**Юпитер/Луна** как символ уюта и душевной теплоты.\n\n**Мифологический образ**: Свадьба Кадма и Гармонии на волшебном острове Самофракии, ради которой даже боги покинули Олимп, чтобы вручить молодым свои дары.\n\n**Ицзин**: 37 Цзя-жэнь. Домашние.\n\nЭта карта выражает наивысшую гармонию и глубокую, благотворную любовь. Она показывает, что мы уверены в себе и в будущем, что наши чувства глубоки и чисты, и что мы свободны от каких бы то ни было иллюзий или самообмана. Она означает душевное спокойствие, добрососедство, любовь и радость в общении с другими, истинное счастье и чувство глубокой благодарности в семье или в партнерских отношениях. Работа Прекрасные отношения между коллегами, хорошо *сыгранная* команда. Любезность и приветливость в отношениях с начальством, подчиненными, преподавателями, товарищами. Новые интересные знакомства и связи, деловые контакты, способствующие нашему профессиональному росту. Сознание Здесь **Десятка Кубков** означает мир и покой в душе, когда мы открыты творческому вдохновению и забываем о прежних мрачных, деструктивных или мучительных мыслях. Их место занимает ощущение радости и легкости. На более глубоком уровне карта может символизировать подлинное человеколюбие. Личные взаимоотношения Чувство защищенности, *безопасности*  Период, когда забываются старые обиды и трудности, сглаживаются кризисы, преодолеваются внутренние барьеры, и на их место приходят радость, благодарность и спокойствие. В партнерских отношениях это означает наступление *светлой полосы*, когда преобладают любовь, доверие и самые лучшие чувства. Часто карта означает начало нового долговременного знакомства, а то и свадьбу.');
+INSERT INTO meaning_minor VALUES (277, 1, 8, 'straight', 1, 'Не загадывай на будущее, говорит эта карта, пользуйся днем, по словам древних: *Молодость быстро летит, лови уходящее время *… Она не дает прямого ответа на заданный вами вопрос, а лишь указывает, что время для ответа еще не пришло.\n\nСейчас не этот вопрос — самый важный; лучше употребить силы на что-то другое.\n\nТо есть, в принципе, *да*, ответ положителен, но осуществится он не так скоро, как вы думаете, а месяцев через шесть-восемь.');
+INSERT INTO meaning_minor VALUES (263, 1, 3, 'reverted', 1, 'План-то хорош, да сил у вас может не хватить.\n\nПоэтому лучше не торопитесь, а обдумайте еще раз все *за* и *против *: скорее всего, вы придете к решению отложить ваш план до более удобного времени.');
+INSERT INTO meaning_minor VALUES (372, 3, 11, 'straight', 3, 'Венера в I доме как повод к примирению, или в V доме как удовольствие от игры.\n\n**Мифологический образ**: Великодушный жест примирения Исава, простившего Иакову его обман, с помощью которого тот получил благословение Исаака.\n\n**Ицзин**: 57 Сунь. Проникновение.\n\nКак и три другие валета, Валет Кубков олицетворяет некий импульс, толчок, шанс. В данном случае это может быть приглашение к участию в каком-то приятном деле или жест любви и примирения. Так или иначе, это инициатива, исходящая от других, которая апеллирует к нашим чувствам, и мы принимаем ее с радостью и благодарностью. Карта олицетворяет начало периода мира после ссоры или конфликта, сочувствие, проявленное по отношению к нам в трудное время, а иногда и чей-то душевный порыв, оборачивающийся для нас влюбленностью. Этот порыв, как правило, чист и искренен, и лишь в случае, если другие карты говорят об обратном, нам следует отнестись к нему с настороженностью. Работа Здесь Валет Кубков означает, что нас ожидает нечто новое, и это новое будет не только полезным, но и приятным, а возможно, и лестным. Это может быть награда, признание, заслуженная похвала, или какое-то известие по работе, подтверждающее высокую оценку наших способностей. Еще это может быть приглашение на дружескую вечеринку в узком кругу или, например, предложение старшего и всеми уважаемого товарища перейти с ним на *ты*  Иногда это добрый совет или дружеская помощь, благодаря которым мы сумеем лучше справиться со своей задачей. Сознание К нам приходят дружеские импульсы и идеи, благодаря которым мы начинаем лучше разбираться в своей ситуации. Это может быть утешение или поддержка в трудное время, сочувствие по поводу наших бед и проблем, или спонтанные жесты, пробуждающие в нас глубокие чувства и жажду нового развития. Кубки символизируют стихию воды, что может означать также мудрый совет или послание, пришедшее к нам в ходе медитации или обращения к высшим силам. Личные взаимоотношения Дружеский, сочувственный, примирительный жест со стороны партнера. Свежий ветер после темного периода ссор и конфликтов, или спонтанное влечение одной души к другой. На событийном уровне может означать предложение пожениться.');
+INSERT INTO meaning_minor VALUES (306, 2, 3, 'straight', 3, '**Марс/Луна** как символ оскорбленных чувств. В аспекте с Меркурием — решение, принятое вопреки чувствам.\n\n**Мифологический образ**: Выбор Авраама, приносящего в жертву своего сына Исаака.\n\nАнтигона, похоронившая своего брата Полиника вопреки категорическому запрету царя Креонта. Ицзин: 23 Бо. Разорение. Из-за рисунка эту карту часто считают только символом огорчений, главным образом, из-за несчастной любви. У нее есть, конечно, и такой аспект, однако этим ее содержание далеко не исчерпывается. Главное значение Тройки Мечей — выбор, сделанный вопреки чувству. А вот что это, малодушие или, наоборот, шаг к освобождению, можно понять, лишь зная подоплеку заданного вопроса и проверив остальные выпавшие карты. В ситуациях, когда чувства жестоко подавляются разумом, эта карта, конечно, имеет негативный характер. Но если она показывает, что нам силой разума удалось вырваться из плена какой-либо зависимости, освободиться от сомнительных привычек, то это — шаг хоть и болезненный, но необходимый. Работа Здесь **Тройка Мечей** означает, что от нас требуется некий шаг или действия, идущие вразрез с нашими чувствами. Мы должны выполнить работу, которую ненавидим, или пойти на тяжелый разговор, от которого с души воротит. Если положение у нас скорее руководящее, то карта может означать неприятную необходимость выговора подчиненному, которого мы очень ценим. Конечно, она может означать и то, что это нам самим придется выслушать выговор, возможно, несправедливый, или пережить какие-либо иные неприятности, которые могут закончиться даже увольнением. Сознание На уровне сознания **Тройка Мечей** показывает, что нам предстоит узнать горькую истину, утратить все прежние иллюзии. Нам может открыться нечто, что заставит нас отказаться от прежних, привычных представлений, преодолеть инерцию мышления, или сделать шаг, которого мы до сих пор избегали — из страха ли, или из отвращения. Там, где интеллект задавил все чувства, эта карта служит предостережением, однако если острое лезвие разума может освободить нас от болезненных, чрезмерно владеющих нами чувств, этот болезненный шаг может быть спасительным, как вовремя сделанная хирургическая операция. Личные взаимоотношения А вот здесь это действительно чаще всего — несчастная любовь. Разочарование, обида, взаимные упреки, нередко заканчивающиеся разрывом. Однако и тут эта карта может проявить свое позитивное значение: решение наконец покончить, несмотря на всю боль, с союзом, ставшим для нас ловушкой, тупиком или пыткой.');
+INSERT INTO meaning_minor VALUES (309, 2, 4, 'straight', 3, '**Сатурн в V доме** как символ перерыва в творчестве или в VI доме как символ болезни.\n\n**Мифологический образ**: Бежавшие герои, ждущие своего часа, когда они вновь понадобятся миру: король Артур на туманном острове Авалон, император Барбаросса на горе Киффхойзер.\n\n**Ицзин**: 9 Сяо-чу. Воспитание малым. \n\n**Четверка Мечей** — это карта застоя, прерванной деятельности и вынужденного покоя. В этом значении она напоминает карту Повешенного (XII), отличие же ее от него в том, что она связана с конкретными событиями: те препятствия или затруднения, на которые указывает Четверка, обычно просты и понятны, и преодоление их не требует от нас переворота всех жизненных устоев. Это положение сродни болезни, которая тоже составляет одно из значений карты. Мы вынуждены прервать активную деятельность, сделать паузу. Будем ли мы страдать или постараемся использовать эту паузу, чтобы разобраться с самими собой, зависит только от нас. При этом ясно, что использовать паузу для работы над собой мы можем, но вот устраивать ее себе по доброй воле стали бы вряд ли. Работа Застой. Дело не может сдвинуться с мертвой точки, потому что наши силы иссякли. Продолжать дальше нет смысла, потому что *сопротивление материала* и наша слабость могут в сумме привести нас к полному краху. В таких-то ситуациях нам и нужны бывают покой, отдых, пауза, а может быть, и действительно помощь врача или консультация психотерапевта. Лишь когда мы восстановим свои силы, побывав, например, в отпуске, можно вновь взяться за решение задачи, представлявшейся такой безнадежной. И тогда мы с удивлением обнаружим, что дело идет легко, как по маслу. Сознание Вполне вероятно, что мы дали своему интеллекту слишком большую нагрузку, и теперь он не может связать даже двух самых простых мыслей. Мы спотыкаемся о препятствия, через которые раньше перенеслись бы с легкостью, и не видим пути дальше. Если же мы проигнорируем предостережение карты и все-таки попытаемся мучительно продолжать работу, это может низвергнуть нас в бездну отчаяния. Вместо этого нам нужно сделать наконец так давно необходимую паузу и отдаться радостям жизни, чтобы забыть о своих проблемах на какое-то время. Думать, что мы при этом *теряем драгоценное время*, есть величайший абсурд: мы потеряли бы больше времени, тащась в своем теперешнем состоянии по той беговой дорожке, которую после отдыха сможем пробежать с легкостью и радостью. Личные взаимоотношения Здесь эта карта означает изоляцию. Либо мы чувствуем себя брошенными, исключенными из числа участников Великих любовных игр, либо же ощущаем одиночество или истощение сил в союзе с прежним партнером. Тогда **Четверка Мечей** предупреждает, что мы рискуем потерять либо себя, либо партнера. Нам нужно срочно дать себе отдых, отбросив все проблемы, и привести себя в порядок, даже побаловать себя чем-нибудь.');
+INSERT INTO meaning_minor VALUES (312, 2, 5, 'straight', 3, 'Марс в Скорпионе, в своей самой "темной" форме, как жажда разрушения и низость.\n\n**Мифологический образ**: Арес, греческий бог войны, и его сестра Эрида, богиня спора. Или Стикс, "Леденящая", богиня ненависти.\n\n**Ицзин**: 6 Сун. Тяжба. \n\n**Пятерка Мечей**, пожалуй, самая *вредная* карта Младших Арканов Таро. Она означает обострение конфликта, мерзкий скандал, *удары ниже пояса*, низость и подлость. При этом карта не указывает, исходят ли все эти вредоносные действия от нас самих или, наоборот, мы оказались втянуты в эту неблаговидную историю в качестве жертвы. Об этом могут сообщить лишь остальные карты расклада. Однако в любом случае **Пятерка Мечей** означает *Пиррову победу*, которая недолго будет радовать победителя. Работа Здесь **Пятерка Мечей** знаменует собой трудный период, связанный с интригами, клеветой и самыми низкими оскорблениями, после чего обычно следует увольнение, а часто и драматическое продолжение в суде. Но карта может означать и какой-либо чувствительный удар — отклонение проекта, провал на экзаменах. Иногда это может быть и манера действовать крайне беспардонно, *хождение по трупам*  Сознание На уровне сознания она означает, что мы переживаем период агрессивного, деструктивного мышления, формы выражения которого — от раздражительности и жажды разрушения до человеконенавистничества и культа насилия. Иногда эти порывы обращаются против нас самих — в форме последовательного подрыва собственного здоровья или попыток самоубийства. Самая неприятная форма — *психология крестоносца*, считающего или выдающего свою разрушительную деятельность за *добродетель* или за служение некоему высшему делу. В области самопознания она может означать внезапный крах всех прежних идеалов и связанное с ним глубочайшее отчаяние — которое, однако, может стать началом пути к открытию Истины. Личные взаимоотношения Период бессердечия, ненависти, всяких подлостей и прямо-таки садистской жажды мести, когда партнеры наносят друг другу самые жестокие и безжалостные удары. Это происходит в союзах, которые либо фактически уже распались, либо им это вскоре предстоит. А поскольку столь разъяренные противники обычно не способны сами договориться друг с другом, то их примирению лучше всего может способствовать кто-то третий, лицо абсолютно нейтральное. Да и то лишь в том случае, если на это решатся оба партнера, потому что тот, кто считает себя *более сильным* (или более правым), часто с издевкой отвергает всякие попытки другого к примирению.');
+INSERT INTO meaning_minor VALUES (315, 2, 6, 'straight', 3, 'Марс в IV доме как прорыв на свободу из привычного окружения, и Меркурий в IX доме как поиски новых горизонтов.\n\n**Мифологический образ**: Путешествие Гильгамеша по Морю Смерти в поисках Травы Жизни. Переход народа Израилева через Чермное море.\n\n**Ицзин**: 3 Чжунь. Начальная трудность.\n\n**Шестерка Мечей** указывает на перемены, благодаря которым мы можем достичь новых берегов. Как карта *прорыва*, она находится как бы посередине между полным радости выступлением героя (Повозка, VII) и печальным прощанием (Восьмерка Кубков). Конкретное же ее значение в большой мере зависит от нас самих — от того, радуемся ли мы предстоящим переменам, ждем ли их, или, наоборот, опасаемся. В любом случае она символизирует путь к новому берегу, для чего, однако, придется покинуть старый. Что ждет нас впереди, неизвестно. Поэтому она означает также горечь прощания, неуверенность, опасения и заботы, но также определенное любопытство и интерес к тому, что будет. При этом нужно учитывать, что этот *новый берег*, ожидающий нас впереди, не обязательно означает физическое перемещене откуда-то куда-то; это может быть открытие чего-то нового в самом себе, усвоение новых правил игры, новых норм жизни, или знакомство с другими культурами и религиями. В книге Ицзин и в мифологии переход через воду всегда означает шаг от множественности к единичности. Работа "*Целина*  Это может быть как увольнение и переход на другую работу, так и новые задачи на прежнем месте. В любом случае карта показывает, что нам придется распроститься со знакомой деятельностью и познакомиться с новой, что обычно вселяет неуверенность, а то и страх. Была бы наша воля, мы предпочли бы хотя бы одной ногой оставаться на старом берегу до тех пор, пока не освоимся на новом. Однако карта указывает также, что расставание со старым есть обязательное условие, без которого новый берег нам не откроется. Этот переход всегда ощущается как некое *подвешенное* состояние, мандраж, страх провалиться на экзамене. Однако мы, как правило, всегда можем рассчитывать при этом на помощь других, по крайней мере, на первых порах. Сознание Постепенное, осторожное приближение к новым, прежде скорее чуждым для нас идеям и представлениям, обычно под чьим-либо мудрым руководством. Отказ от старых представлений и взглядов, *переходный период*, неуверенность первых шагов знакомства с новым — вот основной смысл этой карты. Личные взаимоотношения Перемены, как внешние, так, возможно, и чисто внутренние. Причем перемены могут быть любые. Например, расставание с прежним партнером и путь к новому берегу. Или, наоборот, расставание с прежними представлениями (например, о неизбежности собственного одиночества) и налаживание по-настоящему прочных отношений с партнером. Возможно и то, и другое вместе, то есть пересмотр своих взглядов и переход к новому этапу жизни с другим партнером. В любом случае нам придется расстаться со старыми, привычными нормами жизни, чтобы перейти к новым, нам еще не знакомым.');
+INSERT INTO meaning_minor VALUES (375, 3, 12, 'straight', 3, '**Венера/Луна** как символ задушевности и хорошего настроения.\n\n**Мифологический образ**: Зефир — мягкий, иногда ревнивый западный ветер, поднявший Психею и унесший ее в чертоги Амура.\n\n**Ицзин**: 11 Тай. Расцвет.\n\n**Рыцарь Кубков** означает добрую, дружескую атмосферу, хорошее настроение и мудрую улыбку. Это время медитации, ощущение гармонии, романтические мечтания и готовность влюбиться. Там, где царили раздоры и конфликты, эта карта провозвещает примирение и покой. Это и просто часы отдыха, время, когда мы даем волю своему воображению, радуемся красоте жизни, наслаждаемся искусством, особенно музыкой. Работа Хороший климат в коллективе, работа спокойная, без авралов, отчего у нас и настроение хорошее, и дело ладится *играючи*  При смене работы **Рыцарь Кубков** показывает, что мы намерены отдать предпочтение творческой, художественной стороне нашей натуры. Действительно ли мы решимся на такую смену работы, можно судить только по всему раскладу в целом. Рыцарь же Кубков указывает главным образом на наше отношение к работе, но практически ничего не говорит о ее содержании. Сознание Здесь эта карта указывает, что мы идем на поводу у своих чувств, создавая для себя иллюзорную картину мира. Испытав целый ряд приятных ощущений, мы преисполнились восторга и теперь смотрим на мир сквозь розовые очки. Карта может означать также интерес к миру образов, к сказкам, мифам и сновидениям. Однако в любом случае наш взгляд остается поверхностным. В глубину ведут лишь карты Жрицы (II) или Луны (XVIII). Личные взаимоотношения Главный смысл Рыцаря Кубков лежит именно в этой сфере. Здесь он означает период радости, влюбленности, ласки, уюта, словом — *весну*, начало партнерского союза. Или примирение, когда партнеры начинуют лучше понимать друг друга, когда им больше не нужны слова.');
+INSERT INTO meaning_minor VALUES (378, 3, 13, 'straight', 3, '**Луна в Рыбах** как символ тонкости чувств, готовности помогать другим и образного мышления.\n\n**Мифологический образ**: Прорицательницы Кассандра, Пифия и Сивилла, или темная Хозяйка Моря в средневековых мифах.\n\n**Королева Кубков**, олицетворяющая женский аспект стихии воды, означает тонкость чувств, эмпатию, образное мышление и готовность к самопожертвованию. Она символизирует целительную, укрепляющую силу, а также взгляд внутрь себя. Таким образом, она управляет неосознаваемыми силами души. Это — добрая фея и мудрая волшебница, живущая внутри нас, прорицательница, толкующая наши сновидения, ясновидящая, помогающая нам найти путь в тумане. Ее иногда называют *темной* картой или *картой тайны*, потому что истоки ее мудрости скрыты, и познать их при помощи рационального мышления не удается. Работа Здесь эта карта означает период покоя, ожидания, когда мы прислушиваемся к себе, чтобы уловить дальнейшее направление нашего профессионального развития. Или — превращение нашей способности мыслить образами в профессию: кино, телевидение, радио, писательство, журналистика, иногда эзотерика, оккультизм, очень часто — искусство, главным образом музыка. Сознание Наше сознание настроено на восприятие образов подсознания, отражающих как наши желания и предчувствия, так и наши страхи и кошмары. Эти образы могут стать источником вдохновения, столь необходимого в художественном творчестве. На более глубоком уровне карта может означать, что мы приближаемся к восстановлению целостности нашей *темной стороны*, которое описывает К.-Г. Юнг в своей книге *Символы мечтаний в процессе индивидуации*  Личные взаимоотношения Период тонкой, таинственной и чувственной близости, полного взаимного доверия и внимания друг к другу. Или — указание на нашу потребность в этом, на нужду в близком человеке.');
+INSERT INTO meaning_minor VALUES (278, 1, 8, 'reverted', 1, 'Не загадывай на будущее, говорит эта карта, пользуйся днем, по словам древних: *Молодость быстро летит, лови уходящее время *… Она не дает прямого ответа на заданный вами вопрос, а лишь указывает, что время для ответа еще не пришло.\n\nСейчас не этот вопрос — самый важный; лучше употребить силы на что-то другое.\n\nТо есть, в принципе, *да*, ответ положителен, но осуществится он не так скоро, как вы думаете, а через год-полтора.');
+INSERT INTO meaning_minor VALUES (384, 4, 1, 'straight', 3, 'Венера во II доме как шанс достичь счастья и богатства в своем внутреннем или во внешнем мире.\n\n**Мифологический образ**: Золотое Руно.\n\n**Ицзин**: 34 Да чжуан. Мощь великого.\n\n**Туз Денариев**, как и другие тузы, показывает шансы, скрытые в нас самих. Этот туз призывает нас заглянуть внутрь самих себя, оглядеться вокруг, чтобы найти еще не использованные возможности выхода из создавшейся ситуации, благополучного и удачного в смысле стихии земли, которую этот туз представляет, то есть в смысле не только материального, но прежде всего духовного богатства. Наряду с Тузом Кубков это — одна из самых лучших карт среди Младших Арканов. Однако символизируемая им удача не падает с неба, ее нужно отыскать. И вполне возможно, что поиски эти потребуют немалого труда, как поиски клада в винограднике. Однако результат не только оправдает все наши надежды, но еще и доставит нам ощущение глубокого счастья. Работа Здесь эта карта означает, что перед нами открывается великолепный шанс достичь вершины в своей профессии. Для кого-то это может означать высокие доходы и стабильное материальное положение, для кого-то — почет, уважение, славу, а для кого-то и просто внутреннее удовлетворение и сознание своей независимости от признания или непризнания другими, потому что дело, которому мы служим, составляет смысл нашей жизни. В любом случае эта карта означает прекрасные перспективы роста и возможность достичь поставленной цели. Сознание **Туз Денариев** и здесь означает шанс, открывающийся нам на пути познания, возможность получить важную и действительно нужную информацию. Это может быть некая идея, которая позволит нам решить казавшуюся неразрешимой проблему, или мысль, внезапно озаряющая путь к успеху, или же урок, полученный на одном уровне, но позволяющий многое понять на другом; рост самосознания или своего рода *открытие Америки* в себе самом, новый шаг на пути познания самого себя. Личные взаимоотношения Шанс создать прочный, глубоко душевный союз. Часто этот Туз выпадает как предвестник нового, ни с чем не сравнимого знакомаства. Но он может и указывать, что новые возможности открываются для нас в старом, давно привычном союзе, который теперь может стать настоящим благом для обоих партнеров.');
+INSERT INTO meaning_minor VALUES (257, 1, 1, 'reverted', 1, 'В данном случае **Туз Жезлов** не советует вам вмешиваться в события, потому что *буря и натиск* будут только вредны.\n\nТем более, что противостоят вам силы очень мощные, поэтому рисковать не стоит.\n\nВ обыденном плане для мужчин — проблемы с потенцией, для женщин — указание на отсутствие мужчины.');
+INSERT INTO meaning_minor VALUES (259, 1, 2, 'straight', 1, 'Странная ситуация!\n\nС одной стороны, вроде бы все благоприятствует вашему плану, с другой — большие сомнения, которые ведь не возникают на пустом месте.\n\nТак что подумайте, стоит ли браться за выполнение намеченного дела: дело-то получится, да не наступит ли потом разочарование?\n\nВпрочем, для лиц умственного труда эта карта означает лишь успех в результате долгой, упорной работы — написание диссертации, завершение книги, картины, пьесы и т.п.');
+INSERT INTO meaning_minor VALUES (260, 1, 2, 'reverted', 1, 'Откажитесь от своего замысла.\n\nПотому что усилий и времени придется затратить много, а результат сомнителен.');
+INSERT INTO meaning_minor VALUES (280, 1, 9, 'straight', 1, 'Вам только что пришлось выдержать трудный бой, и вы все еще живете им, все еще озираетесь в поисках врагов.\n\nВы не знаете или не хотите искать таких способов общения, которые позволили бы вам избежать дальнейших конфликтов.\n\nИ напрасно: можете уже не размахивать оружием, не ожидать нападения: его больше не будет.Пора заняться мирными делами, возможно, поработать наконец над собой, посвятить силы и время духовному развитию, говорит эта карта.');
+INSERT INTO meaning_minor VALUES (281, 1, 9, 'reverted', 1, 'Вам только что пришлось выдержать трудный бой, и вы все еще живете им, все еще озираетесь в поисках врагов.\n\nВы не знаете или не хотите искать таких способов общения, которые позволили бы вам избежать дальнейших конфликтов.\n\nНо эта *война* уже подходит к концу, и вам, возможно, придется отразить еще один удар, но он будет последним.');
+INSERT INTO meaning_minor VALUES (272, 1, 6, 'reverted', 1, 'Успех сверх всяких ожиданий, только позже, чем вы рассчитывали.\n\nИли, если вы пользовались нечестными средствами, то успех достанется не вам.');
+INSERT INTO meaning_minor VALUES (283, 1, 10, 'straight', 1, 'Эта карта означает человека, взявшего на себя слишком много — ответственность, которая ему не по силам, или обязательства, которых он не в состоянии выполнить.\n\nВместо того, чтобы шагать дальше с этой ношей, ему нужно остановиться, сбросить хотя бы часть ее и оглядеться: куда он зашел и стоит ли тащить весь этот груз дальше?\n\nЭта карта часто выпадает бизнесменам или *трудоголикам*, работающим по 25 часов в сутки.\n\nТогда она предупреждает, что человек немедленно должен дать себе отдых, иначе его ожидает расстройство здоровья или неудача во всех делах. Как прогноз, она означает, что за планируемое дело лучше не браться, потому что сейчас оно *неподъемно*');
+INSERT INTO meaning_minor VALUES (286, 1, 11, 'straight', 1, 'Эта карта может означать человека, склонного преувеличивать свои возможности и обещать больше, чем он может сделать.\n\nЕсли же вас интересует ситуация, то это — *вызов*, испытание, проверка на прочность.\n\nНо дело хорошее, главное тут — проявить стойкость.');
+INSERT INTO meaning_minor VALUES (275, 1, 7, 'reverted', 1, 'Не затевайте драку и ради Бога не вмешивайтесь в ход событий: побьют.');
+INSERT INTO meaning_minor VALUES (317, 2, 7, 'reverted', 1, 'Проблему-то вы оцениваете верно, да только ваш способ решения не годится.\n\nРаньше годился, да, но теперь ситуация иная, проблема новая. Значит, нужен новый способ.\n\nА его еще надо найти. Поэтому сейчас вам лучше всего не браться за эту проблему. Старый способ принесет вам неудачу.');
+INSERT INTO meaning_minor VALUES (408, 4, 9, 'straight', 3, '**Юпитер/Венера в V доме** как символ крупного выигрыша.\n\n**Мифологический образ**: Неожиданно большой улов апостола Петра.\n\n**Ицзин**: 42 И. Приумножение. \n\n**Девятку Денарие**в еще в старину считали картой финансового успеха, выигрыша, крупной и часто неожиданной прибыли. Таким образом, она означает неожиданный поворот к лучшему, везение и такие ситуации, о которых в народе говорят: *счастье привалило*  Наряду с этим, несомненно благоприятным значением внешней удачи она означает также ощутимую перемену личности (в лучшую сторону).');
+INSERT INTO meaning_minor VALUES (357, 3, 6, 'straight', 3, '**Луна в Рыбах** как символ щемящих, ностальгических воспоминаний, или Луна в Раке как символ романтической мечтательности.\n\n**Мифологический образ**: Дочь титана Мнемозина, богиня воспоминаний, возлюбленная Зевса и мать девяти муз. \n\nЭта карта уводит нас в страну воспоминаний. Она показывает, что прошлое еще живо в нас, что мы возвращаемся к нему как к романтическим мечтаниям или как к щемяще-ностальгическим переживаниям. Такой отрешенно-сентиментальный взгляд на вещи может как прояснить и осветлить картину мира, так и замутнить, омрачить ее. И, поскольку карта так или иначе связана с прошлым, при соответственном вопросе она может указывать на какие-то эпизоды или впечатления нашего детства. Работа **Шестерка Кубков** говорит о том, что свою работу мы воспринимаем скорее романтически, чем прагматически, и нас увлекает именно ее идеальное представление о ней, а не повседневная рутина. В творческих профессиях это даже плюс; однако там, где требуется аккуратность и организованность, такой подход только мешает и нам, и тем, кто работает вместе с нами. Если нам грозит потеря работы, заказов или перепрофилирование, то эта карта указывает на возврат к детским желаниям, когда нам хотелось стать тем-то и тем-то, когда вырастем. И, в зависимости от того, насколько эти наши детские желания успели осуществиться, она олицетворяет либо грусть и безысходность, либо радость и прилив новых сил. Если у нас просто трудности в работе или на работе, то она может означать, что нам хочется вернуться в те времена (или на ту работу), когда нам все удавалось. Сознание Мы оглядываемся назад, чаще всего на детство, или предаемся *блужданию ума*, как говорят буддисты, то есть часто (и обычно с горечью) думаем о чем-то. Образы, появляющиеся при этом, могут воплощаться в стихах, картинах и других произведениях искусства. Но они могут вызвать и желание уйти от действительности, и просто демонстративное дурачество. Вот как пишет об этом Филипп Метман: *Тот, кто пытается осуществить свои детские надежды во взрослой жизни, никогда не достигнет зрелости. Однако тот, кто подавляет их, не давая воплотиться или обрести новое качество, сразу становится стариком* . Личные взаимоотношения Здесь эта карта чаще всего означает теплоту и доброту, характерные для периода сказочной влюбленности. Иногда — воспоминания о таком периоде. Именно в этой сфере наиболее сильно выражается мечтательная, подчас слащаво-сентиментальная сторона этой карты, хотя в ней есть и поэзия, и подлинная романтика.');
+INSERT INTO meaning_minor VALUES (360, 3, 7, 'straight', 3, '**Нептун** как символ не-истины, иллюзии, бегства от действительности.\n\n**Мифологический образ**: Ата, греческая богиня (мгновенного) безумия.\n\nЛотофаги ("пожиратели лотосов"), угощение которых привело спутников Одиссея в "Страну забвения"  Семерка Кубков — это карта не-истины, иллюзии, миража, призраков.\n\nОна показывает, что мы поддались обманчивым надеждам, ложным представлениям, что мы обманываемся и позволяем обманывать себя. Как правило, она выступает предвестницей отрезвления, то есть разочарования, и ее следует воспринимать как последнее предупреждение: открой глаза! Впрочем, у нее есть и положительный аспект: если мы осознаем свои заблуждения, освобождаемся от иллюзий и действительно хотим посвятить себя чему-то реальному, она обещает нам помощь и поддержку со стороны тех, от кого мы этого не ожидали. Работа В плане работы эта карта показывает, что в последнее время мы занимаемся строительством воздушных замков, строим себе иллюзии и вообще вступаем на зыбкую и опасную почву пустого прожектерства и буратиньего легковерия. И, если мы не взглянем на вещи трезво, не умерим свои претензии и не ограничимся тем, что и в самом деле осуществимо, нас ожидает катастрофа. Карта предостерегает от липовых сделок, от ложных целей, а в некоторых случаях и от участия в грязных делах и подлых интригах. Кроме того, она может свидетельствовать, что у нас на работе процветают приписки, обман и халтура, и призывать нас сохранять трезвость и чистоту рук. Сознание Здесь эта карта олицетворяет период несбыточных мечтаний и взгляда на все сквозь розовые очки. Если мы поймем, что это все иллюзии, то подобная эйфория может закончиться сама собой без болезненных последствий. Если же мы будем принимать эти мечты за действительность, поверим в них, то пробуждение может оказаться очень горьким. В своем самом тяжелом варианте **Семерка Кубков** может означать бегство от действительности, прыжок очертя голову в фантастические миры, разрушение привычных структур и, как результат, строительство новых, иллюзорных структур, некоей иррациональной, но необычайно сладостной для нас реальности… которая рано или поздно лопнет, как мыльный пузырь. Личные взаимоотношения В этой сфере **Семерка Кубков** может олицетворять эйфорию влюбленных. Однако она предостерегает, что пробуждение может оказаться болезненным. Дело тут не в том, что предмет нашего обожания недостоин такового, а скорее в том, что мы сами предъявляем к нему непомерно завышенные требования — и сами же будем виновны в своем разочаровании. Она советует также не убаюкивать свой рассудок сладостными мечтами, а отнестись со здоровым скепсисом к своим партнерским отношениям.');
+INSERT INTO meaning_minor VALUES (363, 3, 8, 'straight', 3, '**Сатурн/Луна** как символ печального прощания.\n\n**Мифологический образ**: Лот, покидающий свой родной город Содом.\n\n**Ицзин**: 56 Люй. Странствие.\n\n**Восьмерка Кубков** — это одна из трех карт *прорыва*, означающая печальное прощание . Она показывает, что мы должны покинуть привычное окружение, расстаться с людьми или вещами, к которым *прикипели сердцем*, и отправиться в далекий путь, в неведомое будущее. В любом случае она свидетельствует, что нас не изгнали , это мы сами, по своей воле решили уйти (другое дело, что у нас могло не быть иного выхода). *Тяжесть прощания* заключается в двух моментах: мы должны покинуть то, что нам дорого, и не знаем, что нас ожидает. Работа Чаще всего это — расставание с прежней работой. Это может быть вообще отход от активной деятельности — по возрасту, в результате решения посвятить себя семье, или из-за свертывания данной отрасли, или по иным подобным причинам. Боль из-за расставания с привычным, давно знакомым, сочетается здесь с чувством неуверенности в завтрашнем дне. Впрочем, эта карта может указывать и на менее глубокие изменения в нашей жизни: вашу работу передают другому или вообще закрывают как неперспективную. Однако и в этом случае она означает опустошенность и неуверенность: что же нам делать дальше, куда поведет нас судьба? Сознание Здесь эта карта означает осознание того, что наши прежние взгляды, привычки или какие-то элементы мировоззрения больше не служат нам верой и правдой, и от них нужно избавляться. Иногда это бывает связано с переходом в следующую возрастную группу, когда нам приходится отказываться от многого, — тот самый *великий отказ* в философии дзен. Такое *расставание* с вещами, столь много для нас значившими, часто сопровождается чувством страха и безысходности. Но эти шаги, хоть они и трудны, ведут к новой свободе. Личные взаимоотношения **Восьмерка Кубков** — это расставание с человеком или людьми, значившими для нас до сих пор очень много. Эта карта обозначает перепутье, развилку, где наши с ними пути разделяются, указывая, что нам пора с благодарностью проститься с ними и, если надо, порвать ту *пуповину*, которая нас с ними связывала, чтобы двинуться своим путем. На более глубоком уровне она может означать расставание с прежними представлениями о партнере как сказочном принце или принцессе, осознание того, что идеальных партнеров не бывает, отказ от наивных мечтаний и возвращение к реальности, которая отнюдь не торопится раскрывать перед нами свой секрет, как приблизить партнера к идеалу.');
+INSERT INTO meaning_minor VALUES (399, 4, 6, 'straight', 3, 'Юпитер в Рыбах как готовность прийти на помощь, Юпитер во Льве как великодушие, Юпитер в Водолее как терпимость.\n\n**Мифологический образ**: Прометей — даритель огня, друг и помощник живущим во тьме.\n\n**Ицзин**: 27 И. Питание. \n\n**Шестерка Денариев** обозначает такие качества, как готовность прийти на помощь, великодушие и терпимость. Она может указывать, что мы сами обладаем этими качествами, или что кто-то проявит их по отношению к нам. И речь здесь идет не о минутном порыве или показном благочестии, а об имманентных чертах характера, естественных и постоянных. На практическом уровне эта карта может означать награду, успех, перспективный проект. Работа **Шестерка Денариев** говорит о том, что мы взялись за стоящее дело, в котором заинтересованы и другие, и мы получим от них помощь и поддержку. Наши желания, идеи и планы отвечают чаяниям других, а потому дают и нам возможность реализовать свой творческий потенциал. Кроме того, эта карта указывает, что мы и сами должны быть готовы великодушно оказать другим и поддержку, и помощь. Сознание Здесь эта карта показывает, что мы можем найти достойное применение своим знаниям и способностям, не скрывая и не придерживая их *про запас* из страха, что другие могут оказаться умнее нас или украдут наши идеи. Эта карта говорит, что мы не только готовы, но и рады помощь обращающимся к нам людям — или, наоборот, что нам не откажут в помощи и поддержке. Личные взаимоотношения В плане личных отношений **Шестерка Денариев** также указывает на взаимопомощь и поддержку. Она означает, что мы признаем за партнером право жить по его правде, что мы всегда готовы проявить понимание и терпимость. Партнеры поддерживают один другого во всем, искренне деля и радости, и горести, *подставляя плечо* в трудных ситуациях и не завидуя, а радуясь успеху и удаче партнера.');
+INSERT INTO meaning_minor VALUES (303, 2, 2, 'straight', 3, 'Луна в Близнецах как выражение глубоких внутренних сомнений.\n\n**Мифологический образ**: Фома Неверующий.\n\n**Ицзин**: 38 Куй. Разлад. \n\nЭта карта указывает на ситуации, в которых человека охватывают сильные, буквально гложущие его сомнения. Иногда это — отчаянная попытка напрячь весь свой интеллект, чтобы выработать позицию и найти путь к решению. Однако подлинная убежденность возникает, лишь когда мы осознаем проблему и на уровне чувств. А эта область подсознания (на карте — символы моря и Луны) оказалась отрезана от всего остального. Скрещенные мечи — это границы интеллекта, закрывающие ему доступ к ней. Таким образом, карта олицетворяет то неприятное положение, в которое мы попадаем, когда слишком надеемся на логику, отказываясь прислушаться к своему внутреннему голосу. **Двойка Мечей** составляет противоположность Жрицы (II), о ее сродстве с которой напоминает сидячее положение обеих. Голубые тона, олицетворяющие интуицию Жрицы, здесь оттеснены на задний план. Женская фигура в сером, на серой же опоре, возможно, символизирует бесцветность мысли, пустые рассуждения, — ту самую *сухую теорию*, о которой писал Гете. В сказках символом такой *всеразъедающей* силы сомнения часто служит серый волк. Работа Мы дошли до точки, в которой логика больше не помогает нам выбрать дальнейший способ действий, однако мы упорно не решаемся положиться на интуицию. Обдумать *головой* детали, конечно, не помешает, однако если мы по-прежнему будем подавлять свои чувства, то никогда не найдем кардинального решения проблемы. Сознание Карта говорит о прекрасных аналитических способностях. Это — карта интеллекта, способного постепенно, по частям разобраться в самых запутанных проблемах. Опасность лишь в том, что в конце такого анализа дальнейший путь нам может преградить гора этих самых мелких частей, из которых мы не сумели сложить нового целого. Пока путь к морю, символизирующему целостность, остается закрыт, мы можем строить лишь все более головоломные умозрительные конструкции, возможно, даже блестящие, однако никогда не достигнем мира с самими собой. Чем строже будет наша логика, тем логичнее мы придем к выводу, сделанному еще великим скептиком Декартом: *Все, что лишь вероятно, вероятнее всего ложно*  Личные взаимоотношения Внутренний разлад, скепсис, нежелание или неспособность сделать выбор, принять окончательное решение. Состояние, близкое к критическому, потому что союз двух партнеров основывается на чувствах — любви, близости и привязанности, а яд сомнения подрывает эти основы. Единственным спасением в такой ситуации часто оказывается резкий разрыв. Но этим средством можно воспользоваться лишь как крайней мерой: привыкать к нему ни в коем случае нельзя.');
+INSERT INTO meaning_minor VALUES (321, 2, 8, 'straight', 3, '**Сатурн в IV доме** как символ внутренних барьеров.\n\n**Мифологический образ**: Даная, заточенная в башню, чтобы она не могла зачать сына, однако Зевс проник к ней в виде золотого дождя — и она родила Персея.\n\n**Ицзин**: 39 Цзянь. Препятствие. \n\n**Восьмерка Мечей** показывает, что мы сами подавляем какую-то часть своей личности. Часто это внутренние барьеры или запреты, которые мы обычно ставим себе сами, однако предпочитаем находить их причину в окружающей среде. Такая типичная позиция *Да, но…*, как ее описывает Реверенд Айк : *Я бы с удовольствием сделал то-то и то-то, но…*, *Мне бы очень хотелось иметь то-то и то-то, но…*, *Я был бы рад стать тем-то и тем-то, но…* При этом единственным препятствием на пути нашего я к этим прекрасным вещам оказывается на самом деле лишь наше собственное *но*  Эта карта показывает нам, что те ограничения, трудности и запреты, которые мы ощущаем как помеху, не принадлежат внешнему миру, а отражают лишь наши собственные страхи и барьеры. В любом случае **Восьмерка Мечей** предупреждает, что мы подавляем что-то сами в себе. Хотя иногда речь может идти лишь о временном отказе от чего-либо или о добровольном ограничении, основанном на вполне достоверной информации. Работа Наша работа не позволяет раскрыться каким-то сторонам нашей личности, поэтому нам приходится подавлять их. Если мы знаем, что это только временно, что мы в силу обстоятельств вынуждены сейчас пойти на такое ограничение, то с ним можно согласиться. Если же карта указывает на положение, которому конца не видно, то ее следует воспринимать как совет срочно предоставить себе внутреннюю свободу, а в крайнем случае и сменить работу. Сознание На этом уровне у карты может быть два значения. Она может означать ледяную тиранию рассудка, с помощью которого мы подавляем важнейшие порывы и чувства, полагая, что это и значит *держать под контролем* свои желания, влечения и мечты. Или — осознание этой тирании, а тем самым и первый шаг к освобождению своего внутреннего узника. Личные взаимоотношения Здесь это тоже главным образом барьеры. Мы скрываем какие-то стороны своей личности, потому что боимся предъявить их партнеру. Или же эта карта описывает союз, в котором какая-то часть личности не получает полного раскрытия — опять-таки потому, что мы боимся неодобрения или иной негативной реакции со стороны партнера. В любом случае карта рекомендует изменить это положение как можно скорее, потому что подавление какой-то части нашего *Я* рано или поздно неизбежно приведет к распаду союза. Впрочем, карта может описывать и ощущение одиночества, когда мы живем без партнера. Тогда она означает нашу внутреннюю неготовность открыться другому человеку, заранее принять все радости и горести, с этим связанные, и советует для начала создать в себе все необходимые для этого предпосылки, и тогда встреча с ним произойдет сама собой.');
+INSERT INTO meaning_minor VALUES (330, 2, 11, 'straight', 3, 'Марс в III доме или в напряженном аспекте с Меркурием: семена раздора, конфликта.\n\n**Мифологический образ**: Золотое яблоко, которое бросила незваная богиня Эрида (Гадер) гостям на свадьбе Пелея и Фетиды, вызвав тем самым Троянскую войну.\n\n**Валет Мечей** так же, как и другие валеты, олицетворяет некий шанс или возможность, которые нам открываются, внешний движущий импульс.\n\nВалет масти Мечей, таким образом, часто означает разъяснение, прояснение ситуации, некую свежую струю, благодаря которой нам вдруг открывается истинный смысл происходящего, и даже самое запутенное дело становится простым и понятным. Чаще всего это, однако, происходит через конфликт, скандал или резкий разговор, на который Валет Мечей также может указывать. Поэтому его следует воспринимать как предостережение, готовиться к неизбежной драке или же не оттягивать ее, чтобы конфликт послужил наконец к прояснению сути дела. Работа В связи с работой нам придется готовиться к конфликтам, разборкам, напряженности, возможно, к резкой полемике. Карта может означать и выговор от начальства (несправедливый), и возникновение соперничества между коллегами, или разногласия с деловым партнером. Или чувство опасности в связи с рискованным заданием. Поэтому, в принципе, она может означать и начало конца нашей теперешней деятельности, хотя это может быть и некая *свежая струя*, да пусть даже ураган, который освежит атмосферу у вас на работе и внесет ясность в создавшуюся ситуацию. Сознание Мы можем оказаться втянуты в споры, полемику, ожесточенные дискуссии, которые сильно поколеблют наши прежние убеждения и взгляды, короче — нас будут критиковать. Станет ли это для нас жестоким ударом или полезным уроком, поводом пересмотреть свои взгляды, зависит от нашей готовности учиться на своих ошибках. Но выяснить это можно, лишь проверив другие карты расклада. Личные взаимоотношения Здесь Валет Мечей означает конфликт, инициатором которого станет ваш партнер, или же обострение давних разногласий, угрожающее разрушить ваш союз. Это может выражаться во внезапном охлаждении партнеров, еще недавно так любивших друг друга, в потоке упреков, в разочаровании, копившемся долгое время, в выплескивании стародавних обид или просто каких-то житейских мелочей, достигших некоей *критической массы*  Ясно, что подобный всплеск накопившихся эмоций может сильно обострить ситуацию и даже привести к разрыву. Однако Валет Мечей может означать и возможность заново укрепить союз посредством очищающего конфликта.');
+INSERT INTO meaning_minor VALUES (333, 2, 12, 'straight', 3, '**Сатурн/Венера** как символ холодности и строгости в отношениях и контактах, или Марс/Меркурий как олицетворение острого ума и острых дискуссий.\n\n**Мифологический образ**: Борей, ледяной северный ветер, холодная, но плодотворная сила.\n\n**Рыцарь Мечей** — это всегда нечто холодное, острое, коварное, несущее раздоры и споры, способное отравить даже те сферы жизни, которые до сих пор служили вам надежным, уютным убежищем.\n\nСимволизируемый им холод может, конечно, разгонать туман и вывести на свет Божий все, представлявшееся до сих пор неясным; однако чаще всего в этой карте стихия воздуха оборачивается к нам своей негативной стороной. Это означает холод отчуждения, острые дискуссии, резкие и дерзкие выпады, злой язык и едкую иронию. Поэтому **Рыцарь Мечей** часто предвещает ссору, разрыв отношений, яростную полемику и тщательно спланированную подлость. Работа Здесь он часто означает охлаждение отношений между коллегами, усиление розни и неприязни. Это — период напряженности, когда мы становимся слишком придирчивы к другим или, наоборот, сами подвергаемся критике. Иногда такая напряженность разрешается через очищающий, снимающий противоречия конфликт. Но чаще раны, наносимые и получаемые нами, бывают глубоки и долго не заживают. Эта карта олицетсоряет *мороз*, от которого лопается самый прочный фундамент. Это может означать крах проектов, планов и других деловых начинаний; при этом прекращается сотрудничество, обещавшее быть весьма плодотворным. В более *мягком* варианте — охлаждение первоначального энтузиазма. Уходит прежняя увлеченность, рушатся воздушные замки, и их место заступает здоровый скепсис и трезвая (само-) критика. Сознание **Рыцарь Мечей** означает заметное охлаждение по отношению к прежним увлечениям, усиление критики или, возможно, самокритики. Нам удалось наконец взглянуть на самих себя или на окружающее *со стороны*, и это вызвало у нас раздражение и повышенную склонность к конфликтам, для нас совершенно неожиданную. Нас обуревают сомнения, и мы начинаем относиться с насмешкой и презрением к тем ценностям, которые прежде были для нас дороже всего. Здесь эти *заморозки* тоже могут означать просветление, трезвую переоценку наших прежних, слишком поспешно усвоенных или просто *модных* представлений. Личные взаимоотношения В сфере личных отношений эта карта часто оборачивается к нам своей самой болезненной стороной. Здесь она означает не только внезапное охлаждение прежних теплых чувств, но и превращение их в свою противоположность — злость, ненависть или издевку. Она показывает, что даже глубокие чувства могут пасть жертвой пренебрежительных замечаний и язвительных острот, когда человек, серьезно считая себя *справедливым* и *объективным*, вытаскивает эти чувства из их укромного уголка на свет Божий, и они бьются, точно пойманная на крючок рыба, задыхаясь и умирая, иронизировать над чем может лишь человек абсолютно бессердечный.');
+INSERT INTO meaning_minor VALUES (336, 2, 13, 'straight', 3, '**Солнце в Водолее** как символ независимости, индивидуальности и эмпирической мудрости.\n\n**Мифологический образ**: Быстроногая Аталанта, убегавшая ото всех женихов до тех пор, пока не споткнулась о собственное любопытство. Невеста Ветра у германцев, за которой гонится бог Вотан со своей Дикой Охотой.\n\n**Королева Мечей** — это женское проявление стихии воздуха. Она олицетворяет ум, открытость, независимость, фантазию и сообразительность. Это — выражение нашей способности решать проблемы при помощи логики, восходить на все более высокие ступени познания, не теряясь перед наплывом идей и сомнений. В отличие от прежнего представления о Королеве Мечей как *злой женщине*, в лучшем случае — вдове, сейчас мы видим в ней женщину (или женское начало в мужчине), освобождающую себя от зависимости благодаря силе своего разума. Лишь в некоторых случаях нам открывается негативная сторона этой карты: *снежная королева*, за внешней привлекательностью которой скрывается холодный расчет и почти непреодолимое отчуждение. Работа Здесь **Королева Мечей** указывает на готовность учиться и с открытыми глазами воспринимать все новое, на обдуманную и точную реакцию и на умное, независимое и гибкое поведение в деловых переговорах. Кроме того, она показывает, что мы слишком дорожим своей свободой, чтобы жертвовать ею ради престижной или высокооплачиваемой работы, и немедленно уйдем, если ее условия перестанут удовлетворять нас, или нам предложат нечто, нас больше устраивающее. Сознание На уровне сознания эта карта прежде всего говорит о богатстве идей, о творческой любознательности и готовности учиться новому, однако в то же время о силе и ясности разума, позволяющих нам осознать свою зависимость от кого или чего бы то ни было — и освободиться. Таким образом, эта карта всегда указывает на некий важный процесс, свидетельствующий о пробуждении сознания и начале истинного познания самого себя. Личные взаимоотношения В этой сфере **Королева Мечей** тоже означает осознание нами самих себя и смысла наших взаимоотношений с другим человеком. Чаще всего это выражается в попытке взглянуть на эти отношения как бы *со стороны*, чтобы разобраться в них и сформулировать (по крайней мере для себя) все, что нас не устраивает или беспокоит. Это — период, когда мы особенно нуждаемся в равенстве с партнером и, возможно, начинаем выступать более решительно благодаря обретению внутренней свободы. Так или иначе, карта показывает, что мы не станем больше терпеть некоторые вещи; в отдельных случаях этот протест принимает форму ъолодности, тонкой или, возможно даже, едкой иронии.');
+INSERT INTO meaning_minor VALUES (351, 3, 4, 'straight', 3, '**Марс в Раке** как символ досады и обиды.\n\n**Мифологический образ**: Угрюмый Пелид Ахиллес.\n\nНастроение этой карты лучше всего выражается понятиями "пресыщение", "перебор"  Тем самым она напоминает о непостоянстве наших чувств: мы всеми силами души желаем чего-то, однако достигнув этого (и даже больше того, чем желали), вдруг понимаем, что нам это не нужно, и нас охватывают уныние, обида и отвращение.\n\nЭто может выражаться в брюзжании, равнодушии или, наоборот, раздражении, отчаянии и стремлении все разрушить. Так или иначе, эта карта служит предостережением: не давайте своему дурному настроению перерасти в апатию, чтобы не упустить нового благоприятного шанса и не отвергать руку помощи. Работа **Четверка Кубков** показывает, что работа окончательно утратила для нас свою привлекательность, и нас все сильнее охватывают уныние, злость и обида. Она может также означать *отупение* от монотонной работы, желание *забастовать* и уязвленное самолюбие — например, после полученного выговора или отказа. В таких ситуациях мы должны рассматривать эту карту как совет и предупреждение: не терять времени на бесплодное нытье, чтобы не упустить нового, уже очень близкого шанса. Сознание Здесь у Четверки Кубков широкий спектр значений: это и бесплодная рефлексия (*блуждание ума*), и апатичная леность, и кризис отчаяния из-за *бездарно прожитой жизни*  Если речь идет о легких формах уныния, как-то леность и нежелание заниматься чем-либо, то карта означает, что в самом скором времени в нашу жизнь ворвется свежий ветер перемен. Если же это действительно глубокий кризис сознания, то ее следует воспринимать как сигнал тревоги и совет немедленно заняться чем-то, что способно вывести нас из апатии и вернуть в русло нормальной жизни. Например, спортом или гимнастикой, которые помогают освободиться от внутреннего напряжения и иного *негатива *: хорошая физическая форма придаст нам сил для решения проблем, мешающих радоваться жизни. Личные взаимоотношения Здесь эта карта означает тяжелую, если не сказать отравленную атмосферу. Она показывает, что мы ослеплены ревностью или лелеем свою *обиду*, наказывая партнера молчанием или полностью игнорируя его. Обычно в таком настроении мы не замечаем тех жестов доброй воли и шагов к примирению, которые предпринимает партнер. Карта советует как можно скорее преодолеть эту свою апатию и пойти навстречу его *мирным инициативам*  Если оба партнера настолько упрямы, что дело дошло у них уже до *позиционной войны*, то им можно предложить сыграть в Игру или разложить Гордиев узел; это, конечно, может вылиться в грозу, но после грозы небо, как известно, светлеет.');
+INSERT INTO meaning_minor VALUES (381, 3, 14, 'straight', 3, '**Солнце в Рыбах** как символ тонкости чувств, интуитивного знания и готовности помогать другим.\n\n**Мифологический образ**: ""Морской Старец", обладатель множества имен, у греков — бог мудрости Нерей, Протей или Форкий, ускользающий ото всех, меняя облик; но тому, кто сумел поймать его, он предсказывает его дальнейшую судьбу.\n\n**Король Кубков** олицетворяет мужской аспект стихии воды, наше стремление к приобретению трансцендентного опыта, к освобождению от пут сознания и мистическому единению с первоначалом, с Космосом (или возьмите любое другое обозначение того, для чего нет слов в наших языках).\n\nОн знает, что эти сферы закрыты для рационального познания, и что проникнуть в них может лишь тот, кто научился полагаться на свою интуицию и готов довериться Космосу и его течениям. Кроме этого, **Король Кубков** означает потребность выразить свои чувства, дать волю интуиции, воплотить образы нашего подсознания в музыке, стихах или иной вещественной форме, в том числе и в форме целительства и психотерапии. Однако если этот процесс воплощения скатывается в дилетантизм, то есть в незнание основ дела или в пренебрежении ими, **Король Кубков** превращается в *гуру с заднего двора*, в жалкого проповедника чужих ценностей, а то и просто в шарлатана; такое *второгодническое* непонимание основных закономерностей бытия делает его игрушкой космических сил, и он рано или поздно оказывается жертвой чужих интриг. Работа Период, когда мы чувствуем, что, кроме чисто ремесленных навыков, нам в работе требуется душа. На практике это выражается в том, что заданные рамки кажутся тесны нам, мы вносим собственные идеи и ломаем шаблоны, — даже если это выражается только в том, что мы начинаем острить по поводу порученного задания. Эта карта может означать также, что мы хотим уйти с этой работы или вообще сменить род занятий, начать делать то, что даст нашим способностям возможность проявиться по-настоящему. Это касается в яервую очередь профессий, связанных с искусством, целительством и социальной реабилитацией. Сознание В области сознания **Король Кубков** означает прежде всего наше обращение (возвращение?) к своему внутреннему миру, работу с собственным подсознанием. Мы как бы *пробуждаемся*, тренируем свои экстрасенсорные способности и окончательно определяем, какое именно место занимаем в общей структуре Космоса. Эта карта может также служить указанием, что мы наконец доверились нашему внутреннему миру и всерьез занялись анализом собственных снов, древних мифов или образов коллективного бессознательного. Личные взаимоотношения В личном плане это время расцвета чувств, когда мы более чем когда-либо понимаем, какие влечения, сомнения и страхи мучают нашего партнера, и чем мы можем ему помочь как самому близкому существу на свете. Таким образом, **Король Кубков** чаще всего означает благоприятный, *романтический* период отношений, когда мы не стесняемся выражать свои чувства и готовы понять и принять чувства партнера, хотя иногда это может показаться слишком сентиментальным.');
+INSERT INTO meaning_minor VALUES (387, 4, 2, 'straight', 3, 'Луна на асценденте как признак высокой адаптивности, хорошей приспособляемости, а также Луна/Марс, капризность.\n\n**Мифологический образ**: Гермес, одна из ролей которого — покровитель шарлатанов и игроков.\n\n**Двойка Денариев** — это карта игрока, но также карта человека, которому безразлично, какое решение принять.\n\nОна показывает, что мы умеем приспосабливаться к превратностям жизни и переносим ее взлеты и падения без лишних волнений. В зависимости от того, с каким вопросом или с какой областью жизни связана эта карта, она может означать либо ловкость, коммуникабельность, веселость, спонтанность и нетривиальность, либо же отсутствие собственной точки зрения, соглашательство, капризность и привычку подхалимничать или подлизываться. Таким образом, она охватывает обширный спектр качеств от полного безволия до мудрости королевского Шута. Работа Здесь двойка мечей укахывает на гибкость и высокую приспособляемость — к полученному заданию, к условиям труда, к коллективу. Она может означать также, что мы не слишком серьезно, если не сказать легкомысленно, относимся к делу. В последнем случае карта может указывать на безответственность и полное небрежение порученным делом. В своем же высоком смысле она служит признаком освобождения от унылой *трудовой повинности*, рутины, от мелочной заботы о куске хлеба, благодаря чему мы можем наконец с радостью посвятить себя любимому делу. Сознание В плане сознания мы оказались, так сказать, в состоянии невесомости. Если, как пишет Милан Кундера , это состояние приходит на смену ощущению гнетущей нас к земле тяжести, то это — величайшее облегчение. Если же оно приобретает затяжной характер, то может привести к глубоким кризисам. Тем самым эта карта может означать огромное облегчение после периода жизненных неудач и бед — или же непреодолимый инфантилизм, не позволяющий человеку выработать собственную точку зрения, причем он сам искренне жалуется, что его *никто не понимает*  С одной стороны, это символ *твари дрожащей*, не способной ни на что решиться, с другой — символ смиренной мудрости Шута, прошедшего долгий путь познания и вернувшегося наконец к простоте и непредвзятости мировосприятия ребенка. Личные взаимоотношения Прекрасный период беззаботной, ничем не омраченной радости — или же период инфантильного легкомыслия и капризов. Которая из этих сторон карты проявится сильнее, зависит от наших собственных установок и ожиданий — ведь и *капризность* можно рассматривать как простодушную нерешительность, привлекательную именно в силу своей незащищенности.');
+INSERT INTO meaning_minor VALUES (390, 4, 3, 'straight', 3, '**Юпитер/Марс** как символ творческого успеха; или же революция Сатурна, т.е. возврат его к своей точке в натальной карте, как признак начала нового периода жизни.\n\n**Мифологический образ**: Геракл, с каждым новым из своих двенадцати подвигов проникающий все глубже в тайны жизни.\n\n**Ицзин**: 19 Линь. Посещение. \n\nИз-за черного фона Тройку Денариев часто считают *несчастливой* картой. Однако она, наоборот, означает успешно выдержанный экзамен, то есть необходимый и полезный опыт. В отличие от Восьмерки Денариев, олицетворяющей Ученика, то есть начинающего, Тройка — это уже Подмастерье. Тем самым она показывает, что мы уже взошли на новую ступень познания, характеризуемую не одним только желанием учиться, но и некоторым накопленным опытом. Это — успешное окончание периода учебы или работы, переход в следующий разряд. Работа **Тройка Денариев** здесь более, чем какая-либо иная из карт Таро указывает на повышение квалификации. Она означает, что мы окончили очередной курс (или стадию развития) и можем теперь взять на себя большую ответственность или приступить к новым задачам. Еще она может означать испытание, экзамен, в котором для нас важна не отметка, а признание нашей квалификации и связанное с этим возможное повышение статуса. В любом случае она показывает, что мы будем пока продолжать работать по данной специальности, и что смена рода занятий у нас пока не предвидится. Сознание Тут становятся понятны темные цвета карты, потому что она означает путь к тайне Посвящения. Она свидетельствует о нашем духовном росте, когда количество накопленных знаний переходит в качество, и мы находим наконец свой путь к истине. Это — порог, за которым поиск Многого сменяется поиском Единственного, когда мы погружаемся в темные глубины, вместо того чтобы распространяться вширь по поверхности. Личные взаимоотношения В личном плане **Тройка Денариев** показывает, что нам предстоит сделать решающий шаг, который позволит перейти к более зрелым формам союза с партнером. Это может означать окончание периода поисков и начало поитоянного, действительно теплого и душевного союза. Если такой союз уже есть, карта означает, что первоначальные трудности или бывшие кризисы преодолены, и мы выходим на новый уровень, используя накопленный опыт *болезней роста*  В более глубоком смысле это может означать, что мы находимся на пороге открытия великой тайны Любви.');
+INSERT INTO meaning_minor VALUES (402, 4, 7, 'straight', 3, '**Юпитер/Сатурн** как символ терпения и медленного, но верного роста.\n\n**Мифологический образ**: Горы (богини времен года) у афинян: Талло — богиня цветения, Авксо — богиня роста, и Карпо — богиня зрелых плодов.\n\n**Ицзин**: 5 Сюй. Необходимость ждать.\n\nЭта карта олицетворяет терпение и медленный рост. Она рекомендует не торопиться, найдя для себя многообещающий шанс, а всесторонне обдумать его, дав себе время, прежде чем принимать решение: пусть семя сперва выпустит росток, покажет свои листья и цветы. Если мы не будем торопить события и рвать плоды еще зелеными, то сможем достичь успеха. Вместе с Повешенным (XII) и Четверкой Мечей **Семерка Денариев** составляет триаду, указывающую на необходимость ожидания. Однако она, в отличие от этих двух карт, означает не остановку, а непрерывный рост. Работа Здесь **Семерка Денариев** означает необходимость приготовиться к долгому, но благодарному ожиданию, пока не закончится очередная фаза развития. Если мы ждали скорого результата, то, скорее всего, будем разочарованы; если пытались ускорить события, то можем вообще оказаться перед разбитым корытом. Лучше всего — проявить максимум терпения, чтобы с полной уверенностью дождаться положительного результата. Сознание Период созревания новых идей, концепций и представлений. Это процесс медленный, но неуклонный. От нас требуется не торопиться с решениями, а дать себе время усвоить новые представления и мысли, освоиться с ними. Личные взаимоотношения Медленный, но верный рост качества отношений, обещающий богатый урожай. Может также означать беременность. **Семерка Денариев** — это призыв к созерцанию и терпению, к *у-вэй* китайцев (*не делай лишнего*), к тому, о чем сказано в книге Даодэцзин: действует бездействуя мудрец. Если мы вступаем в новый союз, то эта карта также советует нам не торопиться, не мешать своим нетерпением естественному созреванию плодов.');
+INSERT INTO meaning_minor VALUES (423, 4, 14, 'straight', 3, '**Солнце в Тельце** как символ любви к собственности, чувственной радости и делового подхода.\n\n**Мифологический образ**: Критский царь Минос, сын Зевса (принявшего образ быка) и Европы, отец Минотавра; или Дионис, веселый бог вина и чувственных радостей.\n\n**Король Денариев** олицетворяет мужскую сторону стихии земли.\n\nТаким образом, он олицетворяет наше стремление обладать собственностью, быть уверенным в завтрашнем дне и в реальных ценностях, быть в ладу с окружающей действительностью. Для него главное — дела, а не слова, мечты или благие намерения. Это — наше стремление к прочности и надежности бытия, к возможности постоянно обращаться к тому, что хорошо знакомо. При этом Короля Денариев отличает хорошее чутье, инстинкт, позволяющий распознавать, что реально, а что нет, какое дело стоит того, чтобы им заниматься, а за какое и браться нечего, и каков будет результат. Кроме того, у него прекрасное чувство времени: он понимает, что истинные ценности *созревают* медленно, и умеет ждать. Он терпелив и не признает поспешности. Кроме того, он выражает нашу радость жизни, любовь к развлечениям и удовольствиям, а также плотскую чувственность. Но у него есть и теневая сторона: это неутолимая похоть, прожженное торгашество или вальяжное безделье — тот самый *Обломов внутри нас*, о котором так замечательно написал И. Гончаров. Работа Эта карта указывает прежде всего на наше стремление к надежности и к уверенности в завтрашнем дне, на любовь ко всему прочному, стабильному, постоянному. Труд, доставляющий радость, высокое мастерство, терпение и умение доводить дело до конца, отличающие многих крестьян, ремесленников или банкиров. С финансами у Короля Денариев обычно всегда все в порядке, к тому же ему помогает его прямо-таки выдающийся нюх на все, что может принести деньги. Он никогда не упустит своего шанса. С другой стороны, он способен безошибочно распознавать обманщиков и шарлатанов. Он любит и умеет работать *в команде*, одиночки-индивидуалы среди таких людей почти не встречаются. Сознание **Король Денариев** показывает, что мы решили довериться своему чувству реальности, отказавшись от погони за высокими, но несбыточными идеалами и предпочтя *синицу в руке*  Это — период, когда мы, с одной стороны, стремимся создать себе ясную и практичную картину мира, а с другой — как можно полнее осуществить наши желания и добрые намерения. Карта Короля Денариев — это крестьянская хитрость. Она означает, что для нас важнее всего дело и его результат, и что мы готовы двигаться маленькими шажками (и даже делаем это с удовольствием), если знаем, что в данном случае никакой иной путь не приведет нас ближе к цели. Личные взаимоотношения Здесь эта карта означает постоянство, крепкую дружбу, надежность, верность и душевную теплоту, которые для нас дороже развлечений или новизны. Именно в этой области аспект чувственной радости, присущий этой карте, проявляется наиболее ярко. Она говорит о нашем стремлени к светлому, чувственному и продолжительному союзу, в котором мы чувствуем себя в безопасности. В некоторых случаях она может означать желание создать семью. Если же чувство безопасности отсутствует, **Король Денариев** может указывать на ревность, готовую принять опасные формы.');
+INSERT INTO meaning_minor VALUES (411, 4, 10, 'straight', 3, '**Юпитер во II доме** как символ изобилия и достатка.\n\n**Мифологический образ**: Сказочные богатства царя Соломона.\n\n**Ицзин**: 14 Да-ю. Владение многими. \n\n**Десятка Денариев** обозначает период богатства, стабильности, полноты жизни и уверенности в завтрашнем дне. При этом богатство и полнота могут проявляться как на внешнем плане, так и на внутреннем. Однако, чтобы достичь внутренней полноты, нужно все время держать глаза открытыми. Таким образом, карта предупреждает, что за деловыми хлопотами и усердием нельзя забывать о делах душевных, житейских. За бытом, на первый взгляд таким серым, нужно уметь видеть чудеса, которые он в себе таит. Работа Совет повнимательнее приглядеться к своей текущей и уже малость поднадоевшей работе, чтобы открыть в ней что-то новое и интересное для себя. Это может обогатить нас опытом, описанным Гурджиевым в его медитациях на работу, или у графа Диркгейма в его книге *Быт как испытание* . Эта карта говорит о стабильности нашего положения на работе, об интересных заданиях, о хорошей зарплате, об удачных сделках, об успехе как в материальных делах, так и в духовном развитии. Сознание Богатство мыслей. Поток мыслей и связанные с ним открытия расширяют наш кругозор. Отдельные, давно известные факты складываются наконец подобно мозаике в целостную картину, становясь основой наших дальнейших действий. Теперь мы осознаем, каким богатством владеем. Личные взаимоотношения Здесь эта карта предвещает прекрасный период, во время которого все мельчайшие детали наших взаимоотношений с партнером начинают сверкать новыми удивительными гранями. Именно здесь наше внутреннее богатство начинает играть ключевую роль: мы замечаем малейшие проявления внимания и любви к себе, прежде терявшиеся за пеленой привычки или суеты повседневной жазни.');
+INSERT INTO meaning_minor VALUES (414, 4, 11, 'straight', 3, '**Соединение Уран-Венера в Тельце** как символ неожиданного, однако чрезвычайно ценного шанса.\n\n**Мифологический образ**: Ариадна, помогшая Тезею найти выход из лабиринта.\n\n**Ицзин**: 53 Цзянь. Течение.\n\n**Валет Денариев** обозначает некий шанс, нам открывающийся, толчок, который мы вдруг ощущаем. Поскольку денарии обозначают стихию земли, этот Валет может символизировать какое-то конаретное, вполне практическое предложение. Земля — это прочность, стабильность, поэтому и предложение будет, скорее всего, верным и надежным. При этом, в зависимости от заданного вопроса, речь может идти о выгодной сделке, о новой перспективной работе, просто о жизненном опыте или о неожиданном, но очень благоприятном выходе из создавшегося положения. Эта карта всегда указывает на нечто вещественное, прочное, каковы бы ни были предлагаемые ею шансы и варианты. Работа Новое назначение, задание или план, в осуществлении которого нам будет оказана ощутимая помощь. Валет означает открывающуюся перед нами возможность найти хорошую работу, заработать денег, перейти на новую должность или заключить неплохую сделку. Сознание Возможность получить конкретные, вещественные результаты своего труда. Все вопросы, сомнения и проблемы, мучившие нас долгое время, внезапно разъясняются благодаря этому толчку извне. Планы наконец приобретают законченный вид, проекты осуществляются. Идеи, до сих пор бывшие лишь фантазиями, претворяются в жизнь. Наше *Когда-нибудь мне хотелось бы…* становится руководством к действию и графиком конкретных работ. Личные взаимоотношения В плане личных отношений эта карта также выражает возможность приобрести конкретный полезный опыт. Спектр этого опыта простирается от новых чувственных ощущений до возможности создать прочный, долговременный союз. При этом Валет Денариев может означать некий решающий шаг, который мы должны сделать, чтобы перейти от влюбленности к настоящему союзу. В уже сложившемся союзе он указывает на возможность успешного разрешения проблем и споров, копившихся, возможно, годами, и перехода ваших отношений на новый уровень.');
@@ -0,0 +1,28 @@
+-- Row versions for optimistic concurrency control: every update increments
+-- version, which the API sends as the ETag of the resource, and sets updated_at.
+-- SQLite cannot add a column with a non-constant default, so updated_at of
+-- existing rows is set here and inserts set it explicitly.
+ALTER TABLE deck ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE deck ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE deck SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE source ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE source ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE source SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE spread ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE spread ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE spread SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE suit ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE suit ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE suit SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE rank ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE rank ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE rank SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE card ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE card ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE card SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE meaning_major ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE meaning_major ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE meaning_major SET updated_at = CURRENT_TIMESTAMP;
+ALTER TABLE meaning_minor ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE meaning_minor ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE meaning_minor SET updated_at = CURRENT_TIMESTAMP;
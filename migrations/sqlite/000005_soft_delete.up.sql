@@ -0,0 +1,44 @@
+-- Soft deletion of decks, sources and cards: a delete sets deleted_at, which
+-- hides the row (and the cards of a deleted deck) until it is restored from
+-- the trash or purged. Restores and purges are recorded in the audit log.
+ALTER TABLE deck ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE source ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE card ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX deck_deleted_at_idx ON deck (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX source_deleted_at_idx ON source (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX card_deleted_at_idx ON card (deleted_at) WHERE deleted_at IS NOT NULL;
+
+DROP VIEW deck_with_stats;
+CREATE VIEW deck_with_stats AS
+SELECT d.id,
+    d.name,
+    d.description,
+    d.image,
+    EXISTS (
+        SELECT 1
+        FROM card_minor cm
+        JOIN card c ON c.id = cm.card
+        WHERE c.deck = d.id AND c.deleted_at IS NULL
+    ) AS has_minor_cards
+FROM deck d
+WHERE d.deleted_at IS NULL;
+
+-- SQLite cannot alter a CHECK constraint: the audit log is copied into a new table
+CREATE TABLE audit_log_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    at TIMESTAMP NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')),
+    entity VARCHAR(30) NOT NULL,
+    entity_id INTEGER NOT NULL,
+    changes TEXT NOT NULL
+);
+INSERT INTO audit_log_new (id, at, actor, action, entity, entity_id, changes)
+SELECT id, at, actor, action, entity, entity_id, changes FROM audit_log;
+DROP TABLE audit_log;
+ALTER TABLE audit_log_new RENAME TO audit_log;
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity, entity_id);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor);
+CREATE INDEX audit_log_at_idx ON audit_log (at);
@@ -0,0 +1,6 @@
+-- Image variants: the card back of a deck, and the size of the original card
+-- images in pixels (0 if unknown), from which the sizes of the scaled variants
+-- are computed.
+ALTER TABLE deck ADD COLUMN back_image VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE deck ADD COLUMN image_width INTEGER NOT NULL DEFAULT 0 CHECK (image_width >= 0);
+ALTER TABLE deck ADD COLUMN image_height INTEGER NOT NULL DEFAULT 0 CHECK (image_height >= 0);
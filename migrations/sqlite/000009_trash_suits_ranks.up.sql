@@ -0,0 +1,15 @@
+-- Suits and ranks go to the trash as well, and take their Minor Arcana cards
+-- along, which would otherwise have to be deleted for good with them: a
+-- delete sets the deleted_at of the suit or rank and of its cards to the same
+-- time, and a restore takes both out of the trash again. Their names are
+-- unique among the ones not in the trash only.
+ALTER TABLE suit ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE rank ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX suit_deleted_at_idx ON suit (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX rank_deleted_at_idx ON rank (deleted_at) WHERE deleted_at IS NOT NULL;
+
+DROP INDEX suit_name_unique_idx;
+CREATE UNIQUE INDEX suit_name_unique_idx ON suit (name) WHERE deleted_at IS NULL;
+DROP INDEX rank_name_unique_idx;
+CREATE UNIQUE INDEX rank_name_unique_idx ON rank (name) WHERE deleted_at IS NULL;
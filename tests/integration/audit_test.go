@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GET__audit_lists_writes_of_an_entity(t *testing.T) {
+	from := time.Now().Add(-time.Second).UTC().Format(time.RFC3339)
+
+	body, _ := json.Marshal(models.SpreadInput{Name: "Audited Spread", MajorArcana: true, NumCards: 3})
+	req := httptest.NewRequest(http.MethodPost, "/spreads", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var spread models.Spread
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spread))
+
+	body, _ = json.Marshal(models.SpreadInput{Name: "Audited Spread", MajorArcana: true, NumCards: 5})
+	req = httptest.NewRequest(http.MethodPut, "/spreads/"+strconv.FormatInt(spread.ID, 10), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet,
+		"/audit?entity=spread&entityId="+strconv.FormatInt(spread.ID, 10)+"&from="+from, nil)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var entries []models.AuditEntry
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditUpdate, entries[0].Action)
+	assert.Equal(t, models.AuditCreate, entries[1].Action)
+	assert.JSONEq(t, `{"num_cards": {"before": 3, "after": 5}}`, string(entries[0].Changes))
+
+	req = httptest.NewRequest(http.MethodGet, "/audit?entity=spread&to="+from, nil)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"entityId":`+strconv.FormatInt(spread.ID, 10)+`,`)
+}
+
+// withoutAuditLog runs fn with the audit log table renamed, so that no entry can be recorded
+func withoutAuditLog(t *testing.T, fn func()) {
+	t.Helper()
+	_, err := testApp.App.DB.Exec("ALTER TABLE audit_log RENAME TO audit_log_off")
+	require.NoError(t, err)
+	defer func() {
+		_, err := testApp.App.DB.Exec("ALTER TABLE audit_log_off RENAME TO audit_log")
+		require.NoError(t, err)
+	}()
+	fn()
+}
+
+func Test_PUT__write_is_rolled_back_when_its_audit_entry_fails(t *testing.T) {
+	body, _ := json.Marshal(models.SpreadInput{Name: "Unaudited Spread", MajorArcana: true, NumCards: 3})
+	req := httptest.NewRequest(http.MethodPost, "/spreads", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var spread models.Spread
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spread))
+	path := "/spreads/" + strconv.FormatInt(spread.ID, 10)
+
+	body, _ = json.Marshal(models.SpreadInput{Name: "Unaudited Spread", MajorArcana: true, NumCards: 5})
+	req = httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	withoutAuditLog(t, func() { testApp.App.Echo.ServeHTTP(rec, req) })
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, path, nil)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spread))
+	assert.EqualValues(t, 3, spread.NumCards)
+}
@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ilbagatto/tarot-api/internal/handlers"
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postBatch(t *testing.T, path string, items any) (int, handlers.BatchResponse) {
+	t.Helper()
+	body, _ := json.Marshal(items)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+
+	var resp handlers.BatchResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return rec.Code, resp
+}
+
+func Test_POST__cards_major_batch_is_all_or_nothing(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+
+	items := []models.CardMajorBatchItem{
+		{CardMajorInput: models.CardMajorInput{DeckID: *deckID, Number: 0, Name: "The Fool"}},
+		{CardMajorInput: models.CardMajorInput{DeckID: 999999, Number: 1, Name: "The Magician"}},
+	}
+	status, resp := postBatch(t, "/cards/major/batch", items)
+	require.Equal(t, http.StatusUnprocessableEntity, status)
+	assert.Equal(t, http.StatusFailedDependency, resp.Results[0].Status)
+	assert.Equal(t, http.StatusConflict, resp.Results[1].Status)
+
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/cards/major?deckId="+strconv.FormatInt(*deckID, 10), nil))
+	assert.NotContains(t, rec.Body.String(), "The Fool")
+
+	status, resp = postBatch(t, "/cards/major/batch?mode=partial", items)
+	require.Equal(t, http.StatusMultiStatus, status)
+	require.Equal(t, http.StatusCreated, resp.Results[0].Status)
+
+	items = []models.CardMajorBatchItem{
+		{ID: resp.Results[0].ID, Version: 1, CardMajorInput: models.CardMajorInput{DeckID: *deckID, Number: 0, Name: "Le Mat"}},
+		{CardMajorInput: models.CardMajorInput{DeckID: *deckID, Number: 1, Name: "The Magician"}},
+	}
+	status, resp = postBatch(t, "/cards/major/batch", items)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 1, resp.Created)
+	assert.Equal(t, 1, resp.Updated)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/cards/major/"+strconv.FormatInt(items[0].ID, 10), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Le Mat")
+	assert.True(t, strings.HasPrefix(rec.Header().Get("ETag"), `"2-`), rec.Header().Get("ETag"))
+}
+
+func Test_POST__meanings_minor_batch_reports_each_item(t *testing.T) {
+	sourceID, err := createSource()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteSource(*sourceID), "failed to delete test source")
+	}()
+
+	meaning := models.MeaningMinorInput{Suit: 1, Rank: 1, Position: models.PositionReverted, Source: *sourceID, Meaning: "Lorem Ipsum"}
+	status, resp := postBatch(t, "/meanings/minor/batch?mode=partial", []models.MeaningMinorBatchItem{
+		{MeaningMinorInput: meaning},
+		{MeaningMinorInput: meaning},
+		{ID: 999999, MeaningMinorInput: meaning},
+	})
+	require.Equal(t, http.StatusMultiStatus, status)
+	assert.Equal(t, 1, resp.Created)
+	assert.Equal(t, 2, resp.Failed)
+	assert.Equal(t, []int{http.StatusCreated, http.StatusConflict, http.StatusNotFound},
+		[]int{resp.Results[0].Status, resp.Results[1].Status, resp.Results[2].Status})
+}
+
+func Test_POST__cards_major_batch_is_rolled_back_when_its_audit_entries_fail(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+
+	items := []models.CardMajorBatchItem{
+		{CardMajorInput: models.CardMajorInput{DeckID: *deckID, Number: 0, Name: "The Fool"}},
+		{CardMajorInput: models.CardMajorInput{DeckID: 999999, Number: 1, Name: "The Magician"}},
+	}
+	var status int
+	withoutAuditLog(t, func() { status, _ = postBatch(t, "/cards/major/batch?mode=partial", items) })
+	require.Equal(t, http.StatusInternalServerError, status)
+
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/cards/major?deckId="+strconv.FormatInt(*deckID, 10), nil))
+	assert.NotContains(t, rec.Body.String(), "The Fool")
+}
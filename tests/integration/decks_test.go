@@ -8,7 +8,9 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/ilbagatto/tarot-api/internal/handlers"
 	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -61,6 +63,11 @@ func Test_POST__decks_creates_new_deck(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.True(t, result.ID > 0, "Expected a valid deck ID")
+
+	var deck models.Deck
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deck))
+	require.Len(t, deck.Sources, 1)
+	assert.Equal(t, int64(1), deck.Sources[0].ID)
 }
 
 // Test_POST__decks_with_duplicate_name_returns_409 checks that duplicate names are rejected
@@ -183,8 +190,8 @@ func Test_DELETE__deck_removes_existing_deck(t *testing.T) {
 	require.Equal(t, http.StatusCreated, rec.Code)
 	deckID := getDeckId(t, rec)
 
-	// Delete
-	delReq := httptest.NewRequest(http.MethodDelete, "/decks/"+strconv.Itoa(deckID), nil)
+	// Delete; the links to its sources need force
+	delReq := httptest.NewRequest(http.MethodDelete, "/decks/"+strconv.Itoa(deckID)+"?force=true", nil)
 	delRec := httptest.NewRecorder()
 	testApp.App.Echo.ServeHTTP(delRec, delReq)
 
@@ -192,10 +199,159 @@ func Test_DELETE__deck_removes_existing_deck(t *testing.T) {
 }
 
 // Test_DELETE__nonexistent_deck_returns_404 checks DELETE for nonexistent deck
-func Test_DELETE__nonexistent_deck_returns_204(t *testing.T) {
+func Test_DELETE__nonexistent_deck_returns_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, "/decks/999999", nil)
 	rec := httptest.NewRecorder()
 	testApp.App.Echo.ServeHTTP(rec, req)
 
-	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func Test_GET__nonexistent_deck_returns_request_id(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/decks/999999", nil)
+	req.Header.Set("X-Request-ID", "integration-test-42")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "integration-test-42", rec.Header().Get("X-Request-ID"))
+	assert.Contains(t, rec.Body.String(), `"requestId":"integration-test-42"`)
+}
+
+func Test_POST__decks_scaffold_creates_78_cards(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+	path := "/decks/" + strconv.FormatInt(*deckID, 10) + "/scaffold"
+
+	body, _ := json.Marshal(models.ScaffoldInput{
+		Tradition:   "marseille",
+		MinorImages: "test/minor/{suit}/{rank}.png",
+	})
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var result models.ScaffoldResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Len(t, result.MajorCards, 22)
+	assert.Len(t, result.MinorCards, 56)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/cards/major/"+strconv.FormatInt(result.MajorCards[16], 10), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var tower models.CardMajor
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tower))
+	assert.Equal(t, 16, tower.Number)
+	assert.Equal(t, "La Maison Dieu", tower.OrgName)
+	assert.Nil(t, tower.Image)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/cards/minor/"+strconv.FormatInt(result.MinorCards[0], 10), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var ace models.CardMinor
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ace))
+	require.NotNil(t, ace.Image)
+	assert.Contains(t, *ace.Image, "test/minor/wands/ace.png")
+
+	// The deck has cards now
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func Test_POST__decks_scaffold_can_be_retried_when_its_audit_entries_fail(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+	path := "/decks/" + strconv.FormatInt(*deckID, 10) + "/scaffold"
+
+	rec := httptest.NewRecorder()
+	withoutAuditLog(t, func() {
+		testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+	})
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+	assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func Test_GET__cards_fall_back_to_deck_image_template(t *testing.T) {
+	payload := models.DeckInput{
+		Name:               "Deck with Templates",
+		MinorImageTemplate: "test/{suit}/{rank}.png",
+	}
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(http.MethodPost, "/decks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	deckID := int64(getDeckId(t, rec))
+	defer func() {
+		require.NoError(t, deleteDeck(deckID), "failed to delete test deck")
+	}()
+
+	body, _ = json.Marshal(models.CardMinorInput{DeckID: deckID, SuitID: 3, RankID: 11})
+	req = httptest.NewRequest(http.MethodPost, "/cards/minor", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var card models.CardMinor
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &card))
+	require.NotNil(t, card.Image)
+	assert.Contains(t, *card.Image, "/images/test/cups/page.png")
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/decks/"+strconv.FormatInt(deckID, 10)+"/images/check", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var report handlers.ImageCheckReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, 1, report.Checked)
+	require.Len(t, report.Missing, 1)
+	assert.Equal(t, "test/cups/page.png", report.Missing[0].Path)
+	assert.True(t, report.Missing[0].Templated)
+}
+
+func Test_GET__deck_lists_back_image_variants(t *testing.T) {
+	payload := models.DeckInput{
+		Name:        "Deck with Back",
+		BackImage:   "test/back.jpg",
+		ImageWidth:  700,
+		ImageHeight: 1200,
+	}
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(http.MethodPost, "/decks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	deckID := int64(getDeckId(t, rec))
+	defer func() {
+		require.NoError(t, deleteDeck(deckID), "failed to delete test deck")
+	}()
+
+	var deck models.Deck
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deck))
+	assert.Equal(t, 700, deck.ImageWidth)
+	assert.Equal(t, 1200, deck.ImageHeight)
+	assert.Contains(t, deck.BackImage, "/images/test/back.jpg")
+	require.NotEmpty(t, deck.BackImages)
+	assert.Equal(t, utils.ImageVariant{
+		Size: "original", Format: "jpeg", URL: deck.BackImage, Width: 700, Height: 1200,
+	}, deck.BackImages[0])
+	require.Greater(t, len(deck.BackImages), 1)
+	assert.Contains(t, deck.BackImages[1].URL, "/img/"+strconv.FormatInt(deckID, 10)+"/back?w=")
 }
@@ -35,7 +35,7 @@ func createDeck() (*int64, error) {
 }
 
 func deleteDeck(deckID int64) error {
-	req := httptest.NewRequest(http.MethodDelete, "/decks/"+strconv.FormatInt(deckID, 10), nil)
+	req := httptest.NewRequest(http.MethodDelete, "/decks/"+strconv.FormatInt(deckID, 10)+"?force=true", nil)
 	rec := httptest.NewRecorder()
 
 	testApp.App.Echo.ServeHTTP(rec, req)
@@ -84,7 +84,7 @@ func createSource() (*int64, error) {
 }
 
 func deleteSource(sourceID int64) error {
-	req := httptest.NewRequest(http.MethodDelete, "/sources/"+strconv.FormatInt(sourceID, 10), nil)
+	req := httptest.NewRequest(http.MethodDelete, "/sources/"+strconv.FormatInt(sourceID, 10)+"?force=true", nil)
 	rec := httptest.NewRecorder()
 
 	testApp.App.Echo.ServeHTTP(rec, req)
@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/ilbagatto/tarot-api/internal/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_POST__major_meanings_creates_new_MajorMeaning(t *testing.T) {
@@ -71,6 +73,79 @@ func Test_PUT__major_meanings_updates_existing_entry(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "Calm wisdom")
 }
 
+func Test_PUT__major_meanings_rejects_stale_If_Match(t *testing.T) {
+	sourceID := createTestSource(t)
+
+	id := createTestMajorMeaning(t, models.MeaningMajorInput{
+		Number:   14,
+		Position: "straight",
+		Source:   sourceID,
+		Meaning:  "Moderation",
+	})
+	path := "/meanings/major/" + strconv.Itoa(id)
+
+	put := func(meaning, ifMatch string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(models.MeaningMajorInput{
+			Number:   14,
+			Position: "straight",
+			Source:   sourceID,
+			Meaning:  meaning,
+		})
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", ifMatch)
+		rec := httptest.NewRecorder()
+		testApp.App.Echo.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(etag, `"1-`), etag)
+
+	rec = put("Balance", etag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Header().Get("ETag"), `"2-`), rec.Header().Get("ETag"))
+
+	rec = put("Patience", etag)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	assert.Contains(t, rec.Body.String(), "Balance")
+}
+
+func Test_PATCH__major_meanings_changes_only_given_fields(t *testing.T) {
+	sourceID := createTestSource(t)
+
+	id := createTestMajorMeaning(t, models.MeaningMajorInput{
+		Number:   13,
+		Position: "straight",
+		Source:   sourceID,
+		Meaning:  "Transformation",
+	})
+
+	req := httptest.NewRequest(http.MethodPatch, "/meanings/major/"+strconv.Itoa(id),
+		strings.NewReader(`{"meaning": "An ending that clears the way"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	rec := httptest.NewRecorder()
+
+	testApp.App.Echo.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var meaning models.MeaningMajor
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &meaning))
+	assert.Equal(t, models.MeaningMajor{
+		ID:       int64(id),
+		Number:   13,
+		Position: "straight",
+		Source:   sourceID,
+		Meaning:  "An ending that clears the way",
+	}, meaning)
+}
+
 func Test_DELETE__major_meanings_removes_existing_entry(t *testing.T) {
 	sourceID := createTestSource(t)
 
@@ -87,3 +162,47 @@ func Test_DELETE__major_meanings_removes_existing_entry(t *testing.T) {
 
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 }
+
+func Test_POST__major_meanings_restores_a_revision(t *testing.T) {
+	sourceID := createTestSource(t)
+
+	input := models.MeaningMajorInput{Number: 17, Position: "straight", Source: sourceID, Meaning: "Hope"}
+	id := createTestMajorMeaning(t, input)
+	path := "/meanings/major/" + strconv.Itoa(id)
+
+	input.Meaning = "Inspiration"
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path+"/revisions", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var revisions []models.MeaningMajorRevision
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revisions))
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "Inspiration", revisions[0].Meaning)
+	assert.JSONEq(t, `{"meaning": {"before": "Hope", "after": "Inspiration"}}`, string(revisions[0].Changes))
+
+	req = httptest.NewRequest(http.MethodPost, path+"/revisions/1/restore", nil)
+	req.Header.Set("If-Match", etag)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Header().Get("ETag"), `"3-`), rec.Header().Get("ETag"))
+	var meaning models.MeaningMajor
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &meaning))
+	assert.Equal(t, "Hope", meaning.Meaning)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path+"/revisions/9/restore", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, path, nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -89,7 +89,13 @@ func Test_PUT_minor_cards_updates_existing_Card(t *testing.T) {
 	putRec := httptest.NewRecorder()
 	testApp.App.Echo.ServeHTTP(putRec, putReq)
 
-	assert.Equal(t, http.StatusNoContent, putRec.Code)
+	assert.Equal(t, http.StatusOK, putRec.Code)
+
+	var updatedCard models.CardMinor
+	err = json.Unmarshal(putRec.Body.Bytes(), &updatedCard)
+	require.NoError(t, err)
+	assert.Equal(t, int64(CardID), updatedCard.ID)
+	assert.Equal(t, int64(2), updatedCard.SuitID)
 }
 
 func Test_DELETE_MinorCard_removes_existing_Card(t *testing.T) {
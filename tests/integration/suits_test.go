@@ -2,13 +2,17 @@ package integration
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
 
+	"github.com/ilbagatto/tarot-api/internal/handlers"
 	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -120,3 +124,111 @@ func Test_DELETE_Suit_removes_existing_Suit(t *testing.T) {
 
 	assert.Equal(t, http.StatusNoContent, delRec.Code)
 }
+
+func Test_GET_suits_with_matching_ETag_returns_304(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/suits", nil)
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.NotEmpty(t, rec.Header().Get("Cache-Control"))
+
+	req = httptest.NewRequest(http.MethodGet, "/suits", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func Test_POST_suits_invalidates_cached_list(t *testing.T) {
+	// Warm up the cache
+	req := httptest.NewRequest(http.MethodGet, "/suits", nil)
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	payload := models.SuitInput{
+		Name:     testutils.RandomString(10, 30),
+		Genitive: "of cached suit",
+	}
+	body, _ := json.Marshal(payload)
+	req = httptest.NewRequest(http.MethodPost, "/suits", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/suits", nil)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), payload.Name)
+}
+
+func Test_DELETE_Suit_with_cards_requires_force(t *testing.T) {
+	body, _ := json.Marshal(models.SuitInput{Name: "Suit 4", Genitive: "of suit 4"})
+	req := httptest.NewRequest(http.MethodPost, "/suits", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	suitID := getSuitId(t, rec)
+	suitPath := "/suits/" + strconv.Itoa(suitID)
+
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+	body, _ = json.Marshal(models.CardMinorInput{DeckID: *deckID, SuitID: int64(suitID), RankID: 1})
+	req = httptest.NewRequest(http.MethodPost, "/cards/minor", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	cardID := int64(getMinorCardId(t, rec))
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, suitPath+"?dry_run=true", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var report handlers.DeleteReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, []int64{cardID}, report.Dependents.MinorCards)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, suitPath, nil))
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, suitPath+"?force=true", nil))
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	cardPath := "/cards/minor/" + strconv.FormatInt(cardID, 10)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, cardPath, nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// The card went to the trash with the suit and comes back with it
+	_, err = testApp.App.Repos.Trash.Get(context.Background(), models.TrashCardMinor, cardID)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/trash/suit/"+strconv.Itoa(suitID)+"/restore", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, cardPath, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Purging the suit takes the card along
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, suitPath+"?force=true", nil))
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/trash/suit/"+strconv.Itoa(suitID), nil))
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	_, err = testApp.App.Repos.Trash.Get(context.Background(), models.TrashCardMinor, cardID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,237 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ilbagatto/tarot-api/internal/models"
+	"github.com/ilbagatto/tarot-api/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DELETE__decks_moves_the_deck_with_its_cards_to_the_trash(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	deckPath := "/decks/" + strconv.FormatInt(*deckID, 10)
+
+	body, _ := json.Marshal(models.CardMajorInput{DeckID: *deckID, Number: 1, Name: "The Magician"})
+	req := httptest.NewRequest(http.MethodPost, "/cards/major", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	cardPath := "/cards/major/" + strconv.Itoa(getMajorCardId(t, rec))
+
+	require.NoError(t, deleteDeck(*deckID))
+
+	for _, path := range []string{deckPath, cardPath} {
+		rec = httptest.NewRecorder()
+		testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trash?entity=deck", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var items []models.TrashItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+	i := slices.IndexFunc(items, func(item models.TrashItem) bool { return item.ID == *deckID })
+	require.GreaterOrEqual(t, i, 0, "deck not in the trash")
+	assert.WithinDuration(t, time.Now(), items[i].DeletedAt, time.Minute)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/trash/deck/"+strconv.FormatInt(*deckID, 10)+"/restore", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, cardPath, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, deleteDeck(*deckID))
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete,
+		"/trash/deck/"+strconv.FormatInt(*deckID, 10), nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	_, err = testApp.App.Repos.Trash.Get(context.Background(), models.TrashDeck, *deckID)
+	assert.Error(t, err)
+}
+
+func Test_POST__trash_restore_conflicts_with_a_reused_name(t *testing.T) {
+	name := testutils.RandomString(10, 50)
+	createNamedSource := func() int64 {
+		body, _ := json.Marshal(models.SourceInput{Name: name})
+		req := httptest.NewRequest(http.MethodPost, "/sources", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		testApp.App.Echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var source models.Source
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &source))
+		return source.ID
+	}
+
+	sourceID := createNamedSource()
+	require.NoError(t, deleteSource(sourceID))
+	// The name of a trashed source is free
+	reusedID := createNamedSource()
+	defer func() {
+		require.NoError(t, deleteSource(reusedID))
+	}()
+
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/trash/source/"+strconv.FormatInt(sourceID, 10)+"/restore", nil))
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+}
+
+func Test_POST__cards_of_a_trashed_deck_conflict(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+	deckPath := "/trash/deck/" + strconv.FormatInt(*deckID, 10) + "/restore"
+
+	body, _ := json.Marshal(models.CardMajorInput{DeckID: *deckID, Number: 1, Name: "The Magician"})
+	req := httptest.NewRequest(http.MethodPost, "/cards/major", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	cardID := strconv.Itoa(getMajorCardId(t, rec))
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/cards/major/"+cardID, nil))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.NoError(t, deleteDeck(*deckID))
+
+	req = httptest.NewRequest(http.MethodPost, "/cards/major", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/trash/card_major/"+cardID+"/restore", nil))
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "deck "+strconv.FormatInt(*deckID, 10))
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, deckPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/trash/card_major/"+cardID+"/restore", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func Test_PurgeBefore_purges_entities_trashed_earlier(t *testing.T) {
+	ctx := context.Background()
+	sourceID, err := createSource()
+	require.NoError(t, err)
+	require.NoError(t, deleteSource(*sourceID))
+
+	purged, err := testApp.App.Repos.Trash.PurgeBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	for _, item := range purged {
+		assert.NotEqual(t, *sourceID, item.ID, "purged a source trashed within the retention")
+	}
+
+	purged, err = testApp.App.Repos.Trash.PurgeBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(purged, func(item models.TrashItem) bool {
+		return item.Entity == models.TrashSource && item.ID == *sourceID
+	}), "kept a source trashed before the given time")
+	_, err = testApp.App.Repos.Trash.Get(ctx, models.TrashSource, *sourceID)
+	assert.Error(t, err)
+}
+
+func Test_GET__meanings_of_a_trashed_source_are_hidden(t *testing.T) {
+	sourceID, err := createSource()
+	require.NoError(t, err)
+	meaningPath := "/meanings/major/" + strconv.Itoa(createTestMajorMeaning(t, models.MeaningMajorInput{
+		Number: 1, Position: models.PositionStraight, Source: *sourceID, Meaning: "Воля",
+	}))
+	require.NoError(t, deleteSource(*sourceID))
+
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, meaningPath, nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/meanings/major?source="+strconv.FormatInt(*sourceID, 10), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list []models.MeaningMajor
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Empty(t, list)
+
+	body, _ := json.Marshal(models.MeaningMajorInput{
+		Number: 2, Position: models.PositionStraight, Source: *sourceID, Meaning: "Мудрость",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/meanings/major", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/trash/source/"+strconv.FormatInt(*sourceID, 10)+"/restore", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	defer func() {
+		require.NoError(t, deleteSource(*sourceID))
+	}()
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, meaningPath, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_PATCH__decks_keeps_links_to_trashed_sources(t *testing.T) {
+	deckID, err := createDeck()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, deleteDeck(*deckID), "failed to delete test deck")
+	}()
+	deckPath := "/decks/" + strconv.FormatInt(*deckID, 10)
+	sourceID := createTestSource(t)
+
+	body, _ := json.Marshal(models.DeckInput{
+		Name: testutils.RandomString(10, 50), Sources: []models.IDOnly{{ID: sourceID}},
+	})
+	req := httptest.NewRequest(http.MethodPut, deckPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, deleteSource(sourceID))
+
+	req = httptest.NewRequest(http.MethodPatch, deckPath, bytes.NewBufferString(`{"description": "Updated"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/trash/source/"+strconv.FormatInt(sourceID, 10)+"/restore", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	testApp.App.Echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, deckPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var deck models.Deck
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deck))
+	require.Len(t, deck.Sources, 1)
+	assert.Equal(t, sourceID, deck.Sources[0].ID)
+}